@@ -138,10 +138,12 @@ type Keyspace struct {
 	Vindexes map[string]*Vindex `protobuf:"bytes,2,rep,name=vindexes,proto3" json:"vindexes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Tables   map[string]*Table  `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// If require_explicit_routing is true, vindexes and tables are not added to global routing
-	RequireExplicitRouting bool     `protobuf:"varint,4,opt,name=require_explicit_routing,json=requireExplicitRouting,proto3" json:"require_explicit_routing,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	RequireExplicitRouting bool `protobuf:"varint,4,opt,name=require_explicit_routing,json=requireExplicitRouting,proto3" json:"require_explicit_routing,omitempty"`
+	// comment is a human readable description of the keyspace.
+	Comment              string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
@@ -205,6 +207,13 @@ func (m *Keyspace) GetRequireExplicitRouting() bool {
 	return false
 }
 
+func (m *Keyspace) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
 // Vindex is the vindex info for a Keyspace.
 type Vindex struct {
 	// The type must match one of the predefined
@@ -305,10 +314,26 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// source optionally names the keyspace-qualified table
+	// that a reference table is copied from.
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	// sequence_params optionally configures the table backing
+	// a sequence. It is only set if type is "sequence".
+	SequenceParams *SequenceParams `protobuf:"bytes,8,opt,name=sequence_params,json=sequenceParams,proto3" json:"sequence_params,omitempty"`
+	// parent optionally names the table that the rows of this
+	// table reference with a foreign key. The primary vindexes of
+	// both tables are compatible, which keeps related rows on the
+	// same shard.
+	Parent *ParentTable `protobuf:"bytes,9,opt,name=parent,proto3" json:"parent,omitempty"`
+	// scatter marks a table of a sharded keyspace as intentionally
+	// scatter-routed. Such a table doesn't need any vindex, and the
+	// statements that can't be routed through one are sent to all
+	// the shards.
+	Scatter              bool     `protobuf:"varint,10,opt,name=scatter,proto3" json:"scatter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -386,6 +411,163 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Table) GetSequenceParams() *SequenceParams {
+	if m != nil {
+		return m.SequenceParams
+	}
+	return nil
+}
+
+func (m *Table) GetParent() *ParentTable {
+	if m != nil {
+		return m.Parent
+	}
+	return nil
+}
+
+func (m *Table) GetScatter() bool {
+	if m != nil {
+		return m.Scatter
+	}
+	return false
+}
+
+// SequenceParams holds the tunables of a sequence table.
+type SequenceParams struct {
+	// cache is the number of values reserved by vttablet
+	// every time it goes to the sequence table.
+	Cache int64 `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	// start is the first value handed out by the sequence.
+	Start                int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SequenceParams) Reset()         { *m = SequenceParams{} }
+func (m *SequenceParams) String() string { return proto.CompactTextString(m) }
+func (*SequenceParams) ProtoMessage()    {}
+func (*SequenceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{5}
+}
+func (m *SequenceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceParams.Merge(m, src)
+}
+func (m *SequenceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceParams proto.InternalMessageInfo
+
+func (m *SequenceParams) GetCache() int64 {
+	if m != nil {
+		return m.Cache
+	}
+	return 0
+}
+
+func (m *SequenceParams) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+// ParentTable describes the foreign key relationship of a table
+// with its parent table.
+type ParentTable struct {
+	// table is the keyspace-qualified name of the parent table.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// columns are the columns of the child table that
+	// reference the parent table.
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// referenced_columns are the columns of the parent table
+	// they reference, in the same order.
+	ReferencedColumns    []string `protobuf:"bytes,3,rep,name=referenced_columns,json=referencedColumns,proto3" json:"referenced_columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParentTable) Reset()         { *m = ParentTable{} }
+func (m *ParentTable) String() string { return proto.CompactTextString(m) }
+func (*ParentTable) ProtoMessage()    {}
+func (*ParentTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{6}
+}
+func (m *ParentTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParentTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParentTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParentTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParentTable.Merge(m, src)
+}
+func (m *ParentTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParentTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParentTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParentTable proto.InternalMessageInfo
+
+func (m *ParentTable) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *ParentTable) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *ParentTable) GetReferencedColumns() []string {
+	if m != nil {
+		return m.ReferencedColumns
+	}
+	return nil
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -393,17 +575,34 @@ type ColumnVindex struct {
 	// The name must match a vindex defined in Keyspace.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// List of columns that define this Vindex
-	Columns              []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Columns []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// backfill_required records whether existing rows need to be backfilled
+	// for this binding. It is informational only and meant for external
+	// tooling: vtgate does not act on it.
+	BackfillRequired bool `protobuf:"varint,4,opt,name=backfill_required,json=backfillRequired,proto3" json:"backfill_required,omitempty"`
+	// expression is the expression of the stored generated column the
+	// vindex is bound to, like lower(email). It is only set for single
+	// column bindings.
+	Expression string `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	// disabled keeps the planner from using the vindex for routing. The
+	// vindex is still maintained on writes. The primary vindex can't be
+	// disabled.
+	Disabled bool `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// backfill_source describes how to populate the backing table of
+	// a lookup vindex from the existing rows of its owner table. It is
+	// set when a lookup vindex that requires a backfill is bound to its
+	// owner. Like backfill_required, it is meant for external tooling.
+	BackfillSource       *BackfillSource `protobuf:"bytes,7,opt,name=backfill_source,json=backfillSource,proto3" json:"backfill_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ColumnVindex) Reset()         { *m = ColumnVindex{} }
 func (m *ColumnVindex) String() string { return proto.CompactTextString(m) }
 func (*ColumnVindex) ProtoMessage()    {}
 func (*ColumnVindex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f6849254fea3e77, []int{5}
+	return fileDescriptor_3f6849254fea3e77, []int{7}
 }
 func (m *ColumnVindex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,6 +652,122 @@ func (m *ColumnVindex) GetColumns() []string {
 	return nil
 }
 
+func (m *ColumnVindex) GetBackfillRequired() bool {
+	if m != nil {
+		return m.BackfillRequired
+	}
+	return false
+}
+
+func (m *ColumnVindex) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *ColumnVindex) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *ColumnVindex) GetBackfillSource() *BackfillSource {
+	if m != nil {
+		return m.BackfillSource
+	}
+	return nil
+}
+
+// BackfillSource describes the rows that populate the backing table of
+// a lookup vindex.
+type BackfillSource struct {
+	// table is the keyspace-qualified owner table the rows are read from.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// columns are the columns of the owner table the lookup is bound to.
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// lookup_table is the backing table of the lookup vindex.
+	LookupTable string `protobuf:"bytes,3,opt,name=lookup_table,json=lookupTable,proto3" json:"lookup_table,omitempty"`
+	// from are the columns of the lookup table the values of columns
+	// are written to, in the same order.
+	From []string `protobuf:"bytes,4,rep,name=from,proto3" json:"from,omitempty"`
+	// to is the column of the lookup table the keyspace id, or the
+	// primary key of the owner row, is written to.
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillSource) Reset()         { *m = BackfillSource{} }
+func (m *BackfillSource) String() string { return proto.CompactTextString(m) }
+func (*BackfillSource) ProtoMessage()    {}
+func (*BackfillSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{8}
+}
+func (m *BackfillSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillSource.Merge(m, src)
+}
+func (m *BackfillSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillSource proto.InternalMessageInfo
+
+func (m *BackfillSource) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *BackfillSource) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+func (m *BackfillSource) GetLookupTable() string {
+	if m != nil {
+		return m.LookupTable
+	}
+	return ""
+}
+
+func (m *BackfillSource) GetFrom() []string {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *BackfillSource) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
 // Autoincrement is used to designate a column as auto-inc.
 type AutoIncrement struct {
 	Column string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
@@ -467,7 +782,7 @@ func (m *AutoIncrement) Reset()         { *m = AutoIncrement{} }
 func (m *AutoIncrement) String() string { return proto.CompactTextString(m) }
 func (*AutoIncrement) ProtoMessage()    {}
 func (*AutoIncrement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f6849254fea3e77, []int{6}
+	return fileDescriptor_3f6849254fea3e77, []int{9}
 }
 func (m *AutoIncrement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +838,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f6849254fea3e77, []int{7}
+	return fileDescriptor_3f6849254fea3e77, []int{10}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,18 +884,21 @@ func (m *Column) GetType() query.Type {
 // SrvVSchema is the roll-up of all the Keyspace schema for a cell.
 type SrvVSchema struct {
 	// keyspaces is a map of keyspace name -> Keyspace object.
-	Keyspaces            map[string]*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	RoutingRules         *RoutingRules        `protobuf:"bytes,2,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Keyspaces    map[string]*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RoutingRules *RoutingRules        `protobuf:"bytes,2,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	// label is a free-form version label of the vschema, set with
+	// ALTER VSCHEMA SET LABEL.
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SrvVSchema) Reset()         { *m = SrvVSchema{} }
 func (m *SrvVSchema) String() string { return proto.CompactTextString(m) }
 func (*SrvVSchema) ProtoMessage()    {}
 func (*SrvVSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f6849254fea3e77, []int{8}
+	return fileDescriptor_3f6849254fea3e77, []int{11}
 }
 func (m *SrvVSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,6 +941,13 @@ func (m *SrvVSchema) GetRoutingRules() *RoutingRules {
 	return nil
 }
 
+func (m *SrvVSchema) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RoutingRules)(nil), "vschema.RoutingRules")
 	proto.RegisterType((*RoutingRule)(nil), "vschema.RoutingRule")
@@ -632,7 +957,10 @@ func init() {
 	proto.RegisterType((*Vindex)(nil), "vschema.Vindex")
 	proto.RegisterMapType((map[string]string)(nil), "vschema.Vindex.ParamsEntry")
 	proto.RegisterType((*Table)(nil), "vschema.Table")
+	proto.RegisterType((*SequenceParams)(nil), "vschema.SequenceParams")
+	proto.RegisterType((*ParentTable)(nil), "vschema.ParentTable")
 	proto.RegisterType((*ColumnVindex)(nil), "vschema.ColumnVindex")
+	proto.RegisterType((*BackfillSource)(nil), "vschema.BackfillSource")
 	proto.RegisterType((*AutoIncrement)(nil), "vschema.AutoIncrement")
 	proto.RegisterType((*Column)(nil), "vschema.Column")
 	proto.RegisterType((*SrvVSchema)(nil), "vschema.SrvVSchema")
@@ -642,51 +970,67 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0xfe, 0x9d, 0x90, 0x90, 0x8c, 0x49, 0xf8, 0xbb, 0x02, 0xea, 0x06, 0x11, 0x22, 0x8b, 0xaa,
-	0x69, 0x0f, 0x89, 0x14, 0xd4, 0x8a, 0xa6, 0xa2, 0x2a, 0x45, 0x1c, 0x50, 0x91, 0x5a, 0x19, 0xc4,
-	0xa1, 0x17, 0xcb, 0x38, 0x5b, 0x58, 0x91, 0x78, 0xcd, 0xee, 0xda, 0x25, 0x6f, 0xd2, 0x5e, 0xfb,
-	0x34, 0x3d, 0xf6, 0xde, 0x4b, 0x45, 0x8f, 0x7d, 0x89, 0xca, 0xbb, 0x6b, 0xb3, 0x81, 0xf4, 0xb6,
-	0xdf, 0xce, 0xcc, 0x37, 0xdf, 0xce, 0xce, 0x0c, 0x34, 0x52, 0x1e, 0x5e, 0xe0, 0x49, 0xd0, 0x8b,
-	0x19, 0x15, 0x14, 0x2d, 0x6a, 0xd8, 0xb2, 0xaf, 0x12, 0xcc, 0xa6, 0xea, 0xd6, 0x1d, 0xc2, 0x92,
-	0x47, 0x13, 0x41, 0xa2, 0x73, 0x2f, 0x19, 0x63, 0x8e, 0x9e, 0x41, 0x85, 0x65, 0x07, 0xc7, 0xea,
-	0x94, 0xbb, 0xf6, 0x60, 0xa5, 0x97, 0x93, 0x18, 0x5e, 0x9e, 0x72, 0x71, 0x0f, 0xc1, 0x36, 0x6e,
-	0xd1, 0x06, 0xc0, 0x27, 0x46, 0x27, 0xbe, 0x08, 0xce, 0xc6, 0xd8, 0xb1, 0x3a, 0x56, 0xb7, 0xee,
-	0xd5, 0xb3, 0x9b, 0x93, 0xec, 0x02, 0xad, 0x43, 0x5d, 0x50, 0x65, 0xe4, 0x4e, 0xa9, 0x53, 0xee,
-	0xd6, 0xbd, 0x9a, 0xa0, 0xd2, 0xc6, 0xdd, 0x3f, 0x25, 0xa8, 0xbd, 0xc3, 0x53, 0x1e, 0x07, 0x21,
-	0x46, 0x0e, 0x2c, 0xf2, 0x8b, 0x80, 0x8d, 0xf0, 0x48, 0xb2, 0xd4, 0xbc, 0x1c, 0xa2, 0x57, 0x50,
-	0x4b, 0x49, 0x34, 0xc2, 0xd7, 0x9a, 0xc2, 0x1e, 0x6c, 0x16, 0x02, 0xf3, 0xf0, 0xde, 0xa9, 0xf6,
-	0x38, 0x88, 0x04, 0x9b, 0x7a, 0x45, 0x00, 0x7a, 0x0e, 0x55, 0x9d, 0xbd, 0x2c, 0x43, 0x37, 0xee,
-	0x87, 0x2a, 0x35, 0x2a, 0x50, 0x3b, 0xa3, 0x1d, 0x70, 0x18, 0xbe, 0x4a, 0x08, 0xc3, 0x3e, 0xbe,
-	0x8e, 0xc7, 0x24, 0x24, 0xc2, 0x67, 0xea, 0xd9, 0xce, 0x82, 0x94, 0xb7, 0xa6, 0xed, 0x07, 0xda,
-	0xac, 0x8b, 0xd2, 0x3a, 0x82, 0xc6, 0x8c, 0x16, 0xf4, 0x3f, 0x94, 0x2f, 0xf1, 0x54, 0x97, 0x26,
-	0x3b, 0xa2, 0xc7, 0x50, 0x49, 0x83, 0x71, 0x82, 0x9d, 0x52, 0xc7, 0xea, 0xda, 0x83, 0xe5, 0x42,
-	0x92, 0x0a, 0xf4, 0x94, 0x75, 0x58, 0xda, 0xb1, 0x5a, 0x87, 0x60, 0x1b, 0xf2, 0xe6, 0x70, 0x6d,
-	0xcd, 0x72, 0x35, 0x0b, 0x2e, 0x19, 0x66, 0x50, 0xb9, 0xdf, 0x2c, 0xa8, 0xaa, 0x04, 0x08, 0xc1,
-	0x82, 0x98, 0xc6, 0xf9, 0x77, 0xc9, 0x33, 0xda, 0x86, 0x6a, 0x1c, 0xb0, 0x60, 0x92, 0xd7, 0x78,
-	0xfd, 0x8e, 0xaa, 0xde, 0x07, 0x69, 0xd5, 0x65, 0x52, 0xae, 0x68, 0x05, 0x2a, 0xf4, 0x73, 0x84,
-	0x99, 0x53, 0x96, 0x4c, 0x0a, 0xb4, 0x5e, 0x82, 0x6d, 0x38, 0xcf, 0x11, 0xbd, 0x62, 0x8a, 0xae,
-	0x9b, 0x22, 0xbf, 0x96, 0xa0, 0xa2, 0x3a, 0x67, 0x9e, 0xc6, 0xd7, 0xb0, 0x1c, 0xd2, 0x71, 0x32,
-	0x89, 0xfc, 0x3b, 0x0d, 0xb1, 0x5a, 0x88, 0xdd, 0x97, 0x76, 0x5d, 0xc8, 0x66, 0x68, 0x20, 0xcc,
-	0xd1, 0x2e, 0x34, 0x83, 0x44, 0x50, 0x9f, 0x44, 0x21, 0xc3, 0x13, 0x1c, 0x09, 0xa9, 0xdb, 0x1e,
-	0xac, 0x15, 0xe1, 0x7b, 0x89, 0xa0, 0x87, 0xb9, 0xd5, 0x6b, 0x04, 0x26, 0x44, 0x4f, 0x61, 0x51,
-	0x11, 0x72, 0x67, 0x41, 0xa6, 0x5d, 0xbe, 0x93, 0xd6, 0xcb, 0xed, 0x68, 0x0d, 0xaa, 0x31, 0x89,
-	0x22, 0x3c, 0x72, 0x2a, 0x52, 0xbf, 0x46, 0x68, 0x08, 0x8f, 0xf4, 0x0b, 0xc6, 0x84, 0x0b, 0x3f,
-	0x48, 0xc4, 0x05, 0x65, 0x44, 0x04, 0x82, 0xa4, 0xd8, 0xa9, 0xca, 0xc6, 0x7a, 0xa8, 0x1c, 0x8e,
-	0x08, 0x17, 0x7b, 0xa6, 0xd9, 0x3d, 0x81, 0x25, 0xf3, 0x75, 0x59, 0x0e, 0xe5, 0xaa, 0x6b, 0xa4,
-	0x51, 0x56, 0xb9, 0x28, 0x98, 0xe4, 0xc5, 0x95, 0xe7, 0x6c, 0xba, 0x72, 0xe9, 0x65, 0x39, 0x85,
-	0x39, 0x74, 0xf7, 0xa1, 0x31, 0xf3, 0xe8, 0x7f, 0xd2, 0xb6, 0xa0, 0xc6, 0xf1, 0x55, 0x82, 0xa3,
-	0x30, 0xa7, 0x2e, 0xb0, 0xbb, 0x0b, 0xd5, 0xfd, 0xd9, 0xe4, 0x96, 0x91, 0x7c, 0x53, 0x7f, 0x65,
-	0x16, 0xd5, 0x1c, 0xd8, 0x3d, 0xb5, 0x8a, 0x4e, 0xa6, 0x31, 0x56, 0xff, 0xea, 0xfe, 0xb4, 0x00,
-	0x8e, 0x59, 0x7a, 0x7a, 0x2c, 0x8b, 0x89, 0xde, 0x40, 0xfd, 0x52, 0x0f, 0x67, 0xbe, 0x92, 0xdc,
-	0xa2, 0xd2, 0xb7, 0x7e, 0xc5, 0x04, 0xeb, 0xa6, 0xbc, 0x0d, 0x42, 0x43, 0x68, 0xe8, 0x69, 0xf5,
-	0xd5, 0x62, 0x53, 0xd3, 0xb1, 0x3a, 0x6f, 0xb1, 0x71, 0x6f, 0x89, 0x19, 0xa8, 0xf5, 0x1e, 0x9a,
-	0xb3, 0xc4, 0x73, 0x1a, 0xf8, 0xc9, 0xec, 0xd4, 0x3d, 0xb8, 0xb7, 0x54, 0x8c, 0x9e, 0x7e, 0xfb,
-	0xe2, 0xfb, 0x4d, 0xdb, 0xfa, 0x71, 0xd3, 0xb6, 0x7e, 0xdd, 0xb4, 0xad, 0x2f, 0xbf, 0xdb, 0xff,
-	0x7d, 0xdc, 0x4a, 0x89, 0xc0, 0x9c, 0xf7, 0x08, 0xed, 0xab, 0x53, 0xff, 0x9c, 0xf6, 0x53, 0xd1,
-	0x97, 0xdb, 0xb9, 0xaf, 0xb9, 0xce, 0xaa, 0x12, 0x6e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x39, 0x77, 0x25, 0xd3, 0x05, 0x00, 0x00,
+	// 952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0xc6, 0x71, 0x76, 0xb3, 0x7b, 0x9c, 0x75, 0x9a, 0x51, 0x9a, 0x9a, 0xad, 0xba, 0x5d, 0xac,
+	0x22, 0xc2, 0xdf, 0xae, 0x94, 0x0a, 0x54, 0x02, 0x45, 0x6d, 0xa3, 0x5e, 0x44, 0x54, 0xa2, 0x72,
+	0xaa, 0x5e, 0x70, 0x63, 0x79, 0xbd, 0x93, 0xc6, 0x8a, 0xd7, 0xe3, 0xcc, 0x8c, 0x97, 0xec, 0x03,
+	0x20, 0x5e, 0x81, 0x6b, 0x5e, 0x83, 0x17, 0xe0, 0x92, 0x47, 0x40, 0xe1, 0x09, 0x78, 0x02, 0xd0,
+	0xcc, 0x19, 0x3b, 0xe3, 0x76, 0xb9, 0xe0, 0xce, 0xdf, 0x9c, 0xdf, 0x39, 0xdf, 0x99, 0x73, 0x0c,
+	0x83, 0xa5, 0x48, 0xcf, 0xe9, 0x22, 0x99, 0x94, 0x9c, 0x49, 0x46, 0xb6, 0x0c, 0x1c, 0x7a, 0x97,
+	0x15, 0xe5, 0x2b, 0x3c, 0x0d, 0x8f, 0x60, 0x3b, 0x62, 0x95, 0xcc, 0x8a, 0x37, 0x51, 0x95, 0x53,
+	0x41, 0x3e, 0x81, 0x0e, 0x57, 0x1f, 0x81, 0x33, 0x76, 0x0f, 0xbc, 0xc3, 0xbd, 0x49, 0xed, 0xc4,
+	0xd2, 0x8a, 0x50, 0x25, 0x3c, 0x01, 0xcf, 0x3a, 0x25, 0xf7, 0x00, 0xce, 0x38, 0x5b, 0xc4, 0x32,
+	0x99, 0xe5, 0x34, 0x70, 0xc6, 0xce, 0x41, 0x3f, 0xea, 0xab, 0x93, 0x57, 0xea, 0x80, 0xdc, 0x85,
+	0xbe, 0x64, 0x28, 0x14, 0xc1, 0xc6, 0xd8, 0x3d, 0xe8, 0x47, 0x3d, 0xc9, 0xb4, 0x4c, 0x84, 0x3f,
+	0xb9, 0xd0, 0xfb, 0x8e, 0xae, 0x44, 0x99, 0xa4, 0x94, 0x04, 0xb0, 0x25, 0xce, 0x13, 0x3e, 0xa7,
+	0x73, 0xed, 0xa5, 0x17, 0xd5, 0x90, 0x7c, 0x0d, 0xbd, 0x65, 0x56, 0xcc, 0xe9, 0x95, 0x71, 0xe1,
+	0x1d, 0xde, 0x6f, 0x12, 0xac, 0xcd, 0x27, 0xaf, 0x8d, 0xc6, 0xf3, 0x42, 0xf2, 0x55, 0xd4, 0x18,
+	0x90, 0x2f, 0xa0, 0x6b, 0xa2, 0xbb, 0xda, 0xf4, 0xde, 0xbb, 0xa6, 0x98, 0x0d, 0x1a, 0x1a, 0x65,
+	0xf2, 0x08, 0x02, 0x4e, 0x2f, 0xab, 0x8c, 0xd3, 0x98, 0x5e, 0x95, 0x79, 0x96, 0x66, 0x32, 0xe6,
+	0x78, 0xed, 0x60, 0x53, 0xa7, 0xb7, 0x6f, 0xe4, 0xcf, 0x8d, 0xd8, 0x14, 0x45, 0xdd, 0x23, 0x65,
+	0x8b, 0x05, 0x2d, 0x64, 0xd0, 0xd1, 0xd5, 0xa8, 0xe1, 0xf0, 0x05, 0x0c, 0x5a, 0x59, 0x92, 0x5b,
+	0xe0, 0x5e, 0xd0, 0x95, 0x29, 0x9a, 0xfa, 0x24, 0x1f, 0x42, 0x67, 0x99, 0xe4, 0x15, 0x0d, 0x36,
+	0xc6, 0xce, 0x81, 0x77, 0xb8, 0xd3, 0x24, 0x8b, 0x86, 0x11, 0x4a, 0x8f, 0x36, 0x1e, 0x39, 0xc3,
+	0x13, 0xf0, 0xac, 0xc4, 0xd7, 0xf8, 0x7a, 0xd0, 0xf6, 0xe5, 0x37, 0xbe, 0xb4, 0x99, 0xe5, 0x2a,
+	0xfc, 0xd5, 0x81, 0x2e, 0x06, 0x20, 0x04, 0x36, 0xe5, 0xaa, 0xac, 0x89, 0xd4, 0xdf, 0xe4, 0x21,
+	0x74, 0xcb, 0x84, 0x27, 0x8b, 0xba, 0xfa, 0x77, 0xdf, 0xca, 0x6a, 0xf2, 0x52, 0x4b, 0x4d, 0x01,
+	0x51, 0x95, 0xec, 0x41, 0x87, 0xfd, 0x58, 0x50, 0x1e, 0xb8, 0xda, 0x13, 0x82, 0xe1, 0x57, 0xe0,
+	0x59, 0xca, 0x6b, 0x92, 0xde, 0xb3, 0x93, 0xee, 0xdb, 0x49, 0xfe, 0xe6, 0x42, 0x07, 0x7b, 0x6a,
+	0x5d, 0x8e, 0xdf, 0xc2, 0x4e, 0xca, 0xf2, 0x6a, 0x51, 0xc4, 0x6f, 0xb5, 0xca, 0xed, 0x26, 0xd9,
+	0x63, 0x2d, 0x37, 0x85, 0xf4, 0x53, 0x0b, 0x51, 0x41, 0x1e, 0x83, 0x9f, 0x54, 0x92, 0xc5, 0x59,
+	0x91, 0x72, 0xaa, 0xc9, 0x73, 0x75, 0xd5, 0xf6, 0x1b, 0xf3, 0xa7, 0x95, 0x64, 0x27, 0xb5, 0x34,
+	0x1a, 0x24, 0x36, 0x24, 0x1f, 0xc3, 0x16, 0x3a, 0x14, 0xc1, 0xe6, 0xd8, 0x6d, 0x31, 0x87, 0x61,
+	0xa3, 0x5a, 0x4e, 0xf6, 0xa1, 0x5b, 0x66, 0x45, 0x41, 0xe7, 0xa6, 0x3d, 0x0c, 0x22, 0x47, 0xf0,
+	0xbe, 0xb9, 0x41, 0x9e, 0x09, 0x19, 0x27, 0x95, 0x3c, 0x67, 0x3c, 0x93, 0x89, 0xcc, 0x96, 0x34,
+	0xe8, 0xea, 0x96, 0xbb, 0x83, 0x0a, 0x2f, 0x32, 0x21, 0x9f, 0xda, 0x62, 0xe5, 0x53, 0xb0, 0x8a,
+	0xa7, 0x34, 0xd8, 0x42, 0x9f, 0x88, 0xc8, 0x13, 0xd8, 0x11, 0xf4, 0xb2, 0xa2, 0x45, 0x4a, 0x63,
+	0x43, 0x61, 0x4f, 0x5f, 0xeb, 0x4e, 0x93, 0xde, 0xa9, 0x91, 0x23, 0x2d, 0x91, 0x2f, 0x5a, 0x98,
+	0x7c, 0xa6, 0xb9, 0x57, 0xf5, 0xe8, 0x8f, 0x9d, 0xd6, 0x68, 0x78, 0xa9, 0x8f, 0xb1, 0x97, 0x8c,
+	0x8e, 0x7e, 0xc3, 0x69, 0x22, 0x25, 0xe5, 0x01, 0x98, 0x37, 0x8c, 0x30, 0xfc, 0x06, 0xfc, 0x76,
+	0x24, 0xc5, 0x74, 0x9a, 0xa4, 0xe7, 0x48, 0xa3, 0x1b, 0x21, 0x50, 0xa7, 0x42, 0x26, 0x5c, 0x6a,
+	0xfe, 0xdd, 0x08, 0x41, 0x98, 0x83, 0x67, 0x85, 0x53, 0x4a, 0xf6, 0xb8, 0x41, 0x80, 0x0f, 0x0f,
+	0x39, 0xc0, 0x41, 0x53, 0x43, 0xf2, 0x39, 0x10, 0x4e, 0xcf, 0x28, 0x57, 0xd1, 0xe7, 0x71, 0xad,
+	0xe4, 0x6a, 0xa5, 0xdd, 0x1b, 0x09, 0x32, 0x25, 0xc2, 0x7f, 0x1c, 0xd8, 0xb6, 0x9b, 0x45, 0x95,
+	0x17, 0x8d, 0x4c, 0x40, 0x83, 0x54, 0x23, 0x16, 0xc9, 0xa2, 0xee, 0x55, 0xfd, 0x6d, 0x67, 0xe1,
+	0xb6, 0xb3, 0xf8, 0x14, 0x76, 0x67, 0x49, 0x7a, 0x71, 0x96, 0xe5, 0x79, 0x6c, 0x66, 0xc7, 0xdc,
+	0xcc, 0x92, 0x5b, 0xb5, 0x20, 0x32, 0xe7, 0x64, 0x04, 0x40, 0xaf, 0x4a, 0x4e, 0x85, 0xc8, 0x58,
+	0x61, 0x3a, 0xc5, 0x3a, 0x21, 0x43, 0xe8, 0xcd, 0x33, 0xa1, 0xee, 0x3d, 0x37, 0xcd, 0xd1, 0x60,
+	0xc5, 0x7a, 0x13, 0xc8, 0x6a, 0x0b, 0x9b, 0xf5, 0x67, 0x46, 0x7e, 0xaa, 0xc5, 0x91, 0x3f, 0x6b,
+	0xe1, 0xf0, 0x67, 0x07, 0xfc, 0xb6, 0xca, 0xff, 0xae, 0xf9, 0x07, 0xb0, 0x9d, 0x33, 0x76, 0x51,
+	0x95, 0x66, 0x33, 0xe0, 0x18, 0xf0, 0xf0, 0xac, 0x79, 0xc7, 0x6a, 0x51, 0xe8, 0x17, 0xd3, 0x8f,
+	0xf4, 0x37, 0xf1, 0x61, 0x43, 0x32, 0x73, 0xdf, 0x0d, 0xc9, 0xc2, 0x63, 0x18, 0xb4, 0x1e, 0xde,
+	0x7f, 0x72, 0x31, 0x84, 0x5e, 0xdd, 0xba, 0x86, 0x8f, 0x06, 0x87, 0x8f, 0xa1, 0x7b, 0xdc, 0x66,
+	0xcc, 0xb1, 0x18, 0xbb, 0x6f, 0xc6, 0x89, 0xb2, 0xf2, 0x0f, 0xbd, 0x09, 0x2e, 0xca, 0x57, 0xab,
+	0x92, 0xe2, 0x6c, 0x09, 0xff, 0x76, 0x00, 0x4e, 0xf9, 0xf2, 0xf5, 0xa9, 0xae, 0x1d, 0x79, 0x02,
+	0xfd, 0x0b, 0xb3, 0x3a, 0xea, 0x85, 0x19, 0xde, 0x3c, 0xa7, 0x46, 0xaf, 0xd9, 0x2f, 0x66, 0x30,
+	0xde, 0x18, 0x91, 0x23, 0x18, 0x98, 0x5d, 0x12, 0xe3, 0xda, 0xc5, 0x09, 0x7d, 0x7b, 0xdd, 0xda,
+	0x15, 0xd1, 0x36, 0xb7, 0x90, 0xe2, 0x21, 0x4f, 0x66, 0x34, 0xaf, 0xe7, 0xaa, 0x06, 0xc3, 0xef,
+	0xc1, 0x6f, 0x87, 0x5b, 0x33, 0x5a, 0x3f, 0x6a, 0xef, 0x83, 0xdd, 0x77, 0x16, 0xa1, 0x35, 0x6d,
+	0x9f, 0x7d, 0xf9, 0xfb, 0xf5, 0xc8, 0xf9, 0xe3, 0x7a, 0xe4, 0xfc, 0x79, 0x3d, 0x72, 0x7e, 0xf9,
+	0x6b, 0xf4, 0xde, 0x0f, 0x0f, 0x96, 0x99, 0xa4, 0x42, 0x4c, 0x32, 0x36, 0xc5, 0xaf, 0xe9, 0x1b,
+	0x36, 0x5d, 0xca, 0xa9, 0xfe, 0xa3, 0x98, 0x1a, 0x5f, 0xb3, 0xae, 0x86, 0x0f, 0xff, 0x1d, 0x00,
+	0xff, 0x32, 0xba, 0x0f, 0x87, 0x08, 0x00, 0x00,
 }
 
 func (m *RoutingRules) Marshal() (dAtA []byte, err error) {
@@ -797,6 +1141,13 @@ func (m *Keyspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.RequireExplicitRouting {
 		i--
 		if m.RequireExplicitRouting {
@@ -956,6 +1307,47 @@ func (m *Table) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Scatter {
+		i--
+		if m.Scatter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Parent != nil {
+		{
+			size, err := m.Parent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVschema(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SequenceParams != nil {
+		{
+			size, err := m.SequenceParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVschema(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ColumnListAuthoritative {
 		i--
 		if m.ColumnListAuthoritative {
@@ -1023,7 +1415,7 @@ func (m *Table) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ColumnVindex) Marshal() (dAtA []byte, err error) {
+func (m *SequenceParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1033,12 +1425,12 @@ func (m *ColumnVindex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ColumnVindex) MarshalTo(dAtA []byte) (int, error) {
+func (m *SequenceParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ColumnVindex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SequenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1047,33 +1439,20 @@ func (m *ColumnVindex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Columns) > 0 {
-		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Columns[iNdEx])
-			copy(dAtA[i:], m.Columns[iNdEx])
-			i = encodeVarintVschema(dAtA, i, uint64(len(m.Columns[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintVschema(dAtA, i, uint64(len(m.Name)))
+	if m.Start != 0 {
+		i = encodeVarintVschema(dAtA, i, uint64(m.Start))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Column) > 0 {
-		i -= len(m.Column)
-		copy(dAtA[i:], m.Column)
-		i = encodeVarintVschema(dAtA, i, uint64(len(m.Column)))
+	if m.Cache != 0 {
+		i = encodeVarintVschema(dAtA, i, uint64(m.Cache))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AutoIncrement) Marshal() (dAtA []byte, err error) {
+func (m *ParentTable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1083,12 +1462,12 @@ func (m *AutoIncrement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AutoIncrement) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParentTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AutoIncrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParentTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1097,13 +1476,220 @@ func (m *AutoIncrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Sequence) > 0 {
-		i -= len(m.Sequence)
-		copy(dAtA[i:], m.Sequence)
-		i = encodeVarintVschema(dAtA, i, uint64(len(m.Sequence)))
-		i--
-		dAtA[i] = 0x12
-	}
+	if len(m.ReferencedColumns) > 0 {
+		for iNdEx := len(m.ReferencedColumns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReferencedColumns[iNdEx])
+			copy(dAtA[i:], m.ReferencedColumns[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.ReferencedColumns[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ColumnVindex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ColumnVindex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ColumnVindex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BackfillSource != nil {
+		{
+			size, err := m.BackfillSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVschema(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Expression) > 0 {
+		i -= len(m.Expression)
+		copy(dAtA[i:], m.Expression)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Expression)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BackfillRequired {
+		i--
+		if m.BackfillRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Column) > 0 {
+		i -= len(m.Column)
+		copy(dAtA[i:], m.Column)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Column)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		for iNdEx := len(m.From) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.From[iNdEx])
+			copy(dAtA[i:], m.From[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.From[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LookupTable) > 0 {
+		i -= len(m.LookupTable)
+		copy(dAtA[i:], m.LookupTable)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.LookupTable)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintVschema(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoIncrement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoIncrement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoIncrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Sequence) > 0 {
+		i -= len(m.Sequence)
+		copy(dAtA[i:], m.Sequence)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Sequence)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Column) > 0 {
 		i -= len(m.Column)
 		copy(dAtA[i:], m.Column)
@@ -1177,6 +1763,13 @@ func (m *SrvVSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.RoutingRules != nil {
 		{
 			size, err := m.RoutingRules.MarshalToSizedBuffer(dAtA[:i])
@@ -1307,6 +1900,10 @@ func (m *Keyspace) Size() (n int) {
 	if m.RequireExplicitRouting {
 		n += 2
 	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1374,23 +1971,52 @@ func (m *Table) Size() (n int) {
 	if m.ColumnListAuthoritative {
 		n += 2
 	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.SequenceParams != nil {
+		l = m.SequenceParams.Size()
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.Scatter {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ColumnVindex) Size() (n int) {
+func (m *SequenceParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Column)
-	if l > 0 {
-		n += 1 + l + sovVschema(uint64(l))
+	if m.Cache != 0 {
+		n += 1 + sovVschema(uint64(m.Cache))
 	}
-	l = len(m.Name)
+	if m.Start != 0 {
+		n += 1 + sovVschema(uint64(m.Start))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ParentTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
 	if l > 0 {
 		n += 1 + l + sovVschema(uint64(l))
 	}
@@ -1400,13 +2026,19 @@ func (m *ColumnVindex) Size() (n int) {
 			n += 1 + l + sovVschema(uint64(l))
 		}
 	}
+	if len(m.ReferencedColumns) > 0 {
+		for _, s := range m.ReferencedColumns {
+			l = len(s)
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AutoIncrement) Size() (n int) {
+func (m *ColumnVindex) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1416,28 +2048,65 @@ func (m *AutoIncrement) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVschema(uint64(l))
 	}
-	l = len(m.Sequence)
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
+	if m.BackfillRequired {
+		n += 2
+	}
+	l = len(m.Expression)
 	if l > 0 {
 		n += 1 + l + sovVschema(uint64(l))
 	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.BackfillSource != nil {
+		l = m.BackfillSource.Size()
+		n += 1 + l + sovVschema(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Column) Size() (n int) {
+func (m *BackfillSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Table)
 	if l > 0 {
 		n += 1 + l + sovVschema(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovVschema(uint64(m.Type))
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
+	l = len(m.LookupTable)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if len(m.From) > 0 {
+		for _, s := range m.From {
+			l = len(s)
+			n += 1 + l + sovVschema(uint64(l))
+		}
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1445,18 +2114,57 @@ func (m *Column) Size() (n int) {
 	return n
 }
 
-func (m *SrvVSchema) Size() (n int) {
+func (m *AutoIncrement) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Keyspaces) > 0 {
-		for k, v := range m.Keyspaces {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
+	l = len(m.Column)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	l = len(m.Sequence)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Column) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovVschema(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SrvVSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keyspaces) > 0 {
+		for k, v := range m.Keyspaces {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
 				l = v.Size()
 				l += 1 + sovVschema(uint64(l))
 			}
@@ -1468,6 +2176,10 @@ func (m *SrvVSchema) Size() (n int) {
 		l = m.RoutingRules.Size()
 		n += 1 + l + sovVschema(uint64(l))
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2013,6 +2725,38 @@ func (m *Keyspace) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequireExplicitRouting = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -2224,11 +2968,619 @@ func (m *Vindex) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Params[mapkey] = mapvalue
+			m.Params[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVschema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Table) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVschema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Table: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Table: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnVindexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnVindexes = append(m.ColumnVindexes, &ColumnVindex{})
+			if err := m.ColumnVindexes[len(m.ColumnVindexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoIncrement == nil {
+				m.AutoIncrement = &AutoIncrement{}
+			}
+			if err := m.AutoIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, &Column{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pinned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnListAuthoritative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnListAuthoritative = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SequenceParams == nil {
+				m.SequenceParams = &SequenceParams{}
+			}
+			if err := m.SequenceParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parent == nil {
+				m.Parent = &ParentTable{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scatter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scatter = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVschema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SequenceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVschema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			m.Cache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cache |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVschema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParentTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVschema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParentTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParentTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedColumns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2256,7 +3608,7 @@ func (m *Vindex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ReferencedColumns = append(m.ReferencedColumns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2283,7 +3635,7 @@ func (m *Vindex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Table) Unmarshal(dAtA []byte) error {
+func (m *ColumnVindex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,15 +3658,15 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Table: wiretype end group for non-group")
+			return fmt.Errorf("proto: ColumnVindex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Table: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ColumnVindex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2342,13 +3694,13 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Column = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnVindexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVschema
@@ -2358,31 +3710,29 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVschema
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVschema
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ColumnVindexes = append(m.ColumnVindexes, &ColumnVindex{})
-			if err := m.ColumnVindexes[len(m.ColumnVindexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncrement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVschema
@@ -2392,33 +3742,29 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVschema
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVschema
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoIncrement == nil {
-				m.AutoIncrement = &AutoIncrement{}
-			}
-			if err := m.AutoIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackfillRequired", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVschema
@@ -2428,29 +3774,15 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthVschema
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthVschema
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Columns = append(m.Columns, &Column{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.BackfillRequired = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2478,11 +3810,11 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pinned = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnListAuthoritative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2499,7 +3831,43 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ColumnListAuthoritative = bool(v != 0)
+			m.Disabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackfillSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BackfillSource == nil {
+				m.BackfillSource = &BackfillSource{}
+			}
+			if err := m.BackfillSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -2525,7 +3893,7 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ColumnVindex) Unmarshal(dAtA []byte) error {
+func (m *BackfillSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2548,15 +3916,15 @@ func (m *ColumnVindex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ColumnVindex: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackfillSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColumnVindex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackfillSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2584,11 +3952,11 @@ func (m *ColumnVindex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Column = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2616,11 +3984,11 @@ func (m *ColumnVindex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LookupTable", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2648,7 +4016,71 @@ func (m *ColumnVindex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			m.LookupTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = append(m.From, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3092,6 +4524,38 @@ func (m *SrvVSchema) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -161,10 +161,32 @@ type Session struct {
 	// Session UUID
 	SessionUUID string `protobuf:"bytes,22,opt,name=SessionUUID,proto3" json:"SessionUUID,omitempty"`
 	// enable_system_settings defines if we can use reserved connections.
-	EnableSystemSettings bool     `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	EnableSystemSettings bool `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	// ddl_fail_fast returns the first shard error of a DDL on its own, and
+	// cancels the shards the DDL is still running on. The DDL is still sent
+	// to the shards concurrently. With -ddl_max_concurrency, the batches
+	// after the failed one are not dispatched.
+	DdlFailFast bool `protobuf:"varint,24,opt,name=ddl_fail_fast,json=ddlFailFast,proto3" json:"ddl_fail_fast,omitempty"`
+	// ddl_drop_vschema_table makes a DROP TABLE sent to the shards also
+	// remove the dropped tables from the vschema.
+	DdlDropVschemaTable bool `protobuf:"varint,25,opt,name=ddl_drop_vschema_table,json=ddlDropVschemaTable,proto3" json:"ddl_drop_vschema_table,omitempty"`
+	// vschema_ddl_json makes ALTER VSCHEMA return a JSON description of
+	// the vschema objects it changed.
+	VschemaDdlJson bool `protobuf:"varint,26,opt,name=vschema_ddl_json,json=vschemaDdlJson,proto3" json:"vschema_ddl_json,omitempty"`
+	// capture_vschema_ddl makes the session record the ALTER VSCHEMA
+	// statements it applies in captured_vschema_ddl.
+	CaptureVschemaDdl bool `protobuf:"varint,27,opt,name=capture_vschema_ddl,json=captureVschemaDdl,proto3" json:"capture_vschema_ddl,omitempty"`
+	// captured_vschema_ddl lists the ALTER VSCHEMA statements applied by
+	// the session while capture_vschema_ddl was set, in order. vtgate
+	// stops capturing after -max_captured_vschema_ddl statements.
+	CapturedVschemaDdl []*CapturedVSchemaDDL `protobuf:"bytes,28,rep,name=captured_vschema_ddl,json=capturedVschemaDdl,proto3" json:"captured_vschema_ddl,omitempty"`
+	// vschema_default_keyspace is the keyspace ALTER VSCHEMA statements
+	// apply to when neither the statement nor target_string name one.
+	// It is only used for users authorized to perform vschema operations.
+	VschemaDefaultKeyspace string   `protobuf:"bytes,29,opt,name=vschema_default_keyspace,json=vschemaDefaultKeyspace,proto3" json:"vschema_default_keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -354,6 +376,48 @@ func (m *Session) GetEnableSystemSettings() bool {
 	return false
 }
 
+func (m *Session) GetDdlFailFast() bool {
+	if m != nil {
+		return m.DdlFailFast
+	}
+	return false
+}
+
+func (m *Session) GetDdlDropVschemaTable() bool {
+	if m != nil {
+		return m.DdlDropVschemaTable
+	}
+	return false
+}
+
+func (m *Session) GetVschemaDdlJson() bool {
+	if m != nil {
+		return m.VschemaDdlJson
+	}
+	return false
+}
+
+func (m *Session) GetCaptureVschemaDdl() bool {
+	if m != nil {
+		return m.CaptureVschemaDdl
+	}
+	return false
+}
+
+func (m *Session) GetCapturedVschemaDdl() []*CapturedVSchemaDDL {
+	if m != nil {
+		return m.CapturedVschemaDdl
+	}
+	return nil
+}
+
+func (m *Session) GetVschemaDefaultKeyspace() string {
+	if m != nil {
+		return m.VschemaDefaultKeyspace
+	}
+	return ""
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -426,6 +490,65 @@ func (m *Session_ShardSession) GetReservedId() int64 {
 	return 0
 }
 
+// CapturedVSchemaDDL is an ALTER VSCHEMA statement applied by a session.
+type CapturedVSchemaDDL struct {
+	// keyspace is the target keyspace of the session, which the
+	// statement applies to unless it names another one.
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// statement is the normalized statement.
+	Statement            string   `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CapturedVSchemaDDL) Reset()         { *m = CapturedVSchemaDDL{} }
+func (m *CapturedVSchemaDDL) String() string { return proto.CompactTextString(m) }
+func (*CapturedVSchemaDDL) ProtoMessage()    {}
+func (*CapturedVSchemaDDL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{1}
+}
+func (m *CapturedVSchemaDDL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapturedVSchemaDDL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapturedVSchemaDDL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapturedVSchemaDDL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapturedVSchemaDDL.Merge(m, src)
+}
+func (m *CapturedVSchemaDDL) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapturedVSchemaDDL) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapturedVSchemaDDL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapturedVSchemaDDL proto.InternalMessageInfo
+
+func (m *CapturedVSchemaDDL) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CapturedVSchemaDDL) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
 // ReadAfterWrite contains information regarding gtid set and timeout
 // Also if the gtid information needs to be passed to client.
 type ReadAfterWrite struct {
@@ -441,7 +564,7 @@ func (m *ReadAfterWrite) Reset()         { *m = ReadAfterWrite{} }
 func (m *ReadAfterWrite) String() string { return proto.CompactTextString(m) }
 func (*ReadAfterWrite) ProtoMessage()    {}
 func (*ReadAfterWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{1}
+	return fileDescriptor_aab96496ceaf1ebb, []int{2}
 }
 func (m *ReadAfterWrite) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +637,7 @@ func (m *ExecuteRequest) Reset()         { *m = ExecuteRequest{} }
 func (m *ExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteRequest) ProtoMessage()    {}
 func (*ExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{2}
+	return fileDescriptor_aab96496ceaf1ebb, []int{3}
 }
 func (m *ExecuteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +727,7 @@ func (m *ExecuteResponse) Reset()         { *m = ExecuteResponse{} }
 func (m *ExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteResponse) ProtoMessage()    {}
 func (*ExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{3}
+	return fileDescriptor_aab96496ceaf1ebb, []int{4}
 }
 func (m *ExecuteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +801,7 @@ func (m *ExecuteBatchRequest) Reset()         { *m = ExecuteBatchRequest{} }
 func (m *ExecuteBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteBatchRequest) ProtoMessage()    {}
 func (*ExecuteBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{4}
+	return fileDescriptor_aab96496ceaf1ebb, []int{5}
 }
 func (m *ExecuteBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +898,7 @@ func (m *ExecuteBatchResponse) Reset()         { *m = ExecuteBatchResponse{} }
 func (m *ExecuteBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteBatchResponse) ProtoMessage()    {}
 func (*ExecuteBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{5}
+	return fileDescriptor_aab96496ceaf1ebb, []int{6}
 }
 func (m *ExecuteBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +971,7 @@ func (m *StreamExecuteRequest) Reset()         { *m = StreamExecuteRequest{} }
 func (m *StreamExecuteRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamExecuteRequest) ProtoMessage()    {}
 func (*StreamExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{6}
+	return fileDescriptor_aab96496ceaf1ebb, []int{7}
 }
 func (m *StreamExecuteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -936,7 +1059,7 @@ func (m *StreamExecuteResponse) Reset()         { *m = StreamExecuteResponse{} }
 func (m *StreamExecuteResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamExecuteResponse) ProtoMessage()    {}
 func (*StreamExecuteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{7}
+	return fileDescriptor_aab96496ceaf1ebb, []int{8}
 }
 func (m *StreamExecuteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,7 +1111,7 @@ func (m *ResolveTransactionRequest) Reset()         { *m = ResolveTransactionReq
 func (m *ResolveTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveTransactionRequest) ProtoMessage()    {}
 func (*ResolveTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{8}
+	return fileDescriptor_aab96496ceaf1ebb, []int{9}
 }
 func (m *ResolveTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1165,7 @@ func (m *ResolveTransactionResponse) Reset()         { *m = ResolveTransactionRe
 func (m *ResolveTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveTransactionResponse) ProtoMessage()    {}
 func (*ResolveTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{9}
+	return fileDescriptor_aab96496ceaf1ebb, []int{10}
 }
 func (m *ResolveTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1212,7 @@ func (m *VStreamRequest) Reset()         { *m = VStreamRequest{} }
 func (m *VStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*VStreamRequest) ProtoMessage()    {}
 func (*VStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{10}
+	return fileDescriptor_aab96496ceaf1ebb, []int{11}
 }
 func (m *VStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,7 +1281,7 @@ func (m *VStreamResponse) Reset()         { *m = VStreamResponse{} }
 func (m *VStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*VStreamResponse) ProtoMessage()    {}
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab96496ceaf1ebb, []int{11}
+	return fileDescriptor_aab96496ceaf1ebb, []int{12}
 }
 func (m *VStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,6 +1324,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
 	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
+	proto.RegisterType((*CapturedVSchemaDDL)(nil), "vtgate.CapturedVSchemaDDL")
 	proto.RegisterType((*ReadAfterWrite)(nil), "vtgate.ReadAfterWrite")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
@@ -1217,95 +1341,106 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0x37,
-	0x16, 0xce, 0xe8, 0x5f, 0x47, 0x7f, 0x63, 0x5a, 0x76, 0x26, 0xde, 0xac, 0x57, 0x50, 0x12, 0x44,
-	0xc9, 0x2e, 0xec, 0x5d, 0x6f, 0x8b, 0x06, 0x45, 0x8b, 0xd6, 0x96, 0x9d, 0x54, 0x81, 0x1d, 0xb9,
-	0x94, 0x6c, 0x03, 0x45, 0x8b, 0xc1, 0x58, 0x43, 0xcb, 0x84, 0xe5, 0xa1, 0x42, 0x52, 0x72, 0xf5,
-	0x14, 0xbd, 0x2d, 0xfa, 0x02, 0xbd, 0xe9, 0x7d, 0x5f, 0xa1, 0xe8, 0x55, 0xf3, 0x06, 0x45, 0xfa,
-	0x22, 0x05, 0xc9, 0x19, 0x79, 0xa4, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0xc2, 0xf0, 0x7c, 0x87, 0x87,
-	0x87, 0xe7, 0x3b, 0x3f, 0x14, 0x14, 0xc7, 0xb2, 0xef, 0x49, 0xb2, 0x36, 0xe4, 0x4c, 0x32, 0x94,
-	0x31, 0xab, 0x15, 0xfb, 0x98, 0x06, 0x03, 0xd6, 0xf7, 0x3d, 0xe9, 0x19, 0x64, 0xa5, 0xf0, 0x7c,
-	0x44, 0xf8, 0x24, 0x5c, 0x94, 0x25, 0x1b, 0xb2, 0x38, 0x38, 0x96, 0x7c, 0xd8, 0x33, 0x8b, 0xfa,
-	0x8b, 0x02, 0x64, 0x3b, 0x44, 0x08, 0xca, 0x02, 0x74, 0x0f, 0xca, 0x34, 0x70, 0x25, 0xf7, 0x02,
-	0xe1, 0xf5, 0x24, 0x65, 0x81, 0x63, 0xd5, 0xac, 0x46, 0x0e, 0x97, 0x68, 0xd0, 0xbd, 0x14, 0xa2,
-	0x26, 0x94, 0xc5, 0xa9, 0xc7, 0x7d, 0x57, 0x98, 0x7d, 0xc2, 0x49, 0xd4, 0x92, 0x8d, 0xc2, 0xc6,
-	0xed, 0xb5, 0xd0, 0xbb, 0xd0, 0xde, 0x5a, 0x47, 0x69, 0x85, 0x0b, 0x5c, 0x12, 0xb1, 0x95, 0x40,
-	0xab, 0x00, 0xde, 0x48, 0xb2, 0x1e, 0x3b, 0x3f, 0xa7, 0xd2, 0x49, 0xe9, 0x73, 0x62, 0x12, 0x74,
-	0x07, 0x4a, 0xd2, 0xe3, 0x7d, 0x22, 0x5d, 0x21, 0x39, 0x0d, 0xfa, 0x4e, 0xba, 0x66, 0x35, 0xf2,
-	0xb8, 0x68, 0x84, 0x1d, 0x2d, 0x43, 0xeb, 0x90, 0x65, 0x43, 0xa9, 0x5d, 0xc8, 0xd4, 0xac, 0x46,
-	0x61, 0x63, 0x69, 0xcd, 0x5c, 0x7c, 0xe7, 0x5b, 0xd2, 0x1b, 0x49, 0xd2, 0x36, 0x20, 0x8e, 0xb4,
-	0xd0, 0x16, 0xd8, 0xb1, 0xeb, 0xb9, 0xe7, 0xcc, 0x27, 0x4e, 0xb6, 0x66, 0x35, 0xca, 0x1b, 0x37,
-	0x23, 0xe7, 0x63, 0x37, 0xdd, 0x63, 0x3e, 0xc1, 0x15, 0x39, 0x2b, 0x40, 0xeb, 0x90, 0xbb, 0xf0,
-	0x78, 0x40, 0x83, 0xbe, 0x70, 0x72, 0xfa, 0xe2, 0x8b, 0xe1, 0xa9, 0x5f, 0xaa, 0xdf, 0x23, 0x83,
-	0xe1, 0xa9, 0x12, 0xfa, 0x0c, 0x8a, 0x43, 0x4e, 0x2e, 0xa3, 0x95, 0xbf, 0x46, 0xb4, 0x0a, 0x43,
-	0x4e, 0xa6, 0xb1, 0xda, 0x84, 0xd2, 0x90, 0x09, 0x79, 0x69, 0x01, 0xae, 0x61, 0xa1, 0xa8, 0xb6,
-	0x4c, 0x4d, 0xdc, 0x85, 0xf2, 0xc0, 0x13, 0xd2, 0xa5, 0x81, 0x20, 0x5c, 0xba, 0xd4, 0x77, 0x0a,
-	0x35, 0xab, 0x91, 0xc2, 0x45, 0x25, 0x6d, 0x69, 0x61, 0xcb, 0x47, 0xff, 0x04, 0x38, 0x61, 0xa3,
-	0xc0, 0x77, 0x39, 0xbb, 0x10, 0x4e, 0x51, 0x6b, 0xe4, 0xb5, 0x04, 0xb3, 0x0b, 0x81, 0x5c, 0x58,
-	0x1e, 0x09, 0xc2, 0x5d, 0x9f, 0x9c, 0xd0, 0x80, 0xf8, 0xee, 0xd8, 0xe3, 0xd4, 0x3b, 0x1e, 0x10,
-	0xe1, 0x94, 0xb4, 0x43, 0x0f, 0xe6, 0x1d, 0x3a, 0x10, 0x84, 0x6f, 0x1b, 0xe5, 0xc3, 0x48, 0x77,
-	0x27, 0x90, 0x7c, 0x82, 0xab, 0xa3, 0x2b, 0x20, 0xd4, 0x06, 0x5b, 0x4c, 0x84, 0x24, 0xe7, 0x31,
-	0xd3, 0x65, 0x6d, 0xfa, 0xee, 0x2b, 0x77, 0xd5, 0x7a, 0x73, 0x56, 0x2b, 0x62, 0x56, 0x8a, 0xfe,
-	0x01, 0x79, 0xce, 0x2e, 0xdc, 0x1e, 0x1b, 0x05, 0xd2, 0xa9, 0xd4, 0xac, 0x46, 0x12, 0xe7, 0x38,
-	0xbb, 0x68, 0xaa, 0xb5, 0x4a, 0x41, 0xe1, 0x8d, 0xc9, 0x90, 0xd1, 0x40, 0x0a, 0xc7, 0xae, 0x25,
-	0x1b, 0x79, 0x1c, 0x93, 0xa0, 0x06, 0xd8, 0x34, 0x70, 0x39, 0x11, 0x84, 0x8f, 0x89, 0xef, 0xf6,
-	0x58, 0x10, 0x38, 0x0b, 0x3a, 0x51, 0xcb, 0x34, 0xc0, 0xa1, 0xb8, 0xc9, 0x82, 0x40, 0x31, 0x3c,
-	0x60, 0xbd, 0xb3, 0x88, 0x20, 0x07, 0xe9, 0x64, 0x7c, 0x0d, 0xc3, 0x6a, 0x47, 0x54, 0x79, 0x6b,
-	0xb0, 0xa8, 0xe9, 0xd1, 0x56, 0x4e, 0x89, 0xc7, 0xe5, 0x31, 0xf1, 0xa4, 0xb3, 0xa8, 0x3d, 0x5e,
-	0x50, 0xd0, 0x2e, 0xeb, 0x9d, 0x7d, 0x11, 0x01, 0xe8, 0x73, 0xb0, 0x39, 0xf1, 0x7c, 0xd7, 0x3b,
-	0x91, 0x84, 0xbb, 0x17, 0x9c, 0x4a, 0xe2, 0x54, 0xf5, 0xa1, 0xcb, 0xd1, 0xa1, 0x98, 0x78, 0xfe,
-	0xa6, 0x82, 0x8f, 0x14, 0x8a, 0xcb, 0x7c, 0x66, 0x8d, 0x6a, 0x50, 0xd8, 0xde, 0xde, 0xed, 0x48,
-	0xee, 0x49, 0xd2, 0x9f, 0x38, 0x4b, 0xba, 0xba, 0xe2, 0x22, 0xa5, 0x11, 0xba, 0x77, 0x70, 0xd0,
-	0xda, 0x76, 0x96, 0x8d, 0x46, 0x4c, 0x84, 0x3e, 0x80, 0x65, 0x12, 0xa8, 0x40, 0xbb, 0x21, 0x6b,
-	0x82, 0x48, 0xa9, 0xeb, 0xe2, 0xa6, 0x0e, 0x53, 0xd5, 0xa0, 0x86, 0xaa, 0x4e, 0x88, 0xad, 0xfc,
-	0x6c, 0x41, 0x31, 0x1e, 0x09, 0x74, 0x0f, 0x32, 0xa6, 0xaa, 0x75, 0xbb, 0x29, 0x6c, 0x94, 0xc2,
-	0x72, 0xea, 0x6a, 0x21, 0x0e, 0x41, 0xd5, 0x9d, 0xe2, 0xb5, 0x4b, 0x7d, 0x27, 0xa1, 0xc3, 0x53,
-	0x8a, 0x49, 0x5b, 0x3e, 0x7a, 0x04, 0x45, 0xa9, 0x4e, 0x95, 0xae, 0x37, 0xa0, 0x9e, 0x70, 0x92,
-	0x61, 0x63, 0x98, 0x36, 0xc1, 0xae, 0x46, 0x37, 0x15, 0x88, 0x0b, 0xf2, 0x72, 0x81, 0xfe, 0x05,
-	0x85, 0x29, 0xd9, 0xd4, 0xd7, 0x3d, 0x29, 0x89, 0x21, 0x12, 0xb5, 0xfc, 0x95, 0xaf, 0xe1, 0xd6,
-	0x5f, 0x66, 0x34, 0xb2, 0x21, 0x79, 0x46, 0x26, 0xfa, 0x0a, 0x79, 0xac, 0x3e, 0xd1, 0x03, 0x48,
-	0x8f, 0xbd, 0xc1, 0x88, 0x68, 0x3f, 0x2f, 0xbb, 0xc4, 0x16, 0x0d, 0xa6, 0x7b, 0xb1, 0xd1, 0xf8,
-	0x38, 0xf1, 0xc8, 0x5a, 0xd9, 0x82, 0xea, 0x55, 0x49, 0x7d, 0x85, 0xe1, 0x6a, 0xdc, 0x70, 0x3e,
-	0x66, 0xe3, 0x69, 0x2a, 0x97, 0xb4, 0x53, 0xf5, 0x9f, 0x2c, 0x28, 0xcf, 0xd2, 0x8f, 0xfe, 0x07,
-	0x4b, 0xf3, 0x09, 0xe3, 0xf6, 0x25, 0xf5, 0x43, 0xb3, 0x68, 0x36, 0x3b, 0x9e, 0x48, 0xea, 0xa3,
-	0x8f, 0xc0, 0x79, 0x65, 0x8b, 0xa4, 0xe7, 0x84, 0x8d, 0xa4, 0x3e, 0xd8, 0xc2, 0x4b, 0xb3, 0xbb,
-	0xba, 0x06, 0x54, 0xc9, 0x1c, 0x16, 0x82, 0x9a, 0x25, 0xbd, 0x33, 0x7d, 0x90, 0x21, 0x22, 0x87,
-	0x17, 0x42, 0xa8, 0xab, 0x10, 0x75, 0x8e, 0xa8, 0xff, 0x98, 0x80, 0x72, 0xd8, 0xb0, 0x31, 0x79,
-	0x3e, 0x22, 0x42, 0xa2, 0xff, 0x40, 0xbe, 0xe7, 0x0d, 0x06, 0x84, 0xbb, 0xa1, 0x8b, 0x85, 0x8d,
-	0xca, 0x9a, 0x19, 0x5b, 0x4d, 0x2d, 0x6f, 0x6d, 0xe3, 0x9c, 0xd1, 0x68, 0xf9, 0xe8, 0x01, 0x64,
-	0xa3, 0xca, 0x4b, 0x4c, 0x75, 0xe3, 0x95, 0x87, 0x23, 0x1c, 0xdd, 0x87, 0xb4, 0x66, 0x21, 0x4c,
-	0x8b, 0x85, 0x88, 0x13, 0xd5, 0xe3, 0x74, 0xfb, 0xc6, 0x06, 0x47, 0x1f, 0x42, 0x98, 0x1b, 0xae,
-	0x9c, 0x0c, 0x89, 0x4e, 0x86, 0xf2, 0x46, 0x75, 0x3e, 0x8b, 0xba, 0x93, 0x21, 0xc1, 0x20, 0xa7,
-	0xdf, 0x2a, 0x49, 0xcf, 0xc8, 0x44, 0x0c, 0xbd, 0x1e, 0x71, 0xf5, 0xc0, 0xd3, 0x83, 0x29, 0x8f,
-	0x4b, 0x91, 0x54, 0x67, 0x7e, 0x7c, 0x70, 0x65, 0xaf, 0x33, 0xb8, 0x9e, 0xa6, 0x72, 0x69, 0x3b,
-	0x53, 0xff, 0xce, 0x82, 0xca, 0x34, 0x52, 0x62, 0xc8, 0x02, 0xa1, 0x4e, 0x4c, 0x13, 0xce, 0x19,
-	0x9f, 0x0b, 0x13, 0xde, 0x6f, 0xee, 0x28, 0x31, 0x36, 0xe8, 0x9b, 0xc4, 0xe8, 0x21, 0x64, 0x38,
-	0x11, 0xa3, 0x81, 0x0c, 0x83, 0x84, 0xe2, 0xe3, 0x0d, 0x6b, 0x04, 0x87, 0x1a, 0xf5, 0x17, 0x09,
-	0x58, 0x0c, 0x3d, 0xda, 0xf2, 0x64, 0xef, 0xf4, 0xbd, 0x13, 0xf8, 0x6f, 0xc8, 0x2a, 0x6f, 0x28,
-	0x51, 0x09, 0x95, 0xbc, 0x9a, 0xc2, 0x48, 0xe3, 0x1d, 0x48, 0xf4, 0xc4, 0xcc, 0x3b, 0x28, 0x6d,
-	0xde, 0x41, 0x9e, 0x88, 0xbf, 0x83, 0xde, 0x13, 0xd7, 0xf5, 0x1f, 0x2c, 0xa8, 0xce, 0xc6, 0xf4,
-	0xbd, 0x51, 0xfd, 0x5f, 0xc8, 0x1a, 0x22, 0xa3, 0x68, 0x2e, 0x87, 0xbe, 0x19, 0x9a, 0x8f, 0xa8,
-	0x3c, 0x35, 0xa6, 0x23, 0x35, 0x55, 0xac, 0xd5, 0x8e, 0xe4, 0xc4, 0x3b, 0x7f, 0xa7, 0x92, 0x9d,
-	0xd6, 0x61, 0xe2, 0xcd, 0xea, 0x30, 0xf9, 0xd6, 0x75, 0x98, 0x7a, 0x0d, 0x37, 0xe9, 0x6b, 0x3d,
-	0x20, 0x63, 0xb1, 0xcd, 0xfc, 0x7d, 0x6c, 0xeb, 0x4d, 0x58, 0x9a, 0x0b, 0x54, 0x48, 0xe3, 0x65,
-	0x7d, 0x59, 0xaf, 0xad, 0xaf, 0x6f, 0xe0, 0x16, 0x26, 0x82, 0x0d, 0xc6, 0x24, 0x96, 0x79, 0x6f,
-	0x17, 0x72, 0x04, 0x29, 0x5f, 0x86, 0x53, 0x33, 0x8f, 0xf5, 0x77, 0xfd, 0x36, 0xac, 0x5c, 0x65,
-	0xde, 0x38, 0x5a, 0xff, 0xd5, 0x82, 0xf2, 0xa1, 0xb9, 0xc3, 0xdb, 0x1d, 0x39, 0x47, 0x5e, 0xe2,
-	0x9a, 0xe4, 0xdd, 0x87, 0xf4, 0x58, 0x0f, 0xa7, 0xa8, 0x49, 0xc7, 0xfe, 0xdf, 0x1c, 0xaa, 0x99,
-	0x81, 0x0d, 0xae, 0x22, 0x79, 0x42, 0x07, 0x92, 0x70, 0xcd, 0xae, 0x8a, 0x64, 0x4c, 0xf3, 0xb1,
-	0x46, 0x70, 0xa8, 0x51, 0xff, 0x14, 0x2a, 0xd3, 0xbb, 0x5c, 0x12, 0x41, 0xc6, 0x44, 0x3d, 0xfe,
-	0x2c, 0x9d, 0xfc, 0x33, 0xdb, 0x0f, 0x77, 0x14, 0x84, 0x43, 0x8d, 0x87, 0xdb, 0x50, 0x99, 0xfb,
-	0x67, 0x80, 0x2a, 0x50, 0x38, 0x78, 0xd6, 0xd9, 0xdf, 0x69, 0xb6, 0x1e, 0xb7, 0x76, 0xb6, 0xed,
-	0x1b, 0x08, 0x20, 0xd3, 0x69, 0x3d, 0x7b, 0xb2, 0xbb, 0x63, 0x5b, 0x28, 0x0f, 0xe9, 0xbd, 0x83,
-	0xdd, 0x6e, 0xcb, 0x4e, 0xa8, 0xcf, 0xee, 0x51, 0x7b, 0xbf, 0x69, 0x27, 0x1f, 0x7e, 0x02, 0x85,
-	0xa6, 0xfe, 0x7f, 0xd3, 0xe6, 0x3e, 0xe1, 0x6a, 0xc3, 0xb3, 0x36, 0xde, 0xdb, 0xdc, 0xb5, 0x6f,
-	0xa0, 0x2c, 0x24, 0xf7, 0xb1, 0xda, 0x99, 0x83, 0xd4, 0x7e, 0xbb, 0xd3, 0xb5, 0x13, 0xa8, 0x0c,
-	0xb0, 0x79, 0xd0, 0x6d, 0x37, 0xdb, 0x7b, 0x7b, 0xad, 0xae, 0x9d, 0xdc, 0x7a, 0xfc, 0xcb, 0xcb,
-	0x55, 0xeb, 0xb7, 0x97, 0xab, 0xd6, 0xef, 0x2f, 0x57, 0xad, 0xef, 0xff, 0x58, 0xbd, 0x01, 0x15,
-	0xca, 0xd6, 0xc6, 0x54, 0x12, 0x21, 0xcc, 0xdf, 0xb9, 0xaf, 0xee, 0x84, 0x2b, 0xca, 0xd6, 0xcd,
-	0xd7, 0x7a, 0x9f, 0xad, 0x8f, 0xe5, 0xba, 0x46, 0xd7, 0x4d, 0xaa, 0x1e, 0x67, 0xf4, 0xea, 0xff,
-	0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x21, 0x3c, 0xb1, 0x4e, 0x0e, 0x00, 0x00,
+	// 1578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xef, 0x6e, 0x23, 0x49,
+	0x11, 0xdf, 0xf1, 0x9f, 0xc4, 0x2e, 0xc7, 0xf6, 0xa4, 0xe3, 0xe4, 0x66, 0x7d, 0x7b, 0xc1, 0xf2,
+	0xdd, 0xe9, 0xbc, 0x0b, 0x4a, 0x20, 0x07, 0x62, 0x85, 0x40, 0x90, 0xd8, 0xc9, 0xe1, 0x25, 0xd9,
+	0x84, 0xb6, 0x93, 0x95, 0x10, 0x68, 0xd4, 0xf1, 0x74, 0x92, 0x21, 0xe3, 0x69, 0x5f, 0x77, 0xdb,
+	0xc1, 0xef, 0x80, 0xc4, 0x57, 0xc4, 0x0b, 0xf0, 0x85, 0xef, 0xbc, 0x02, 0xe2, 0x13, 0xbc, 0x01,
+	0x5a, 0x5e, 0x04, 0xf5, 0x9f, 0xb1, 0xc7, 0xde, 0x70, 0x9b, 0xdb, 0xd5, 0x7e, 0xb1, 0xa6, 0xeb,
+	0x57, 0x55, 0x5d, 0x5d, 0xbf, 0xaa, 0xea, 0x36, 0xac, 0x4d, 0xe4, 0x35, 0x91, 0x74, 0x67, 0xc4,
+	0x99, 0x64, 0x68, 0xc5, 0xac, 0xea, 0xee, 0x65, 0x18, 0x47, 0xec, 0x3a, 0x20, 0x92, 0x18, 0xa4,
+	0x5e, 0xfa, 0x7a, 0x4c, 0xf9, 0xd4, 0x2e, 0x2a, 0x92, 0x8d, 0x58, 0x1a, 0x9c, 0x48, 0x3e, 0x1a,
+	0x98, 0x45, 0xf3, 0x8f, 0x15, 0x58, 0xed, 0x51, 0x21, 0x42, 0x16, 0xa3, 0xcf, 0xa1, 0x12, 0xc6,
+	0xbe, 0xe4, 0x24, 0x16, 0x64, 0x20, 0x43, 0x16, 0x7b, 0x4e, 0xc3, 0x69, 0x15, 0x70, 0x39, 0x8c,
+	0xfb, 0x73, 0x21, 0x6a, 0x43, 0x45, 0xdc, 0x10, 0x1e, 0xf8, 0xc2, 0xd8, 0x09, 0x2f, 0xd3, 0xc8,
+	0xb6, 0x4a, 0x7b, 0x4f, 0x76, 0x6c, 0x74, 0xd6, 0xdf, 0x4e, 0x4f, 0x69, 0xd9, 0x05, 0x2e, 0x8b,
+	0xd4, 0x4a, 0xa0, 0x6d, 0x00, 0x32, 0x96, 0x6c, 0xc0, 0x86, 0xc3, 0x50, 0x7a, 0x39, 0xbd, 0x4f,
+	0x4a, 0x82, 0x3e, 0x85, 0xb2, 0x24, 0xfc, 0x9a, 0x4a, 0x5f, 0x48, 0x1e, 0xc6, 0xd7, 0x5e, 0xbe,
+	0xe1, 0xb4, 0x8a, 0x78, 0xcd, 0x08, 0x7b, 0x5a, 0x86, 0x76, 0x61, 0x95, 0x8d, 0xa4, 0x0e, 0x61,
+	0xa5, 0xe1, 0xb4, 0x4a, 0x7b, 0x9b, 0x3b, 0xe6, 0xe0, 0x87, 0x7f, 0xa0, 0x83, 0xb1, 0xa4, 0xa7,
+	0x06, 0xc4, 0x89, 0x16, 0x3a, 0x00, 0x37, 0x75, 0x3c, 0x7f, 0xc8, 0x02, 0xea, 0xad, 0x36, 0x9c,
+	0x56, 0x65, 0xef, 0xa3, 0x24, 0xf8, 0xd4, 0x49, 0x4f, 0x58, 0x40, 0x71, 0x55, 0x2e, 0x0a, 0xd0,
+	0x2e, 0x14, 0xee, 0x08, 0x8f, 0xc3, 0xf8, 0x5a, 0x78, 0x05, 0x7d, 0xf0, 0x0d, 0xbb, 0xeb, 0xaf,
+	0xd5, 0xef, 0x2b, 0x83, 0xe1, 0x99, 0x12, 0xfa, 0x39, 0xac, 0x8d, 0x38, 0x9d, 0x67, 0xab, 0xf8,
+	0x80, 0x6c, 0x95, 0x46, 0x9c, 0xce, 0x72, 0xb5, 0x0f, 0xe5, 0x11, 0x13, 0x72, 0xee, 0x01, 0x1e,
+	0xe0, 0x61, 0x4d, 0x99, 0xcc, 0x5c, 0x7c, 0x06, 0x95, 0x88, 0x08, 0xe9, 0x87, 0xb1, 0xa0, 0x5c,
+	0xfa, 0x61, 0xe0, 0x95, 0x1a, 0x4e, 0x2b, 0x87, 0xd7, 0x94, 0xb4, 0xab, 0x85, 0xdd, 0x00, 0x7d,
+	0x02, 0x70, 0xc5, 0xc6, 0x71, 0xe0, 0x73, 0x76, 0x27, 0xbc, 0x35, 0xad, 0x51, 0xd4, 0x12, 0xcc,
+	0xee, 0x04, 0xf2, 0x61, 0x6b, 0x2c, 0x28, 0xf7, 0x03, 0x7a, 0x15, 0xc6, 0x34, 0xf0, 0x27, 0x84,
+	0x87, 0xe4, 0x32, 0xa2, 0xc2, 0x2b, 0xeb, 0x80, 0x9e, 0x2e, 0x07, 0x74, 0x2e, 0x28, 0xef, 0x18,
+	0xe5, 0x8b, 0x44, 0xf7, 0x30, 0x96, 0x7c, 0x8a, 0x6b, 0xe3, 0x7b, 0x20, 0x74, 0x0a, 0xae, 0x98,
+	0x0a, 0x49, 0x87, 0x29, 0xd7, 0x15, 0xed, 0xfa, 0xb3, 0x37, 0xce, 0xaa, 0xf5, 0x96, 0xbc, 0x56,
+	0xc5, 0xa2, 0x14, 0x7d, 0x0c, 0x45, 0xce, 0xee, 0xfc, 0x01, 0x1b, 0xc7, 0xd2, 0xab, 0x36, 0x9c,
+	0x56, 0x16, 0x17, 0x38, 0xbb, 0x6b, 0xab, 0xb5, 0x2a, 0x41, 0x41, 0x26, 0x74, 0xc4, 0xc2, 0x58,
+	0x0a, 0xcf, 0x6d, 0x64, 0x5b, 0x45, 0x9c, 0x92, 0xa0, 0x16, 0xb8, 0x61, 0xec, 0x73, 0x2a, 0x28,
+	0x9f, 0xd0, 0xc0, 0x1f, 0xb0, 0x38, 0xf6, 0xd6, 0x75, 0xa1, 0x56, 0xc2, 0x18, 0x5b, 0x71, 0x9b,
+	0xc5, 0xb1, 0x62, 0x38, 0x62, 0x83, 0xdb, 0x84, 0x20, 0x0f, 0x35, 0x9c, 0xb7, 0xf2, 0x53, 0x52,
+	0x16, 0x76, 0x81, 0x76, 0x60, 0x43, 0xd3, 0xa3, 0xbd, 0xdc, 0x50, 0xc2, 0xe5, 0x25, 0x25, 0xd2,
+	0xdb, 0xd0, 0x11, 0xaf, 0x2b, 0xe8, 0x98, 0x0d, 0x6e, 0x7f, 0x99, 0x00, 0xe8, 0x17, 0xe0, 0x72,
+	0x4a, 0x02, 0x9f, 0x5c, 0x49, 0xca, 0xfd, 0x3b, 0x1e, 0x4a, 0xea, 0xd5, 0xf4, 0xa6, 0x5b, 0xc9,
+	0xa6, 0x98, 0x92, 0x60, 0x5f, 0xc1, 0xaf, 0x14, 0x8a, 0x2b, 0x7c, 0x61, 0x8d, 0x1a, 0x50, 0xea,
+	0x74, 0x8e, 0x7b, 0x92, 0x13, 0x49, 0xaf, 0xa7, 0xde, 0xa6, 0xee, 0xae, 0xb4, 0x48, 0x69, 0xd8,
+	0xf0, 0xce, 0xcf, 0xbb, 0x1d, 0x6f, 0xcb, 0x68, 0xa4, 0x44, 0xe8, 0x87, 0xb0, 0x45, 0x63, 0x95,
+	0x68, 0xdf, 0xb2, 0x26, 0xa8, 0x94, 0xba, 0x2f, 0x3e, 0xd2, 0x69, 0xaa, 0x19, 0xd4, 0x50, 0xd5,
+	0xb3, 0x18, 0x6a, 0x42, 0x39, 0x08, 0x22, 0xff, 0x8a, 0x84, 0xea, 0x47, 0x48, 0xcf, 0xd3, 0xca,
+	0xa5, 0x20, 0x88, 0x8e, 0x48, 0x18, 0x1d, 0x11, 0x21, 0xd1, 0x97, 0xb0, 0xa5, 0x74, 0x02, 0xce,
+	0x46, 0xfe, 0x44, 0x0c, 0x6e, 0xe8, 0x90, 0xf8, 0x52, 0xf9, 0xf2, 0x1e, 0x6b, 0xe5, 0x8d, 0x20,
+	0x88, 0x3a, 0x9c, 0x8d, 0x2e, 0x0c, 0xd6, 0x57, 0x90, 0xe2, 0x2b, 0xd1, 0x55, 0xc6, 0xbf, 0x17,
+	0x2c, 0xf6, 0xea, 0x86, 0x2f, 0x2b, 0xef, 0x04, 0xd1, 0x0b, 0x61, 0xd2, 0x3d, 0x20, 0x23, 0x39,
+	0xe6, 0xd4, 0x4f, 0x59, 0x78, 0x1f, 0x6b, 0xe5, 0x75, 0x0b, 0x5d, 0xcc, 0x6c, 0xd0, 0x31, 0xd4,
+	0xac, 0x30, 0x58, 0x30, 0x78, 0xa2, 0x6b, 0xb3, 0x9e, 0xa4, 0xbc, 0x6d, 0x75, 0x2e, 0x7a, 0xc6,
+	0xb2, 0x73, 0x8c, 0x51, 0x62, 0x97, 0xf2, 0xf6, 0x1c, 0xbc, 0x99, 0x13, 0x7a, 0x45, 0xc6, 0x91,
+	0xf4, 0x6f, 0xe9, 0x54, 0x8c, 0xc8, 0x80, 0x7a, 0x9f, 0xe8, 0x2c, 0x6f, 0x25, 0xf1, 0x1a, 0xf8,
+	0x57, 0x16, 0xad, 0xff, 0xdd, 0x81, 0xb5, 0x74, 0x11, 0xa1, 0xcf, 0x61, 0xc5, 0x0c, 0x44, 0x3d,
+	0xa9, 0x4b, 0x7b, 0x65, 0x3b, 0x89, 0xfa, 0x5a, 0x88, 0x2d, 0xa8, 0x06, 0x7b, 0x7a, 0xec, 0x85,
+	0x81, 0x97, 0xd1, 0x95, 0x55, 0x4e, 0x49, 0xbb, 0x01, 0x7a, 0x0e, 0x6b, 0x3a, 0xc9, 0xd2, 0x27,
+	0x51, 0x48, 0x84, 0x97, 0xb5, 0x33, 0x75, 0x76, 0x7f, 0xe8, 0x3c, 0xcb, 0x7d, 0x05, 0xe2, 0x92,
+	0x9c, 0x2f, 0xd0, 0x77, 0xa0, 0x34, 0xeb, 0x93, 0x30, 0xd0, 0xe3, 0x3c, 0x8b, 0x21, 0x11, 0x75,
+	0x83, 0xfa, 0x6f, 0xe1, 0xf1, 0xff, 0x1d, 0x06, 0xc8, 0x85, 0xec, 0x2d, 0x9d, 0xea, 0x23, 0x14,
+	0xb1, 0xfa, 0x44, 0x4f, 0x21, 0x3f, 0x21, 0xd1, 0x98, 0xea, 0x38, 0xe7, 0x03, 0xf6, 0x20, 0x8c,
+	0x67, 0xb6, 0xd8, 0x68, 0xfc, 0x24, 0xf3, 0xdc, 0xa9, 0x1f, 0x40, 0xed, 0xbe, 0x79, 0x70, 0x8f,
+	0xe3, 0x5a, 0xda, 0x71, 0x31, 0xe5, 0xe3, 0x45, 0xae, 0x90, 0x75, 0x73, 0xcd, 0x97, 0x80, 0xde,
+	0x64, 0x11, 0xd5, 0xa1, 0x30, 0x63, 0xc8, 0x38, 0x9b, 0xad, 0xd1, 0x13, 0x28, 0x0a, 0x49, 0x24,
+	0x1d, 0xd2, 0x58, 0x5a, 0xaf, 0x73, 0x41, 0xf3, 0x6f, 0x0e, 0x54, 0x16, 0x3b, 0x11, 0xfd, 0x00,
+	0x36, 0x97, 0x7b, 0xd7, 0xbf, 0x96, 0x61, 0x60, 0x3d, 0xa3, 0xc5, 0x46, 0xfd, 0x4a, 0x86, 0x01,
+	0xfa, 0x31, 0x78, 0x6f, 0x98, 0xc8, 0x70, 0x48, 0xd9, 0xd8, 0x6c, 0xe9, 0xe0, 0xcd, 0x45, 0xab,
+	0xbe, 0x01, 0x55, 0xa1, 0xdb, 0x99, 0xa4, 0xae, 0xf5, 0xc1, 0xad, 0xde, 0xc8, 0x10, 0x5b, 0xc0,
+	0xeb, 0x16, 0xea, 0x2b, 0x44, 0xed, 0x23, 0x9a, 0x7f, 0xcd, 0x40, 0xc5, 0xde, 0x9d, 0x98, 0x7e,
+	0x3d, 0xa6, 0x42, 0xa2, 0xef, 0x41, 0x71, 0x40, 0xa2, 0x88, 0x72, 0xdf, 0x86, 0x58, 0xda, 0xab,
+	0xee, 0x98, 0x17, 0x44, 0x5b, 0xcb, 0xbb, 0x1d, 0x5c, 0x30, 0x1a, 0xdd, 0x00, 0x3d, 0x85, 0xd5,
+	0x64, 0x08, 0x66, 0x66, 0xba, 0xe9, 0x21, 0x88, 0x13, 0x1c, 0x7d, 0x01, 0x79, 0xcd, 0xaa, 0x2d,
+	0xb3, 0xf5, 0x84, 0x63, 0x75, 0xdd, 0xe8, 0x9b, 0x14, 0x1b, 0x1c, 0xfd, 0x08, 0x6c, 0xad, 0xf9,
+	0x72, 0x3a, 0xa2, 0xba, 0xb8, 0x2a, 0x7b, 0xb5, 0xe5, 0xaa, 0xec, 0x4f, 0x47, 0x14, 0x83, 0x9c,
+	0x7d, 0xab, 0xa2, 0x4f, 0x48, 0xf2, 0xf5, 0xdb, 0x43, 0xbf, 0x11, 0x8a, 0xb8, 0x9c, 0x48, 0x75,
+	0x27, 0xa5, 0xdf, 0x10, 0xab, 0x0f, 0x79, 0x43, 0xbc, 0xc8, 0x15, 0xf2, 0xee, 0x4a, 0xf3, 0x4f,
+	0x0e, 0x54, 0x67, 0x99, 0x12, 0x23, 0x16, 0x0b, 0xb5, 0x63, 0x9e, 0x72, 0xce, 0xf8, 0x52, 0x9a,
+	0xf0, 0x59, 0xfb, 0x50, 0x89, 0xb1, 0x41, 0xbf, 0x4d, 0x8e, 0x9e, 0xc1, 0x0a, 0xa7, 0x62, 0x1c,
+	0x49, 0x9b, 0x24, 0x94, 0x7e, 0x69, 0x60, 0x8d, 0x60, 0xab, 0xd1, 0xfc, 0x77, 0x06, 0x36, 0x6c,
+	0x44, 0x07, 0x44, 0x0e, 0x6e, 0x3e, 0x38, 0x81, 0xdf, 0x85, 0x55, 0x15, 0x4d, 0x48, 0x55, 0x41,
+	0x65, 0xef, 0xa7, 0x30, 0xd1, 0x78, 0x0f, 0x12, 0x89, 0x58, 0x78, 0x92, 0xe6, 0xcd, 0x93, 0x94,
+	0x88, 0xf4, 0x93, 0xf4, 0x03, 0x71, 0xdd, 0xfc, 0x8b, 0x03, 0xb5, 0xc5, 0x9c, 0x7e, 0x30, 0xaa,
+	0xbf, 0x0f, 0xab, 0x86, 0xc8, 0x24, 0x9b, 0x5b, 0x36, 0x36, 0x43, 0xf3, 0xab, 0x50, 0xde, 0x18,
+	0xd7, 0x89, 0x9a, 0x6a, 0xd6, 0x5a, 0x4f, 0x72, 0x4a, 0x86, 0xef, 0xd5, 0xb2, 0xb3, 0x3e, 0xcc,
+	0x7c, 0xbb, 0x3e, 0xcc, 0xbe, 0x73, 0x1f, 0xe6, 0xde, 0xc2, 0x4d, 0xfe, 0x41, 0x6f, 0xf9, 0x54,
+	0x6e, 0x57, 0xbe, 0x39, 0xb7, 0xcd, 0x36, 0x6c, 0x2e, 0x25, 0xca, 0xd2, 0x38, 0xef, 0x2f, 0xe7,
+	0xad, 0xfd, 0xf5, 0x3b, 0x78, 0x8c, 0xa9, 0x60, 0xd1, 0x84, 0xa6, 0x2a, 0xef, 0xdd, 0x52, 0x8e,
+	0x20, 0x17, 0x48, 0x7b, 0x0b, 0x17, 0xb1, 0xfe, 0x6e, 0x3e, 0x81, 0xfa, 0x7d, 0xee, 0x4d, 0xa0,
+	0xcd, 0x7f, 0x3a, 0x50, 0xb9, 0x30, 0x67, 0x78, 0xb7, 0x2d, 0x97, 0xc8, 0xcb, 0x3c, 0x90, 0xbc,
+	0x2f, 0x20, 0x3f, 0xd1, 0x97, 0x53, 0x32, 0xa4, 0x53, 0x7f, 0x35, 0x2f, 0xd4, 0x9d, 0x81, 0x0d,
+	0xae, 0x32, 0x79, 0x15, 0x46, 0x92, 0x72, 0x2f, 0x67, 0x33, 0x99, 0xd2, 0x3c, 0xd2, 0x08, 0xb6,
+	0x1a, 0xcd, 0x9f, 0x41, 0x75, 0x76, 0x96, 0x39, 0x11, 0x74, 0x42, 0xd5, 0x3b, 0xdc, 0x69, 0x64,
+	0x97, 0xcd, 0x2f, 0x0e, 0x15, 0x84, 0xad, 0xc6, 0xb3, 0x0e, 0x54, 0x97, 0xfe, 0xa4, 0xa1, 0x2a,
+	0x94, 0xce, 0x5f, 0xf6, 0xce, 0x0e, 0xdb, 0xdd, 0xa3, 0xee, 0x61, 0xc7, 0x7d, 0x84, 0x00, 0x56,
+	0x7a, 0xdd, 0x97, 0x5f, 0x1d, 0x1f, 0xba, 0x0e, 0x2a, 0x42, 0xfe, 0xe4, 0xfc, 0xb8, 0xdf, 0x75,
+	0x33, 0xea, 0xb3, 0xff, 0xea, 0xf4, 0xac, 0xed, 0x66, 0x9f, 0xfd, 0x14, 0x4a, 0x6d, 0xfd, 0x57,
+	0xf3, 0x94, 0x07, 0x94, 0x2b, 0x83, 0x97, 0xa7, 0xf8, 0x64, 0xff, 0xd8, 0x7d, 0x84, 0x56, 0x21,
+	0x7b, 0x86, 0x95, 0x65, 0x01, 0x72, 0x67, 0xa7, 0xbd, 0xbe, 0x9b, 0x41, 0x15, 0x80, 0xfd, 0xf3,
+	0xfe, 0x69, 0xfb, 0xf4, 0xe4, 0xa4, 0xdb, 0x77, 0xb3, 0x07, 0x47, 0xff, 0x78, 0xbd, 0xed, 0xfc,
+	0xeb, 0xf5, 0xb6, 0xf3, 0x9f, 0xd7, 0xdb, 0xce, 0x9f, 0xff, 0xbb, 0xfd, 0x08, 0xaa, 0x21, 0xdb,
+	0x99, 0x84, 0x92, 0x0a, 0x61, 0xfe, 0x59, 0xff, 0xe6, 0x53, 0xbb, 0x0a, 0xd9, 0xae, 0xf9, 0xda,
+	0xbd, 0x66, 0xbb, 0x13, 0xb9, 0xab, 0xd1, 0x5d, 0x53, 0xaa, 0x97, 0x2b, 0x7a, 0xf5, 0xe5, 0xff,
+	0x06, 0x00, 0x71, 0xf0, 0xd5, 0x2b, 0xd9, 0x0f, 0x00, 0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -1332,6 +1467,79 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.VschemaDefaultKeyspace) > 0 {
+		i -= len(m.VschemaDefaultKeyspace)
+		copy(dAtA[i:], m.VschemaDefaultKeyspace)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.VschemaDefaultKeyspace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.CapturedVschemaDdl) > 0 {
+		for iNdEx := len(m.CapturedVschemaDdl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapturedVschemaDdl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVtgate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.CaptureVschemaDdl {
+		i--
+		if m.CaptureVschemaDdl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.VschemaDdlJson {
+		i--
+		if m.VschemaDdlJson {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.DdlDropVschemaTable {
+		i--
+		if m.DdlDropVschemaTable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.DdlFailFast {
+		i--
+		if m.DdlFailFast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	if m.EnableSystemSettings {
 		i--
 		if m.EnableSystemSettings {
@@ -1644,6 +1852,47 @@ func (m *Session_ShardSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CapturedVSchemaDDL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapturedVSchemaDDL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapturedVSchemaDDL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Statement) > 0 {
+		i -= len(m.Statement)
+		copy(dAtA[i:], m.Statement)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.Statement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReadAfterWrite) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2435,6 +2684,28 @@ func (m *Session) Size() (n int) {
 	if m.EnableSystemSettings {
 		n += 3
 	}
+	if m.DdlFailFast {
+		n += 3
+	}
+	if m.DdlDropVschemaTable {
+		n += 3
+	}
+	if m.VschemaDdlJson {
+		n += 3
+	}
+	if m.CaptureVschemaDdl {
+		n += 3
+	}
+	if len(m.CapturedVschemaDdl) > 0 {
+		for _, e := range m.CapturedVschemaDdl {
+			l = e.Size()
+			n += 2 + l + sovVtgate(uint64(l))
+		}
+	}
+	l = len(m.VschemaDefaultKeyspace)
+	if l > 0 {
+		n += 2 + l + sovVtgate(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2467,6 +2738,26 @@ func (m *Session_ShardSession) Size() (n int) {
 	return n
 }
 
+func (m *CapturedVSchemaDDL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keyspace)
+	if l > 0 {
+		n += 1 + l + sovVtgate(uint64(l))
+	}
+	l = len(m.Statement)
+	if l > 0 {
+		n += 1 + l + sovVtgate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ReadAfterWrite) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3580,6 +3871,152 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableSystemSettings = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlFailFast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DdlFailFast = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlDropVschemaTable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DdlDropVschemaTable = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VschemaDdlJson", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VschemaDdlJson = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptureVschemaDdl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CaptureVschemaDdl = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedVschemaDdl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapturedVschemaDdl = append(m.CapturedVschemaDdl, &CapturedVSchemaDDL{})
+			if err := m.CapturedVschemaDdl[len(m.CapturedVschemaDdl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VschemaDefaultKeyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VschemaDefaultKeyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtgate(dAtA[iNdEx:])
@@ -3769,6 +4206,124 @@ func (m *Session_ShardSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CapturedVSchemaDDL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtgate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapturedVSchemaDDL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapturedVSchemaDDL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtgate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ReadAfterWrite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
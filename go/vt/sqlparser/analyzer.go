@@ -199,7 +199,7 @@ func Preview(sql string) StatementType {
 		return StmtFlush
 	case "set":
 		return StmtSet
-	case "show":
+	case "show", "validate":
 		return StmtShow
 	case "use":
 		return StmtUse
@@ -67,6 +67,7 @@ func TestPreview(t *testing.T) {
 		{"drop", StmtDDL},
 		{"set", StmtSet},
 		{"show", StmtShow},
+		{"validate vschema", StmtShow},
 		{"use", StmtUse},
 		{"analyze", StmtOther},
 		{"describe", StmtExplain},
@@ -402,14 +402,70 @@ type (
 		Action DDLAction
 		Table  TableName
 
-		// VindexSpec is set for CreateVindexDDLAction, DropVindexDDLAction, AddColVindexDDLAction, DropColVindexDDLAction.
+		// VindexSpec is set for CreateVindexDDLAction, DropVindexDDLAction, AddColVindexDDLAction, DropColVindexDDLAction,
+		// ReorderColVindexDDLAction, EnableColVindexDDLAction and DisableColVindexDDLAction.
 		VindexSpec *VindexSpec
 
 		// VindexCols is set for AddColVindexDDLAction.
 		VindexCols []ColIdent
 
+		// VindexExpr is optionally set for AddColVindexDDLAction. It is
+		// the expression of the stored generated column in VindexCols.
+		VindexExpr Expr
+
+		// VindexBindings is set for AddColVindexesDDLAction.
+		VindexBindings []*VindexBinding
+
 		// AutoIncSpec is set for AddAutoIncDDLAction.
 		AutoIncSpec *AutoIncSpec
+
+		// ParentSpec is set for SetParentTableDDLAction.
+		ParentSpec *ParentSpec
+
+		// SequenceParams is optionally set for AddSequenceDDLAction.
+		SequenceParams []VindexParam
+
+		// ReferenceSource is optionally set for AddReferenceTableDDLAction.
+		ReferenceSource TableName
+
+		// Cascade is set for DropColVindexDDLAction and
+		// DropAllColVindexesDDLAction to remove the table entry once its
+		// last vindex is dropped. Without it, the entry is kept, and a
+		// table of a sharded keyspace has to be set as scatter first.
+		Cascade bool
+
+		// Force is set for DropColVindexDDLAction to drop the primary
+		// vindex of a table even if the next vindex can't replace it.
+		Force bool
+
+		// Scatter is the value set by SetScatterTableDDLAction.
+		Scatter bool
+
+		// NewName is set for RenameVschemaTableDDLAction. For
+		// CopyKeyspaceDDLAction, the source keyspace is the qualifier of
+		// Table and the destination keyspace the qualifier of NewName.
+		// For AddRoutingRuleDDLAction, it is the table the queries for
+		// Table are routed to. For CloneVindexDDLAction, it is the new
+		// vindex, and Table the vindex it is cloned from.
+		NewName TableName
+
+		// Anchor is set for ReorderColVindexDDLAction. The vindex of
+		// VindexSpec moves right after Anchor if After is set, and right
+		// before it otherwise.
+		Anchor ColIdent
+		After  bool
+
+		// Comment is set for SetKeyspaceCommentDDLAction, whose keyspace
+		// is the qualifier of Table. An empty comment clears it.
+		Comment string
+
+		// Script is set for ApplyVSchemaScriptDDLAction. It holds the
+		// ALTER VSCHEMA statements to apply, separated by semicolons.
+		Script string
+
+		// Label is set for SetVSchemaLabelDDLAction. An empty label
+		// clears it.
+		Label string
 	}
 
 	// AlterTable represents a ALTER TABLE statement.
@@ -554,6 +610,35 @@ type (
 		Wild  string
 	}
 
+	// ExplainRouting represents an EXPLAIN ROUTING statement, which
+	// shows how a list of ids is routed by the primary vindex of a table.
+	ExplainRouting struct {
+		Table  TableName
+		Values ValTuple
+	}
+
+	// ExplainShards represents an EXPLAIN SHARDS FOR statement, which
+	// shows the shards a predicate on the primary vindex column of a
+	// table resolves to.
+	ExplainShards struct {
+		Table TableName
+		Where *Where
+	}
+
+	// ExplainVindex represents an EXPLAIN VINDEX FOR statement, which
+	// shows the column vindex the planner selects for a predicate on a
+	// table.
+	ExplainVindex struct {
+		Table TableName
+		Where *Where
+	}
+
+	// ExplainVSchema represents an EXPLAIN VSCHEMA statement, which
+	// shows the vindexes and the auto increment of a vschema table.
+	ExplainVSchema struct {
+		Table TableName
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -607,6 +692,10 @@ func (*RenameTable) iStatement()       {}
 func (*CallProc) iStatement()          {}
 func (*ExplainStmt) iStatement()       {}
 func (*ExplainTab) iStatement()        {}
+func (*ExplainRouting) iStatement()    {}
+func (*ExplainShards) iStatement()     {}
+func (*ExplainVindex) iStatement()     {}
+func (*ExplainVSchema) iStatement()    {}
 
 func (*CreateView) iDDLStatement()    {}
 func (*AlterView) iDDLStatement()     {}
@@ -637,8 +726,12 @@ func (*RenameIndex) iAlterOption()             {}
 func (*Validation) iAlterOption()              {}
 func (TableOptions) iAlterOption()             {}
 
-func (*ExplainStmt) iExplain() {}
-func (*ExplainTab) iExplain()  {}
+func (*ExplainStmt) iExplain()    {}
+func (*ExplainTab) iExplain()     {}
+func (*ExplainRouting) iExplain() {}
+func (*ExplainShards) iExplain()  {}
+func (*ExplainVindex) iExplain()  {}
+func (*ExplainVSchema) iExplain() {}
 
 // IsFullyParsed implements the DDLStatement interface
 func (*TruncateTable) IsFullyParsed() bool {
@@ -1346,12 +1439,27 @@ type VindexSpec struct {
 	Params []VindexParam
 }
 
+// VindexBinding defines a vindex created and bound to a column by an
+// ADD VINDEXES statement
+type VindexBinding struct {
+	Column ColIdent
+	Spec   *VindexSpec
+}
+
 // AutoIncSpec defines and autoincrement value for a ADD AUTO_INCREMENT statement
 type AutoIncSpec struct {
 	Column   ColIdent
 	Sequence TableName
 }
 
+// ParentSpec defines the parent table of a SET PARENT statement, and the
+// columns of the child table that reference its columns
+type ParentSpec struct {
+	Parent            TableName
+	Columns           Columns
+	ReferencedColumns Columns
+}
+
 // VindexParam defines a key/value parameter for a CREATE VINDEX statement
 type VindexParam struct {
 	Key ColIdent
@@ -2037,6 +2145,15 @@ func (node *AlterVschema) Format(buf *TrackedBuffer) {
 		buf.astPrintf(node, "alter vschema create vindex %v %v", node.Table, node.VindexSpec)
 	case DropVindexDDLAction:
 		buf.astPrintf(node, "alter vschema drop vindex %v", node.Table)
+	case CloneVindexDDLAction:
+		buf.astPrintf(node, "alter vschema clone vindex %v as %v", node.Table, node.NewName)
+		for i, p := range node.VindexSpec.Params {
+			if i == 0 {
+				buf.astPrintf(node, " with %v", p)
+			} else {
+				buf.astPrintf(node, ", %v", p)
+			}
+		}
 	case AddVschemaTableDDLAction:
 		buf.astPrintf(node, "alter vschema add table %v", node.Table)
 	case DropVschemaTableDDLAction:
@@ -2050,16 +2167,89 @@ func (node *AlterVschema) Format(buf *TrackedBuffer) {
 				buf.astPrintf(node, "%v", col)
 			}
 		}
+		if node.VindexExpr != nil {
+			buf.astPrintf(node, " as (%v)", node.VindexExpr)
+		}
 		buf.astPrintf(node, ")")
 		if node.VindexSpec.Type.String() != "" {
 			buf.astPrintf(node, " %v", node.VindexSpec)
+		} else {
+			for i, p := range node.VindexSpec.Params {
+				if i == 0 {
+					buf.WriteString(" with ")
+				} else {
+					buf.WriteString(", ")
+				}
+				buf.astPrintf(node, "%v", p)
+			}
+		}
+	case AddColVindexesDDLAction:
+		buf.astPrintf(node, "alter vschema on %v add vindexes (", node.Table)
+		for i, binding := range node.VindexBindings {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", binding)
 		}
+		buf.WriteString(")")
 	case DropColVindexDDLAction:
 		buf.astPrintf(node, "alter vschema on %v drop vindex %v", node.Table, node.VindexSpec.Name)
+		if node.Cascade {
+			buf.WriteString(" cascade")
+		}
+		if node.Force {
+			buf.WriteString(" force")
+		}
+	case DropAllColVindexesDDLAction:
+		buf.astPrintf(node, "alter vschema on %v drop all vindexes", node.Table)
+		if node.Cascade {
+			buf.WriteString(" cascade")
+		}
 	case AddSequenceDDLAction:
 		buf.astPrintf(node, "alter vschema add sequence %v", node.Table)
+		for i, p := range node.SequenceParams {
+			if i == 0 {
+				buf.WriteString(" with ")
+			} else {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", p)
+		}
 	case AddAutoIncDDLAction:
 		buf.astPrintf(node, "alter vschema on %v add auto_increment %v", node.Table, node.AutoIncSpec)
+	case SetParentTableDDLAction:
+		buf.astPrintf(node, "alter vschema on %v set parent %v", node.Table, node.ParentSpec)
+	case SetScatterTableDDLAction:
+		buf.astPrintf(node, "alter vschema on %v set scatter = %v", node.Table, BoolVal(node.Scatter))
+	case RenameVschemaTableDDLAction:
+		buf.astPrintf(node, "alter vschema rename table %v to %v", node.Table, node.NewName)
+	case CopyKeyspaceDDLAction:
+		buf.astPrintf(node, "alter vschema copy keyspace %v to %v", node.Table.Qualifier, node.NewName.Qualifier)
+	case ReorderColVindexDDLAction:
+		position := "before"
+		if node.After {
+			position = "after"
+		}
+		buf.astPrintf(node, "alter vschema on %v reorder vindex %v %s %v", node.Table, node.VindexSpec.Name, position, node.Anchor)
+	case SetKeyspaceCommentDDLAction:
+		buf.astPrintf(node, "alter vschema keyspace %v set comment %v", node.Table.Qualifier, NewStrLiteral([]byte(node.Comment)))
+	case ApplyVSchemaScriptDDLAction:
+		buf.astPrintf(node, "alter vschema apply %v", NewStrLiteral([]byte(node.Script)))
+	case SetVSchemaLabelDDLAction:
+		buf.astPrintf(node, "alter vschema set label %v", NewStrLiteral([]byte(node.Label)))
+	case AddRoutingRuleDDLAction:
+		buf.astPrintf(node, "alter vschema add routing rule %v route to %v", node.Table, node.NewName)
+	case DropRoutingRuleDDLAction:
+		buf.astPrintf(node, "alter vschema drop routing rule %v", node.Table)
+	case EnableColVindexDDLAction:
+		buf.astPrintf(node, "alter vschema on %v enable vindex %v", node.Table, node.VindexSpec.Name)
+	case DisableColVindexDDLAction:
+		buf.astPrintf(node, "alter vschema on %v disable vindex %v", node.Table, node.VindexSpec.Name)
+	case AddReferenceTableDDLAction:
+		buf.astPrintf(node, "alter vschema add reference table %v", node.Table)
+		if !node.ReferenceSource.IsEmpty() {
+			buf.astPrintf(node, " source = %v", node.ReferenceSource)
+		}
 	default:
 		buf.astPrintf(node, "%s table %v", node.Action.ToString(), node.Table)
 	}
@@ -2365,6 +2555,16 @@ func (node *AutoIncSpec) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "using %v", node.Sequence)
 }
 
+// Format formats the node.
+func (node *ParentSpec) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v on %v references %v", node.Parent, node.Columns, node.ReferencedColumns)
+}
+
+// Format formats the node.
+func (node *VindexBinding) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v %v", node.Column, node.Spec)
+}
+
 // Format formats the node. The "CREATE VINDEX" preamble was formatted in
 // the containing DDL node Format, so this just prints the type, any
 // parameters, and optionally the owner
@@ -2459,6 +2659,18 @@ func (node *ShowLegacy) Format(buf *TrackedBuffer) {
 		buf.astPrintf(node, "%v", opt.Filter)
 		return
 	}
+	if nodeType == "validate vschema" {
+		buf.astPrintf(node, "%s", nodeType)
+		return
+	}
+	if nodeType == "validate vschema routing" {
+		buf.astPrintf(node, "%s %v", nodeType, node.Table.Qualifier)
+		return
+	}
+	if nodeType == "vschema vindex params" && node.HasTable() {
+		buf.astPrintf(node, "show vschema vindex %v params", node.Table)
+		return
+	}
 	if node.Scope == ImplicitScope {
 		buf.astPrintf(node, "show %s", nodeType)
 	} else {
@@ -2470,9 +2682,16 @@ func (node *ShowLegacy) Format(buf *TrackedBuffer) {
 	if nodeType == "collation" && node.ShowCollationFilterOpt != nil {
 		buf.astPrintf(node, " where %v", node.ShowCollationFilterOpt)
 	}
+	if (nodeType == "vschema keyspaces" || nodeType == "vschema vindexes") && node.ShowTablesOpt != nil {
+		buf.astPrintf(node, "%v", node.ShowTablesOpt.Filter)
+	}
 	if nodeType == "charset" && node.ShowTablesOpt != nil {
 		buf.astPrintf(node, "%v", node.ShowTablesOpt.Filter)
 	}
+	if nodeType == "vschema tables" && node.HasTable() {
+		buf.astPrintf(node, " using vindex %v", node.Table)
+		return
+	}
 	if node.HasTable() {
 		buf.astPrintf(node, " %v", node.Table)
 	}
@@ -2550,6 +2769,26 @@ func (node *ExplainTab) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *ExplainRouting) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "explain routing %v %v", node.Table, node.Values)
+}
+
+// Format formats the node.
+func (node *ExplainShards) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "explain shards for %v%v", node.Table, node.Where)
+}
+
+// Format formats the node.
+func (node *ExplainVindex) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "explain vindex for %v%v", node.Table, node.Where)
+}
+
+// Format formats the node.
+func (node *ExplainVSchema) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "explain vschema %v", node.Table)
+}
+
 // Format formats the node.
 func (node *CallProc) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "call %v(%v)", node.Name, node.Params)
@@ -297,20 +297,64 @@ func (ct *ColumnType) SQLType() querypb.Type {
 }
 
 // ParseParams parses the vindex parameter list, pulling out the special-case
-// "owner" parameter
+// "owner" parameter. The "tags" parameter is normalized to a comma separated
+// list without quotes or surrounding whitespace.
 func (node *VindexSpec) ParseParams() (string, map[string]string) {
 	var owner string
 	params := map[string]string{}
 	for _, p := range node.Params {
-		if p.Key.Lowered() == VindexOwnerStr {
+		switch p.Key.Lowered() {
+		case VindexOwnerStr:
 			owner = p.Val
-		} else {
+		case VindexTagsStr:
+			params[VindexTagsStr] = strings.Join(ParseVindexTags(strings.Trim(p.Val, "'")), ",")
+		default:
 			params[p.Key.String()] = p.Val
 		}
 	}
 	return owner, params
 }
 
+// isVSchemaDescription returns true if "describe <table> <wild>" is
+// a description of the vschema table named wild rather than of the
+// columns of table. The grammar can't tell them apart because vschema
+// is not a reserved keyword.
+func isVSchemaDescription(table TableName, wild string) bool {
+	return table.Qualifier.IsEmpty() && strings.EqualFold(table.Name.String(), "vschema") &&
+		wild != "" && !strings.HasPrefix(wild, "'")
+}
+
+// isExplainRouting returns true if table is the routing word of
+// "explain routing <table> (<values>)". routing is not a keyword, so that
+// it can still name a table, an index or a column.
+func isExplainRouting(table TableName) bool {
+	return table.Qualifier.IsEmpty() && strings.EqualFold(table.Name.String(), "routing")
+}
+
+// GenerateVindexName returns the name given to a vindex declared
+// without one: its type followed by its columns, separated by
+// underscores. For example, a hash vindex on column id is named hash_id.
+func GenerateVindexName(vindexType ColIdent, cols []ColIdent) ColIdent {
+	parts := make([]string, 0, len(cols)+1)
+	parts = append(parts, vindexType.Lowered())
+	for _, col := range cols {
+		parts = append(parts, col.Lowered())
+	}
+	return NewColIdent(strings.Join(parts, "_"))
+}
+
+// ParseVindexTags splits the value of a vindex "tags" parameter into
+// its individual tags, dropping empty entries.
+func ParseVindexTags(val string) []string {
+	var tags []string
+	for _, tag := range strings.Split(val, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 var _ ConstraintInfo = &ForeignKeyDefinition{}
 
 func (f *ForeignKeyDefinition) iConstraintInfo() {}
@@ -895,6 +939,38 @@ func (action DDLAction) ToString() string {
 		return AddSequenceStr
 	case AddAutoIncDDLAction:
 		return AddAutoIncStr
+	case AddReferenceTableDDLAction:
+		return AddReferenceTableStr
+	case RenameVschemaTableDDLAction:
+		return RenameVschemaTableStr
+	case DropAllColVindexesDDLAction:
+		return DropAllColVindexesStr
+	case CopyKeyspaceDDLAction:
+		return CopyKeyspaceStr
+	case ReorderColVindexDDLAction:
+		return ReorderColVindexStr
+	case SetKeyspaceCommentDDLAction:
+		return SetKeyspaceCommentStr
+	case AddRoutingRuleDDLAction:
+		return AddRoutingRuleStr
+	case DropRoutingRuleDDLAction:
+		return DropRoutingRuleStr
+	case EnableColVindexDDLAction:
+		return EnableColVindexStr
+	case DisableColVindexDDLAction:
+		return DisableColVindexStr
+	case AddColVindexesDDLAction:
+		return AddColVindexesStr
+	case SetParentTableDDLAction:
+		return SetParentTableStr
+	case SetScatterTableDDLAction:
+		return SetScatterTableStr
+	case ApplyVSchemaScriptDDLAction:
+		return ApplyVSchemaScriptStr
+	case SetVSchemaLabelDDLAction:
+		return SetVSchemaLabelStr
+	case CloneVindexDDLAction:
+		return CloneVindexStr
 	default:
 		return "Unknown DDL Action"
 	}
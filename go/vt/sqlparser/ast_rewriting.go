@@ -234,6 +234,11 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 		sysvars.TransactionMode.Name,
 		sysvars.Workload.Name,
 		sysvars.DDLStrategy.Name,
+		sysvars.DDLFailFast.Name,
+		sysvars.DDLDropVSchemaTable.Name,
+		sysvars.VSchemaDDLJSON.Name,
+		sysvars.CaptureVSchemaDDL.Name,
+		sysvars.VSchemaDefaultKeyspace.Name,
 		sysvars.SessionUUID.Name,
 		sysvars.SessionEnableSystemSettings.Name,
 		sysvars.ReadAfterWriteGTID.Name,
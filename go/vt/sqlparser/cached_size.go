@@ -197,7 +197,7 @@ func (cached *AlterVschema) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(320)
 	}
 	// field Table vitess.io/vitess/go/vt/sqlparser.TableName
 	size += cached.Table.CachedSize(false)
@@ -210,8 +210,40 @@ func (cached *AlterVschema) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field VindexExpr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.VindexExpr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field VindexBindings []*vitess.io/vitess/go/vt/sqlparser.VindexBinding
+	{
+		size += int64(cap(cached.VindexBindings)) * int64(8)
+		for _, elem := range cached.VindexBindings {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field AutoIncSpec *vitess.io/vitess/go/vt/sqlparser.AutoIncSpec
 	size += cached.AutoIncSpec.CachedSize(true)
+	// field ParentSpec *vitess.io/vitess/go/vt/sqlparser.ParentSpec
+	size += cached.ParentSpec.CachedSize(true)
+	// field SequenceParams []vitess.io/vitess/go/vt/sqlparser.VindexParam
+	{
+		size += int64(cap(cached.SequenceParams)) * int64(56)
+		for _, elem := range cached.SequenceParams {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field ReferenceSource vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.ReferenceSource.CachedSize(false)
+	// field NewName vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.NewName.CachedSize(false)
+	// field Anchor vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Anchor.CachedSize(false)
+	// field Comment string
+	size += int64(len(cached.Comment))
+	// field Script string
+	size += int64(len(cached.Script))
+	// field Label string
+	size += int64(len(cached.Label))
 	return size
 }
 func (cached *AndExpr) CachedSize(alloc bool) int64 {
@@ -1156,6 +1188,32 @@ func (cached *ParenTableExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ParentSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Parent vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.Parent.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += int64(cap(cached.Columns)) * int64(40)
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field ReferencedColumns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += int64(cap(cached.ReferencedColumns)) * int64(40)
+		for _, elem := range cached.ReferencedColumns {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *ParsedQuery) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1637,6 +1695,20 @@ func (cached *ValuesFuncExpr) CachedSize(alloc bool) int64 {
 	size += cached.Name.CachedSize(true)
 	return size
 }
+func (cached *VindexBinding) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Column vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Column.CachedSize(false)
+	// field Spec *vitess.io/vitess/go/vt/sqlparser.VindexSpec
+	size += cached.Spec.CachedSize(true)
+	return size
+}
 func (cached *VindexParam) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
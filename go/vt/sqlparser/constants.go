@@ -50,20 +50,36 @@ const (
 	ImplicitStr       = ""
 
 	// DDL strings.
-	CreateStr           = "create"
-	AlterStr            = "alter"
-	DropStr             = "drop"
-	RenameStr           = "rename"
-	TruncateStr         = "truncate"
-	FlushStr            = "flush"
-	CreateVindexStr     = "create vindex"
-	DropVindexStr       = "drop vindex"
-	AddVschemaTableStr  = "add vschema table"
-	DropVschemaTableStr = "drop vschema table"
-	AddColVindexStr     = "on table add vindex"
-	DropColVindexStr    = "on table drop vindex"
-	AddSequenceStr      = "add sequence"
-	AddAutoIncStr       = "add auto_increment"
+	CreateStr             = "create"
+	AlterStr              = "alter"
+	DropStr               = "drop"
+	RenameStr             = "rename"
+	TruncateStr           = "truncate"
+	FlushStr              = "flush"
+	CreateVindexStr       = "create vindex"
+	DropVindexStr         = "drop vindex"
+	AddVschemaTableStr    = "add vschema table"
+	DropVschemaTableStr   = "drop vschema table"
+	AddColVindexStr       = "on table add vindex"
+	DropColVindexStr      = "on table drop vindex"
+	AddSequenceStr        = "add sequence"
+	AddAutoIncStr         = "add auto_increment"
+	AddReferenceTableStr  = "add reference table"
+	RenameVschemaTableStr = "rename vschema table"
+	DropAllColVindexesStr = "on table drop all vindexes"
+	CopyKeyspaceStr       = "copy keyspace"
+	ReorderColVindexStr   = "on table reorder vindex"
+	SetKeyspaceCommentStr = "set keyspace comment"
+	AddRoutingRuleStr     = "add routing rule"
+	DropRoutingRuleStr    = "drop routing rule"
+	EnableColVindexStr    = "on table enable vindex"
+	DisableColVindexStr   = "on table disable vindex"
+	AddColVindexesStr     = "on table add vindexes"
+	SetParentTableStr     = "on table set parent"
+	SetScatterTableStr    = "on table set scatter"
+	ApplyVSchemaScriptStr = "apply"
+	SetVSchemaLabelStr    = "set label"
+	CloneVindexStr        = "clone vindex"
 
 	// Online DDL hint
 	OnlineStr = "online"
@@ -71,6 +87,19 @@ const (
 	// Vindex DDL param to specify the owner of a vindex
 	VindexOwnerStr = "owner"
 
+	// Vindex DDL param to annotate a vindex with a comma separated list of tags
+	VindexTagsStr = "tags"
+
+	// Vindex DDL param to declare whether a vindex is unique
+	VindexUniqueStr = "unique"
+
+	// Vindex DDL param to record whether a new column vindex binding needs a backfill
+	VindexBackfillRequiredStr = "backfill_required"
+
+	// Sequence DDL params to configure the table backing a sequence
+	SequenceCacheStr = "cache"
+	SequenceStartStr = "start"
+
 	// Partition strings
 	ReorganizeStr        = "reorganize partition"
 	AddStr               = "add partition"
@@ -255,7 +284,7 @@ const (
 	ReadWrite
 )
 
-//Constants for Enum type - IsolationLevel
+// Constants for Enum type - IsolationLevel
 const (
 	ReadUncommitted IsolationLevel = iota
 	ReadCommitted
@@ -284,6 +313,22 @@ const (
 	DropColVindexDDLAction
 	AddSequenceDDLAction
 	AddAutoIncDDLAction
+	AddReferenceTableDDLAction
+	RenameVschemaTableDDLAction
+	DropAllColVindexesDDLAction
+	CopyKeyspaceDDLAction
+	ReorderColVindexDDLAction
+	SetKeyspaceCommentDDLAction
+	AddRoutingRuleDDLAction
+	DropRoutingRuleDDLAction
+	EnableColVindexDDLAction
+	DisableColVindexDDLAction
+	AddColVindexesDDLAction
+	SetParentTableDDLAction
+	SetScatterTableDDLAction
+	ApplyVSchemaScriptDDLAction
+	SetVSchemaLabelDDLAction
+	CloneVindexDDLAction
 )
 
 // Constants for Enum Type - Scope
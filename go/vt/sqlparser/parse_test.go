@@ -1140,6 +1140,8 @@ var (
 		input: "alter vschema create vindex lookup_vdx using lookup with owner=user, table=name_user_idx, from=name, to=user_id",
 	}, {
 		input: "alter vschema create vindex xyz_vdx using xyz with param1=hello, param2='world', param3=123",
+	}, {
+		input: "alter vschema create vindex hash_vdx using hash with tags='pii,gdpr'",
 	}, {
 		input: "alter vschema drop vindex hash_vdx",
 	}, {
@@ -1148,22 +1150,123 @@ var (
 		input: "alter vschema add table a",
 	}, {
 		input: "alter vschema add table ks.a",
+	}, {
+		input: "alter vschema on a drop all vindexes",
+	}, {
+		input: "alter vschema on ks.a drop all vindexes cascade",
+	}, {
+		input: "alter vschema on a reorder vindex v1 before v2",
+	}, {
+		input:  "ALTER VSCHEMA ON ks.a REORDER VINDEX v1 AFTER v2",
+		output: "alter vschema on ks.a reorder vindex v1 after v2",
 	}, {
 		input: "alter vschema add sequence a_seq",
 	}, {
 		input: "alter vschema add sequence ks.a_seq",
+	}, {
+		input: "alter vschema add sequence a_seq with cache=1000, start=5000",
+	}, {
+		input:  "alter vschema add sequence a_seq with cache = 10",
+		output: "alter vschema add sequence a_seq with cache=10",
 	}, {
 		input: "alter vschema on a add auto_increment id using a_seq",
 	}, {
 		input: "alter vschema on ks.a add auto_increment id using a_seq",
+	}, {
+		input: "alter vschema on ks.order_item set parent ks.orders on (order_id) references (id)",
+	}, {
+		input:  "alter vschema on a SET PARENT b ON (c1,c2) REFERENCES (p1,p2)",
+		output: "alter vschema on a set parent b on (c1, c2) references (p1, p2)",
+	}, {
+		input: "alter vschema on ks.events set scatter = true",
+	}, {
+		input:  "alter vschema on events SET SCATTER=false",
+		output: "alter vschema on events set scatter = false",
+	}, {
+		input: "alter vschema on a drop vindex hash cascade",
+	}, {
+		input: "alter vschema on a drop vindex hash force",
+	}, {
+		input:  "ALTER VSCHEMA ON a DROP VINDEX hash CASCADE FORCE",
+		output: "alter vschema on a drop vindex hash cascade force",
+	}, {
+		input: "alter vschema rename table a to b",
+	}, {
+		input: "alter vschema rename table ks.a to ks.b",
+	}, {
+		input: "alter vschema copy keyspace ks to ks_staging",
+	}, {
+		input:  "alter vschema copy keyspace `ks` to `ks-staging`",
+		output: "alter vschema copy keyspace ks to `ks-staging`",
+	}, {
+		input: "alter vschema keyspace ks set comment 'user data, owned by the accounts team'",
+	}, {
+		input:  "alter vschema KEYSPACE `ks` set comment = 'it''s'",
+		output: "alter vschema keyspace ks set comment 'it\\'s'",
+	}, {
+		input: "alter vschema keyspace ks set comment ''",
+	}, {
+		input: "alter vschema apply 'alter vschema create vindex hash_vdx using hash; alter vschema on t add vindex hash_vdx(id)'",
+	}, {
+		input:  "ALTER VSCHEMA APPLY \"alter vschema drop table t\"",
+		output: "alter vschema apply 'alter vschema drop table t'",
+	}, {
+		input: "alter vschema clone vindex user_lookup as user_lookup_v2 with table=user_lookup_v2, write_only=true",
+	}, {
+		input:  "ALTER VSCHEMA CLONE VINDEX ks.hash AS ks.hash2",
+		output: "alter vschema clone vindex ks.hash as ks.hash2",
+	}, {
+		input: "alter vschema set label '2024-06-release-3'",
+	}, {
+		input:  "ALTER VSCHEMA SET LABEL = \"2024-06-release-3\"",
+		output: "alter vschema set label '2024-06-release-3'",
+	}, {
+		input: "alter vschema add routing rule t route to ks2.t",
+	}, {
+		input:  "alter vschema ADD ROUTING RULE ks1.t ROUTE TO ks2.t2",
+		output: "alter vschema add routing rule ks1.t route to ks2.t2",
+	}, {
+		input: "alter vschema drop routing rule ks1.t",
+	}, {
+		input: "alter vschema on ks.t disable vindex t_lkp",
+	}, {
+		input:  "alter vschema on t ENABLE VINDEX `t_lkp`",
+		output: "alter vschema on t enable vindex t_lkp",
+	}, {
+		input: "alter vschema on ks.t add vindexes (id using hash)",
+	}, {
+		input:  "alter vschema on t ADD VINDEXES (id using hash, region using region_json with region_map=regions, region_bytes=1, Name using unicode_loose_md5)",
+		output: "alter vschema on t add vindexes (id using hash, region using region_json with region_map=regions, region_bytes=1, `Name` using unicode_loose_md5)",
+	}, {
+		input: "alter vschema add reference table a",
+	}, {
+		input: "alter vschema add reference table ks.a source = other.a",
+	}, {
+		input:  "alter vschema add reference table a source=other.a",
+		output: "alter vschema add reference table a source = other.a",
 	}, {
 		input: "alter vschema drop table a",
 	}, {
 		input: "alter vschema drop table ks.a",
 	}, {
 		input: "alter vschema on a add vindex hash (id)",
+	}, {
+		input:  "alter vschema on a add vindex (id) using hash",
+		output: "alter vschema on a add vindex hash_id (id) using hash",
+	}, {
+		input:  "alter vschema on a add vindex (Name, LastName) using lookup with table=t, from=`name,lastname`, to=keyspace_id",
+		output: "alter vschema on a add vindex lookup_name_lastname (`Name`, LastName) using lookup with table=t, from=name,lastname, to=keyspace_id",
 	}, {
 		input: "alter vschema on ks.a add vindex hash (id)",
+	}, {
+		input: "alter vschema on a add vindex hash (id) with backfill_required=false",
+	}, {
+		input: "alter vschema on a add vindex email_md5 (email_lower as (lower(email))) using unicode_loose_md5",
+	}, {
+		input:  "alter vschema on a add vindex (email_lower AS (LOWER(email))) using unicode_loose_md5",
+		output: "alter vschema on a add vindex unicode_loose_md5_email_lower (email_lower as (LOWER(email))) using unicode_loose_md5",
+	}, {
+		input: "alter vschema on a add vindex email_md5 (email_lower as (lower(email)))",
 	}, {
 		input:  "alter vschema on a add vindex `hash` (`id`)",
 		output: "alter vschema on a add vindex hash (id)",
@@ -1506,10 +1609,53 @@ var (
 		input: "show vitess_tablets where hostname = 'some-tablet'",
 	}, {
 		input: "show vschema tables",
+	}, {
+		input:  "show vschema vindex region_json PARAMS",
+		output: "show vschema vindex region_json params",
+	}, {
+		input: "show vschema tables using vindex hash",
+	}, {
+		input: "show vschema tables using vindex ks.hash",
+	}, {
+		input: "show vschema as sql",
+	}, {
+		input:  "SHOW VSCHEMA AS SQL",
+		output: "show vschema as sql",
+	}, {
+		input: "show vschema acl",
+	}, {
+		input: "show vschema backfill",
+	}, {
+		input:  "SHOW VSCHEMA VERSION",
+		output: "show vschema version",
+	}, {
+		input:  "SHOW VSCHEMA BACKFILL ON ks.t",
+		output: "show vschema backfill on ks.t",
+	}, {
+		input:  "SHOW VSCHEMA ACL",
+		output: "show vschema acl",
+	}, {
+		input: "show vschema vindex stats",
+	}, {
+		input:  "SHOW VSCHEMA VINDEX STATS",
+		output: "show vschema vindex stats",
+	}, {
+		input: "show vschema keyspaces",
+	}, {
+		input: "show vschema keyspaces like 'ks%'",
 	}, {
 		input: "show vschema vindexes",
 	}, {
 		input: "show vschema vindexes on t",
+	}, {
+		input: "show vschema vindexes like 'hash%'",
+	}, {
+		input: "show vschema vindexes where tag = 'pii'",
+	}, {
+		input: "validate vschema",
+	}, {
+		input:  "VALIDATE VSCHEMA ROUTING `TestExecutor`",
+		output: "validate vschema routing TestExecutor",
 	}, {
 		input:  "show warnings",
 		output: "show warnings",
@@ -1573,6 +1719,84 @@ var (
 		input: "explain insert into t(col1, col2) values (1, 2)",
 	}, {
 		input: "explain update t set col = 2",
+	}, {
+		input:  "explain create table t (id bigint)",
+		output: "explain create table t (\n\tid bigint\n)",
+	}, {
+		input: "explain format = vitess alter table t add column c int",
+	}, {
+		input: "explain drop table t1, t2",
+	}, {
+		input: "explain rename table t1 to t2",
+	}, {
+		input: "select routing from t",
+	}, {
+		input: "select a from routing",
+	}, {
+		input: "select routing.a from routing where routing.routing = 1",
+	}, {
+		input:  "describe routing",
+		output: "explain routing",
+	}, {
+		input:  "describe routing t",
+		output: "explain routing t",
+	}, {
+		input:  "create index routing on t (a)",
+		output: "alter table t add index routing (a)",
+	}, {
+		input:  "drop index routing on t",
+		output: "alter table t drop key routing",
+	}, {
+		input: "create database routing",
+	}, {
+		input: "alter table t add index routing (a)",
+	}, {
+		input: "create table t (\n\tid int,\n\tkey routing (id)\n)",
+	}, {
+		input: "alter table t add constraint routing foreign key (a) references u (b)",
+	}, {
+		input:  "create index source on t (a)",
+		output: "alter table t add index source (a)",
+	}, {
+		input:  "create index reference on t (a)",
+		output: "alter table t add index reference (a)",
+	}, {
+		input: "select source, reference from t",
+	}, {
+		input:  "create index validate on t (a)",
+		output: "alter table t add index validate (a)",
+	}, {
+		input: "select validate from t",
+	}, {
+		input:  "describe t routing",
+		output: "explain t routing",
+	}, {
+		input: "explain routing ks.t (1, 2, 'a')",
+	}, {
+		input:  "describe routing t (1)",
+		output: "explain routing t (1)",
+	}, {
+		input: "explain shards for ks.t where id in (1, 2, 3)",
+	}, {
+		input:  "describe vschema TestExecutor.test",
+		output: "explain vschema TestExecutor.test",
+	}, {
+		input:  "DESC VSCHEMA test",
+		output: "explain vschema test",
+	}, {
+		input:  "explain vschema '%col%'",
+		output: "explain `vschema` '%col%'",
+	}, {
+		input:  "EXPLAIN SHARDS FOR t WHERE id = 1",
+		output: "explain shards for t where id = 1",
+	}, {
+		input: "explain vindex for ks.t where id = :v1 and c = 'a'",
+	}, {
+		input:  "DESC VINDEX FOR t WHERE id = 1",
+		output: "explain vindex for t where id = 1",
+	}, {
+		input:  "explain vindex",
+		output: "explain `vindex`",
 	}, {
 		input:  "truncate table foo",
 		output: "truncate table foo",
@@ -2977,6 +3201,51 @@ var (
 	}, {
 		input:  "select next id from a",
 		output: "expecting value after next at position 15 near 'id'",
+	}, {
+		input:  "explain rows for t where id = 1",
+		output: "expecting shards after explain at position 17 near 'for'",
+	}, {
+		input:  "alter vschema copy keyspac ks to ks2",
+		output: "expecting keyspace after copy at position 27 near 'keyspac'",
+	}, {
+		input:  "alter vschema keyspac ks set comment 'x'",
+		output: "expecting keyspace after vschema at position 22 near 'keyspac'",
+	}, {
+		input:  "alter vschema aply 'alter vschema drop table t'",
+		output: "expecting apply after vschema at position 19 near 'aply'",
+	}, {
+		input:  "ALTER VSCHEMA APPLI 'alter vschema drop table t'",
+		output: "expecting apply after vschema at position 20 near 'APPLI'",
+	}, {
+		input:  "alter vschema add routing rul t route to ks2.t",
+		output: "expecting rule after routing at position 30 near 'rul'",
+	}, {
+		input:  "alter vschema on t reordr vindex v1 before v2",
+		output: "expecting reorder vindex at position 33 near 'vindex'",
+	}, {
+		input:  "validat vschema",
+		output: "syntax error at position 8 near 'validat'",
+	}, {
+		input:  "describe t1 ks.t2",
+		output: "expecting vschema before qualified table name at position 18",
+	}, {
+		input:  "show vschema acls",
+		output: "expecting acl, backfill or version after vschema at position 18 near 'acls'",
+	}, {
+		input:  "alter vschema clon vindex v as w",
+		output: "expecting clone after vschema at position 19 near 'clon'",
+	}, {
+		input:  "alter vschema xyz vindex v as w",
+		output: "expecting keyspace, apply or clone after vschema at position 18 near 'xyz'",
+	}, {
+		input:  "alter vschema clone ks set comment 'x'",
+		output: "expecting keyspace after vschema at position 39 near 'x'",
+	}, {
+		input:  "alter vschema set labl 'x'",
+		output: "expecting label after set at position 23 near 'labl'",
+	}, {
+		input:  "alter vschema on t reorder vindex v1 behind v2",
+		output: "syntax error at position 44 near 'behind'",
 	}, {
 		input:  "select next 1+1 values from a",
 		output: "syntax error at position 15",
@@ -104,14 +104,50 @@ func replaceAlterViewViewName(newNode, parent SQLNode) {
 	parent.(*AlterView).ViewName = newNode.(TableName)
 }
 
+func replaceAlterVschemaAnchor(newNode, parent SQLNode) {
+	parent.(*AlterVschema).Anchor = newNode.(ColIdent)
+}
+
 func replaceAlterVschemaAutoIncSpec(newNode, parent SQLNode) {
 	parent.(*AlterVschema).AutoIncSpec = newNode.(*AutoIncSpec)
 }
 
+func replaceAlterVschemaNewName(newNode, parent SQLNode) {
+	parent.(*AlterVschema).NewName = newNode.(TableName)
+}
+
+func replaceAlterVschemaParentSpec(newNode, parent SQLNode) {
+	parent.(*AlterVschema).ParentSpec = newNode.(*ParentSpec)
+}
+
+func replaceAlterVschemaReferenceSource(newNode, parent SQLNode) {
+	parent.(*AlterVschema).ReferenceSource = newNode.(TableName)
+}
+
+type replaceAlterVschemaSequenceParams int
+
+func (r *replaceAlterVschemaSequenceParams) replace(newNode, container SQLNode) {
+	container.(*AlterVschema).SequenceParams[int(*r)] = newNode.(VindexParam)
+}
+
+func (r *replaceAlterVschemaSequenceParams) inc() {
+	*r++
+}
+
 func replaceAlterVschemaTable(newNode, parent SQLNode) {
 	parent.(*AlterVschema).Table = newNode.(TableName)
 }
 
+type replaceAlterVschemaVindexBindings int
+
+func (r *replaceAlterVschemaVindexBindings) replace(newNode, container SQLNode) {
+	container.(*AlterVschema).VindexBindings[int(*r)] = newNode.(*VindexBinding)
+}
+
+func (r *replaceAlterVschemaVindexBindings) inc() {
+	*r++
+}
+
 type replaceAlterVschemaVindexCols int
 
 func (r *replaceAlterVschemaVindexCols) replace(newNode, container SQLNode) {
@@ -122,6 +158,10 @@ func (r *replaceAlterVschemaVindexCols) inc() {
 	*r++
 }
 
+func replaceAlterVschemaVindexExpr(newNode, parent SQLNode) {
+	parent.(*AlterVschema).VindexExpr = newNode.(Expr)
+}
+
 func replaceAlterVschemaVindexSpec(newNode, parent SQLNode) {
 	parent.(*AlterVschema).VindexSpec = newNode.(*VindexSpec)
 }
@@ -346,6 +386,22 @@ func replaceExistsExprSubquery(newNode, parent SQLNode) {
 	parent.(*ExistsExpr).Subquery = newNode.(*Subquery)
 }
 
+func replaceExplainRoutingTable(newNode, parent SQLNode) {
+	parent.(*ExplainRouting).Table = newNode.(TableName)
+}
+
+func replaceExplainRoutingValues(newNode, parent SQLNode) {
+	parent.(*ExplainRouting).Values = newNode.(ValTuple)
+}
+
+func replaceExplainShardsTable(newNode, parent SQLNode) {
+	parent.(*ExplainShards).Table = newNode.(TableName)
+}
+
+func replaceExplainShardsWhere(newNode, parent SQLNode) {
+	parent.(*ExplainShards).Where = newNode.(*Where)
+}
+
 func replaceExplainStmtStatement(newNode, parent SQLNode) {
 	parent.(*ExplainStmt).Statement = newNode.(Statement)
 }
@@ -354,6 +410,18 @@ func replaceExplainTabTable(newNode, parent SQLNode) {
 	parent.(*ExplainTab).Table = newNode.(TableName)
 }
 
+func replaceExplainVSchemaTable(newNode, parent SQLNode) {
+	parent.(*ExplainVSchema).Table = newNode.(TableName)
+}
+
+func replaceExplainVindexTable(newNode, parent SQLNode) {
+	parent.(*ExplainVindex).Table = newNode.(TableName)
+}
+
+func replaceExplainVindexWhere(newNode, parent SQLNode) {
+	parent.(*ExplainVindex).Where = newNode.(*Where)
+}
+
 type replaceExprsItems int
 
 func (r *replaceExprsItems) replace(newNode, container SQLNode) {
@@ -583,6 +651,18 @@ func replaceParenTableExprExprs(newNode, parent SQLNode) {
 	parent.(*ParenTableExpr).Exprs = newNode.(TableExprs)
 }
 
+func replaceParentSpecColumns(newNode, parent SQLNode) {
+	parent.(*ParentSpec).Columns = newNode.(Columns)
+}
+
+func replaceParentSpecParent(newNode, parent SQLNode) {
+	parent.(*ParentSpec).Parent = newNode.(TableName)
+}
+
+func replaceParentSpecReferencedColumns(newNode, parent SQLNode) {
+	parent.(*ParentSpec).ReferencedColumns = newNode.(Columns)
+}
+
 func replacePartitionDefinitionLimit(newNode, parent SQLNode) {
 	parent.(*PartitionDefinition).Limit = newNode.(Expr)
 }
@@ -1001,6 +1081,14 @@ func replaceValuesFuncExprName(newNode, parent SQLNode) {
 	parent.(*ValuesFuncExpr).Name = newNode.(*ColName)
 }
 
+func replaceVindexBindingColumn(newNode, parent SQLNode) {
+	parent.(*VindexBinding).Column = newNode.(ColIdent)
+}
+
+func replaceVindexBindingSpec(newNode, parent SQLNode) {
+	parent.(*VindexBinding).Spec = newNode.(*VindexSpec)
+}
+
 func replaceVindexParamKey(newNode, parent SQLNode) {
 	tmp := parent.(VindexParam)
 	tmp.Key = newNode.(ColIdent)
@@ -1120,14 +1208,31 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ViewName, replaceAlterViewViewName)
 
 	case *AlterVschema:
+		a.apply(node, n.Anchor, replaceAlterVschemaAnchor)
 		a.apply(node, n.AutoIncSpec, replaceAlterVschemaAutoIncSpec)
+		a.apply(node, n.NewName, replaceAlterVschemaNewName)
+		a.apply(node, n.ParentSpec, replaceAlterVschemaParentSpec)
+		a.apply(node, n.ReferenceSource, replaceAlterVschemaReferenceSource)
+		replacerSequenceParams := replaceAlterVschemaSequenceParams(0)
+		replacerSequenceParamsB := &replacerSequenceParams
+		for _, item := range n.SequenceParams {
+			a.apply(node, item, replacerSequenceParamsB.replace)
+			replacerSequenceParamsB.inc()
+		}
 		a.apply(node, n.Table, replaceAlterVschemaTable)
+		replacerVindexBindings := replaceAlterVschemaVindexBindings(0)
+		replacerVindexBindingsB := &replacerVindexBindings
+		for _, item := range n.VindexBindings {
+			a.apply(node, item, replacerVindexBindingsB.replace)
+			replacerVindexBindingsB.inc()
+		}
 		replacerVindexCols := replaceAlterVschemaVindexCols(0)
 		replacerVindexColsB := &replacerVindexCols
 		for _, item := range n.VindexCols {
 			a.apply(node, item, replacerVindexColsB.replace)
 			replacerVindexColsB.inc()
 		}
+		a.apply(node, n.VindexExpr, replaceAlterVschemaVindexExpr)
 		a.apply(node, n.VindexSpec, replaceAlterVschemaVindexSpec)
 
 	case *AndExpr:
@@ -1264,12 +1369,27 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *ExistsExpr:
 		a.apply(node, n.Subquery, replaceExistsExprSubquery)
 
+	case *ExplainRouting:
+		a.apply(node, n.Table, replaceExplainRoutingTable)
+		a.apply(node, n.Values, replaceExplainRoutingValues)
+
+	case *ExplainShards:
+		a.apply(node, n.Table, replaceExplainShardsTable)
+		a.apply(node, n.Where, replaceExplainShardsWhere)
+
 	case *ExplainStmt:
 		a.apply(node, n.Statement, replaceExplainStmtStatement)
 
 	case *ExplainTab:
 		a.apply(node, n.Table, replaceExplainTabTable)
 
+	case *ExplainVSchema:
+		a.apply(node, n.Table, replaceExplainVSchemaTable)
+
+	case *ExplainVindex:
+		a.apply(node, n.Table, replaceExplainVindexTable)
+		a.apply(node, n.Where, replaceExplainVindexWhere)
+
 	case Exprs:
 		replacer := replaceExprsItems(0)
 		replacerRef := &replacer
@@ -1420,6 +1540,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *ParenTableExpr:
 		a.apply(node, n.Exprs, replaceParenTableExprExprs)
 
+	case *ParentSpec:
+		a.apply(node, n.Columns, replaceParentSpecColumns)
+		a.apply(node, n.Parent, replaceParentSpecParent)
+		a.apply(node, n.ReferencedColumns, replaceParentSpecReferencedColumns)
+
 	case *PartitionDefinition:
 		a.apply(node, n.Limit, replacePartitionDefinitionLimit)
 		a.apply(node, n.Name, replacePartitionDefinitionName)
@@ -1673,6 +1798,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *ValuesFuncExpr:
 		a.apply(node, n.Name, replaceValuesFuncExprName)
 
+	case *VindexBinding:
+		a.apply(node, n.Column, replaceVindexBindingColumn)
+		a.apply(node, n.Spec, replaceVindexBindingSpec)
+
 	case VindexParam:
 		a.apply(node, n.Key, replaceVindexParamKey)
 
@@ -31,6 +31,33 @@ func decNesting(yylex interface{}) {
 	yylex.(*Tokenizer).nesting--
 }
 
+// vschemaVerbs are the words that may follow ALTER VSCHEMA without being
+// keywords.
+var vschemaVerbs = []string{"keyspace", "apply", "clone"}
+
+// checkVSchemaVerb returns the error for a word that is not one of the
+// vschemaVerbs, or an empty string. The error names the verb the word was
+// likely meant to be, the one it shares the longest prefix with.
+func checkVSchemaVerb(verb string) string {
+	best, bestLen := "", 0
+	for _, want := range vschemaVerbs {
+		if verb == want {
+			return ""
+		}
+		n := 0
+		for n < len(verb) && n < len(want) && verb[n] == want[n] {
+			n++
+		}
+		if n > bestLen {
+			best, bestLen = want, n
+		}
+	}
+	if best == "" {
+		return "expecting keyspace, apply or clone after vschema"
+	}
+	return "expecting " + best + " after vschema"
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -38,7 +65,7 @@ func skipToEnd(yylex interface{}) {
 	yylex.(*Tokenizer).SkipToEnd = true
 }
 
-//line sql.y:53
+//line sql.y:80
 type yySymType struct {
 	yys                    int
 	empty                  struct{}
@@ -108,6 +135,7 @@ type yySymType struct {
 	partSpecs              []*PartitionSpec
 	vindexParam            VindexParam
 	vindexParams           []VindexParam
+	vindexBindings         []*VindexBinding
 	showFilter             *ShowFilter
 	optLike                *OptLike
 	isolationLevel         IsolationLevel
@@ -344,248 +372,249 @@ const SECURITY = 57547
 const FIRST = 57548
 const AFTER = 57549
 const LAST = 57550
-const BEGIN = 57551
-const START = 57552
-const TRANSACTION = 57553
-const COMMIT = 57554
-const ROLLBACK = 57555
-const SAVEPOINT = 57556
-const RELEASE = 57557
-const WORK = 57558
-const BIT = 57559
-const TINYINT = 57560
-const SMALLINT = 57561
-const MEDIUMINT = 57562
-const INT = 57563
-const INTEGER = 57564
-const BIGINT = 57565
-const INTNUM = 57566
-const REAL = 57567
-const DOUBLE = 57568
-const FLOAT_TYPE = 57569
-const DECIMAL = 57570
-const NUMERIC = 57571
-const TIME = 57572
-const TIMESTAMP = 57573
-const DATETIME = 57574
-const YEAR = 57575
-const CHAR = 57576
-const VARCHAR = 57577
-const BOOL = 57578
-const CHARACTER = 57579
-const VARBINARY = 57580
-const NCHAR = 57581
-const TEXT = 57582
-const TINYTEXT = 57583
-const MEDIUMTEXT = 57584
-const LONGTEXT = 57585
-const BLOB = 57586
-const TINYBLOB = 57587
-const MEDIUMBLOB = 57588
-const LONGBLOB = 57589
-const JSON = 57590
-const ENUM = 57591
-const GEOMETRY = 57592
-const POINT = 57593
-const LINESTRING = 57594
-const POLYGON = 57595
-const GEOMETRYCOLLECTION = 57596
-const MULTIPOINT = 57597
-const MULTILINESTRING = 57598
-const MULTIPOLYGON = 57599
-const NULLX = 57600
-const AUTO_INCREMENT = 57601
-const APPROXNUM = 57602
-const SIGNED = 57603
-const UNSIGNED = 57604
-const ZEROFILL = 57605
-const COLLATION = 57606
-const DATABASES = 57607
-const SCHEMAS = 57608
-const TABLES = 57609
-const VITESS_METADATA = 57610
-const VSCHEMA = 57611
-const FULL = 57612
-const PROCESSLIST = 57613
-const COLUMNS = 57614
-const FIELDS = 57615
-const ENGINES = 57616
-const PLUGINS = 57617
-const EXTENDED = 57618
-const KEYSPACES = 57619
-const VITESS_KEYSPACES = 57620
-const VITESS_SHARDS = 57621
-const VITESS_TABLETS = 57622
-const CODE = 57623
-const PRIVILEGES = 57624
-const FUNCTION = 57625
-const OPEN = 57626
-const TRIGGERS = 57627
-const EVENT = 57628
-const USER = 57629
-const NAMES = 57630
-const CHARSET = 57631
-const GLOBAL = 57632
-const SESSION = 57633
-const ISOLATION = 57634
-const LEVEL = 57635
-const READ = 57636
-const WRITE = 57637
-const ONLY = 57638
-const REPEATABLE = 57639
-const COMMITTED = 57640
-const UNCOMMITTED = 57641
-const SERIALIZABLE = 57642
-const CURRENT_TIMESTAMP = 57643
-const DATABASE = 57644
-const CURRENT_DATE = 57645
-const CURRENT_TIME = 57646
-const LOCALTIME = 57647
-const LOCALTIMESTAMP = 57648
-const CURRENT_USER = 57649
-const UTC_DATE = 57650
-const UTC_TIME = 57651
-const UTC_TIMESTAMP = 57652
-const REPLACE = 57653
-const CONVERT = 57654
-const CAST = 57655
-const SUBSTR = 57656
-const SUBSTRING = 57657
-const GROUP_CONCAT = 57658
-const SEPARATOR = 57659
-const TIMESTAMPADD = 57660
-const TIMESTAMPDIFF = 57661
-const MATCH = 57662
-const AGAINST = 57663
-const BOOLEAN = 57664
-const LANGUAGE = 57665
-const WITH = 57666
-const QUERY = 57667
-const EXPANSION = 57668
-const WITHOUT = 57669
-const VALIDATION = 57670
-const UNUSED = 57671
-const ARRAY = 57672
-const CUME_DIST = 57673
-const DESCRIPTION = 57674
-const DENSE_RANK = 57675
-const EMPTY = 57676
-const EXCEPT = 57677
-const FIRST_VALUE = 57678
-const GROUPING = 57679
-const GROUPS = 57680
-const JSON_TABLE = 57681
-const LAG = 57682
-const LAST_VALUE = 57683
-const LATERAL = 57684
-const LEAD = 57685
-const MEMBER = 57686
-const NTH_VALUE = 57687
-const NTILE = 57688
-const OF = 57689
-const OVER = 57690
-const PERCENT_RANK = 57691
-const RANK = 57692
-const RECURSIVE = 57693
-const ROW_NUMBER = 57694
-const SYSTEM = 57695
-const WINDOW = 57696
-const ACTIVE = 57697
-const ADMIN = 57698
-const BUCKETS = 57699
-const CLONE = 57700
-const COMPONENT = 57701
-const DEFINITION = 57702
-const ENFORCED = 57703
-const EXCLUDE = 57704
-const FOLLOWING = 57705
-const GEOMCOLLECTION = 57706
-const GET_MASTER_PUBLIC_KEY = 57707
-const HISTOGRAM = 57708
-const HISTORY = 57709
-const INACTIVE = 57710
-const INVISIBLE = 57711
-const LOCKED = 57712
-const MASTER_COMPRESSION_ALGORITHMS = 57713
-const MASTER_PUBLIC_KEY_PATH = 57714
-const MASTER_TLS_CIPHERSUITES = 57715
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57716
-const NESTED = 57717
-const NETWORK_NAMESPACE = 57718
-const NOWAIT = 57719
-const NULLS = 57720
-const OJ = 57721
-const OLD = 57722
-const OPTIONAL = 57723
-const ORDINALITY = 57724
-const ORGANIZATION = 57725
-const OTHERS = 57726
-const PATH = 57727
-const PERSIST = 57728
-const PERSIST_ONLY = 57729
-const PRECEDING = 57730
-const PRIVILEGE_CHECKS_USER = 57731
-const PROCESS = 57732
-const RANDOM = 57733
-const REFERENCE = 57734
-const REQUIRE_ROW_FORMAT = 57735
-const RESOURCE = 57736
-const RESPECT = 57737
-const RESTART = 57738
-const RETAIN = 57739
-const REUSE = 57740
-const ROLE = 57741
-const SECONDARY = 57742
-const SECONDARY_ENGINE = 57743
-const SECONDARY_LOAD = 57744
-const SECONDARY_UNLOAD = 57745
-const SKIP = 57746
-const SRID = 57747
-const THREAD_PRIORITY = 57748
-const TIES = 57749
-const UNBOUNDED = 57750
-const VCPU = 57751
-const VISIBLE = 57752
-const FORMAT = 57753
-const TREE = 57754
-const VITESS = 57755
-const TRADITIONAL = 57756
-const LOCAL = 57757
-const LOW_PRIORITY = 57758
-const NO_WRITE_TO_BINLOG = 57759
-const LOGS = 57760
-const ERROR = 57761
-const GENERAL = 57762
-const HOSTS = 57763
-const OPTIMIZER_COSTS = 57764
-const USER_RESOURCES = 57765
-const SLOW = 57766
-const CHANNEL = 57767
-const RELAY = 57768
-const EXPORT = 57769
-const AVG_ROW_LENGTH = 57770
-const CONNECTION = 57771
-const CHECKSUM = 57772
-const DELAY_KEY_WRITE = 57773
-const ENCRYPTION = 57774
-const ENGINE = 57775
-const INSERT_METHOD = 57776
-const MAX_ROWS = 57777
-const MIN_ROWS = 57778
-const PACK_KEYS = 57779
-const PASSWORD = 57780
-const FIXED = 57781
-const DYNAMIC = 57782
-const COMPRESSED = 57783
-const REDUNDANT = 57784
-const COMPACT = 57785
-const ROW_FORMAT = 57786
-const STATS_AUTO_RECALC = 57787
-const STATS_PERSISTENT = 57788
-const STATS_SAMPLE_PAGES = 57789
-const STORAGE = 57790
-const MEMORY = 57791
-const DISK = 57792
+const BEFORE = 57551
+const BEGIN = 57552
+const START = 57553
+const TRANSACTION = 57554
+const COMMIT = 57555
+const ROLLBACK = 57556
+const SAVEPOINT = 57557
+const RELEASE = 57558
+const WORK = 57559
+const BIT = 57560
+const TINYINT = 57561
+const SMALLINT = 57562
+const MEDIUMINT = 57563
+const INT = 57564
+const INTEGER = 57565
+const BIGINT = 57566
+const INTNUM = 57567
+const REAL = 57568
+const DOUBLE = 57569
+const FLOAT_TYPE = 57570
+const DECIMAL = 57571
+const NUMERIC = 57572
+const TIME = 57573
+const TIMESTAMP = 57574
+const DATETIME = 57575
+const YEAR = 57576
+const CHAR = 57577
+const VARCHAR = 57578
+const BOOL = 57579
+const CHARACTER = 57580
+const VARBINARY = 57581
+const NCHAR = 57582
+const TEXT = 57583
+const TINYTEXT = 57584
+const MEDIUMTEXT = 57585
+const LONGTEXT = 57586
+const BLOB = 57587
+const TINYBLOB = 57588
+const MEDIUMBLOB = 57589
+const LONGBLOB = 57590
+const JSON = 57591
+const ENUM = 57592
+const GEOMETRY = 57593
+const POINT = 57594
+const LINESTRING = 57595
+const POLYGON = 57596
+const GEOMETRYCOLLECTION = 57597
+const MULTIPOINT = 57598
+const MULTILINESTRING = 57599
+const MULTIPOLYGON = 57600
+const NULLX = 57601
+const AUTO_INCREMENT = 57602
+const APPROXNUM = 57603
+const SIGNED = 57604
+const UNSIGNED = 57605
+const ZEROFILL = 57606
+const COLLATION = 57607
+const DATABASES = 57608
+const SCHEMAS = 57609
+const TABLES = 57610
+const VITESS_METADATA = 57611
+const VSCHEMA = 57612
+const FULL = 57613
+const PROCESSLIST = 57614
+const COLUMNS = 57615
+const FIELDS = 57616
+const ENGINES = 57617
+const PLUGINS = 57618
+const EXTENDED = 57619
+const KEYSPACES = 57620
+const VITESS_KEYSPACES = 57621
+const VITESS_SHARDS = 57622
+const VITESS_TABLETS = 57623
+const CODE = 57624
+const PRIVILEGES = 57625
+const FUNCTION = 57626
+const OPEN = 57627
+const TRIGGERS = 57628
+const EVENT = 57629
+const USER = 57630
+const NAMES = 57631
+const CHARSET = 57632
+const GLOBAL = 57633
+const SESSION = 57634
+const ISOLATION = 57635
+const LEVEL = 57636
+const READ = 57637
+const WRITE = 57638
+const ONLY = 57639
+const REPEATABLE = 57640
+const COMMITTED = 57641
+const UNCOMMITTED = 57642
+const SERIALIZABLE = 57643
+const CURRENT_TIMESTAMP = 57644
+const DATABASE = 57645
+const CURRENT_DATE = 57646
+const CURRENT_TIME = 57647
+const LOCALTIME = 57648
+const LOCALTIMESTAMP = 57649
+const CURRENT_USER = 57650
+const UTC_DATE = 57651
+const UTC_TIME = 57652
+const UTC_TIMESTAMP = 57653
+const REPLACE = 57654
+const CONVERT = 57655
+const CAST = 57656
+const SUBSTR = 57657
+const SUBSTRING = 57658
+const GROUP_CONCAT = 57659
+const SEPARATOR = 57660
+const TIMESTAMPADD = 57661
+const TIMESTAMPDIFF = 57662
+const MATCH = 57663
+const AGAINST = 57664
+const BOOLEAN = 57665
+const LANGUAGE = 57666
+const WITH = 57667
+const QUERY = 57668
+const EXPANSION = 57669
+const WITHOUT = 57670
+const VALIDATION = 57671
+const UNUSED = 57672
+const ARRAY = 57673
+const CUME_DIST = 57674
+const DESCRIPTION = 57675
+const DENSE_RANK = 57676
+const EMPTY = 57677
+const EXCEPT = 57678
+const FIRST_VALUE = 57679
+const GROUPING = 57680
+const GROUPS = 57681
+const JSON_TABLE = 57682
+const LAG = 57683
+const LAST_VALUE = 57684
+const LATERAL = 57685
+const LEAD = 57686
+const MEMBER = 57687
+const NTH_VALUE = 57688
+const NTILE = 57689
+const OF = 57690
+const OVER = 57691
+const PERCENT_RANK = 57692
+const RANK = 57693
+const RECURSIVE = 57694
+const ROW_NUMBER = 57695
+const SYSTEM = 57696
+const WINDOW = 57697
+const ACTIVE = 57698
+const ADMIN = 57699
+const BUCKETS = 57700
+const CLONE = 57701
+const COMPONENT = 57702
+const DEFINITION = 57703
+const ENFORCED = 57704
+const EXCLUDE = 57705
+const FOLLOWING = 57706
+const GEOMCOLLECTION = 57707
+const GET_MASTER_PUBLIC_KEY = 57708
+const HISTOGRAM = 57709
+const HISTORY = 57710
+const INACTIVE = 57711
+const INVISIBLE = 57712
+const LOCKED = 57713
+const MASTER_COMPRESSION_ALGORITHMS = 57714
+const MASTER_PUBLIC_KEY_PATH = 57715
+const MASTER_TLS_CIPHERSUITES = 57716
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57717
+const NESTED = 57718
+const NETWORK_NAMESPACE = 57719
+const NOWAIT = 57720
+const NULLS = 57721
+const OJ = 57722
+const OLD = 57723
+const OPTIONAL = 57724
+const ORDINALITY = 57725
+const ORGANIZATION = 57726
+const OTHERS = 57727
+const PATH = 57728
+const PERSIST = 57729
+const PERSIST_ONLY = 57730
+const PRECEDING = 57731
+const PRIVILEGE_CHECKS_USER = 57732
+const PROCESS = 57733
+const RANDOM = 57734
+const REFERENCE = 57735
+const REQUIRE_ROW_FORMAT = 57736
+const RESOURCE = 57737
+const RESPECT = 57738
+const RESTART = 57739
+const RETAIN = 57740
+const REUSE = 57741
+const ROLE = 57742
+const SECONDARY = 57743
+const SECONDARY_ENGINE = 57744
+const SECONDARY_LOAD = 57745
+const SECONDARY_UNLOAD = 57746
+const SKIP = 57747
+const SRID = 57748
+const THREAD_PRIORITY = 57749
+const TIES = 57750
+const UNBOUNDED = 57751
+const VCPU = 57752
+const VISIBLE = 57753
+const FORMAT = 57754
+const TREE = 57755
+const VITESS = 57756
+const TRADITIONAL = 57757
+const LOCAL = 57758
+const LOW_PRIORITY = 57759
+const NO_WRITE_TO_BINLOG = 57760
+const LOGS = 57761
+const ERROR = 57762
+const GENERAL = 57763
+const HOSTS = 57764
+const OPTIMIZER_COSTS = 57765
+const USER_RESOURCES = 57766
+const SLOW = 57767
+const CHANNEL = 57768
+const RELAY = 57769
+const EXPORT = 57770
+const AVG_ROW_LENGTH = 57771
+const CONNECTION = 57772
+const CHECKSUM = 57773
+const DELAY_KEY_WRITE = 57774
+const ENCRYPTION = 57775
+const ENGINE = 57776
+const INSERT_METHOD = 57777
+const MAX_ROWS = 57778
+const MIN_ROWS = 57779
+const PACK_KEYS = 57780
+const PASSWORD = 57781
+const FIXED = 57782
+const DYNAMIC = 57783
+const COMPRESSED = 57784
+const REDUNDANT = 57785
+const COMPACT = 57786
+const ROW_FORMAT = 57787
+const STATS_AUTO_RECALC = 57788
+const STATS_PERSISTENT = 57789
+const STATS_SAMPLE_PAGES = 57790
+const STORAGE = 57791
+const MEMORY = 57792
+const DISK = 57793
 
 var yyToknames = [...]string{
 	"$end",
@@ -813,6 +842,7 @@ var yyToknames = [...]string{
 	"FIRST",
 	"AFTER",
 	"LAST",
+	"BEFORE",
 	"BEGIN",
 	"START",
 	"TRANSACTION",
@@ -1069,620 +1099,682 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 43,
-	163, 923,
-	-2, 90,
 	-1, 44,
-	1, 111,
-	468, 111,
-	-2, 117,
+	163, 989,
+	-2, 91,
 	-1, 45,
-	143, 117,
-	254, 117,
-	306, 117,
-	-2, 324,
-	-1, 52,
-	34, 465,
-	164, 465,
-	176, 465,
-	209, 479,
-	210, 479,
-	-2, 467,
-	-1, 57,
-	166, 489,
-	-2, 487,
-	-1, 82,
-	56, 556,
-	-2, 564,
-	-1, 107,
-	1, 112,
-	468, 112,
-	-2, 117,
-	-1, 117,
-	169, 229,
-	170, 229,
-	-2, 318,
-	-1, 136,
-	143, 117,
-	254, 117,
-	306, 117,
-	-2, 333,
-	-1, 570,
-	150, 944,
-	-2, 940,
-	-1, 571,
-	150, 945,
-	-2, 941,
-	-1, 589,
-	56, 557,
-	-2, 569,
-	-1, 590,
-	56, 558,
-	-2, 570,
-	-1, 610,
-	118, 1283,
-	-2, 83,
-	-1, 611,
-	118, 1166,
+	1, 123,
+	469, 123,
+	-2, 129,
+	-1, 46,
+	143, 129,
+	255, 129,
+	307, 129,
+	-2, 336,
+	-1, 53,
+	34, 503,
+	164, 503,
+	176, 503,
+	209, 517,
+	210, 517,
+	-2, 505,
+	-1, 58,
+	166, 527,
+	-2, 525,
+	-1, 85,
+	56, 622,
+	-2, 630,
+	-1, 110,
+	1, 124,
+	469, 124,
+	-2, 129,
+	-1, 120,
+	169, 241,
+	170, 241,
+	-2, 330,
+	-1, 139,
+	143, 129,
+	255, 129,
+	307, 129,
+	-2, 345,
+	-1, 577,
+	150, 1010,
+	-2, 1006,
+	-1, 578,
+	150, 1011,
+	-2, 1007,
+	-1, 597,
+	56, 623,
+	-2, 635,
+	-1, 598,
+	56, 624,
+	-2, 636,
+	-1, 618,
+	118, 1350,
 	-2, 84,
-	-1, 617,
-	118, 1216,
-	-2, 917,
-	-1, 754,
-	118, 1104,
-	-2, 914,
-	-1, 789,
-	175, 37,
-	180, 37,
-	-2, 240,
-	-1, 868,
-	1, 371,
-	468, 371,
-	-2, 117,
-	-1, 1104,
-	1, 267,
-	468, 267,
-	-2, 117,
-	-1, 1182,
-	169, 229,
-	170, 229,
-	-2, 318,
-	-1, 1191,
+	-1, 619,
+	118, 1233,
+	-2, 85,
+	-1, 625,
+	118, 1283,
+	-2, 983,
+	-1, 762,
+	118, 1171,
+	-2, 980,
+	-1, 797,
 	175, 38,
 	180, 38,
-	-2, 241,
-	-1, 1399,
-	150, 947,
-	-2, 943,
-	-1, 1491,
-	74, 65,
-	82, 65,
-	-2, 69,
-	-1, 1512,
-	1, 268,
-	468, 268,
-	-2, 117,
-	-1, 1920,
-	5, 811,
-	18, 811,
-	20, 811,
-	32, 811,
-	83, 811,
-	-2, 595,
-	-1, 2132,
-	46, 885,
-	-2, 883,
+	-2, 252,
+	-1, 881,
+	1, 383,
+	469, 383,
+	-2, 129,
+	-1, 1131,
+	1, 279,
+	469, 279,
+	-2, 129,
+	-1, 1209,
+	169, 241,
+	170, 241,
+	-2, 330,
+	-1, 1218,
+	175, 39,
+	180, 39,
+	-2, 253,
+	-1, 1320,
+	163, 568,
+	-2, 567,
+	-1, 1449,
+	150, 1013,
+	-2, 1009,
+	-1, 1542,
+	74, 66,
+	82, 66,
+	-2, 70,
+	-1, 1563,
+	1, 280,
+	469, 280,
+	-2, 129,
+	-1, 1927,
+	118, 570,
+	-2, 566,
+	-1, 2014,
+	5, 877,
+	18, 877,
+	20, 877,
+	32, 877,
+	83, 877,
+	-2, 661,
+	-1, 2274,
+	46, 951,
+	-2, 949,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 27976
+const yyLast = 29569
 
 var yyAct = [...]int{
-	570, 2213, 2200, 1972, 2177, 1833, 1802, 1723, 514, 2141,
-	2132, 2083, 2061, 926, 81, 3, 1900, 1575, 543, 1690,
-	1509, 1901, 582, 1436, 1969, 1059, 1724, 1007, 529, 1897,
-	1542, 1806, 1710, 512, 1527, 1166, 1547, 758, 1787, 1488,
-	1788, 1912, 1859, 145, 1650, 1786, 880, 1625, 176, 1393,
-	1573, 188, 1300, 477, 188, 907, 1052, 1189, 79, 493,
-	819, 188, 131, 1385, 1549, 1096, 1780, 1089, 1477, 188,
-	784, 1470, 1062, 1057, 1438, 1082, 1045, 1419, 615, 576,
-	591, 1362, 32, 516, 943, 1080, 797, 1161, 765, 762,
-	493, 1165, 505, 493, 188, 493, 1196, 1079, 770, 790,
-	1279, 1453, 1086, 612, 766, 1538, 785, 786, 1095, 1069,
-	1493, 77, 924, 787, 1093, 1305, 1207, 774, 874, 861,
-	1181, 500, 8, 114, 115, 7, 108, 109, 148, 76,
-	6, 1825, 1824, 1604, 2085, 1847, 1848, 1351, 175, 1020,
-	1266, 1433, 1434, 1528, 1350, 1349, 1021, 177, 178, 179,
-	1348, 82, 1347, 1346, 503, 1339, 504, 597, 601, 1688,
-	577, 2129, 509, 759, 2169, 2040, 116, 2107, 2106, 1946,
-	823, 188, 2056, 110, 822, 2057, 2219, 2174, 2212, 1167,
-	824, 188, 78, 873, 501, 2152, 188, 84, 85, 86,
-	87, 88, 89, 944, 821, 2203, 453, 177, 178, 179,
-	1552, 1973, 1592, 1640, 609, 801, 2173, 835, 836, 1876,
-	839, 840, 841, 842, 2004, 776, 845, 846, 847, 848,
-	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
-	859, 832, 800, 616, 778, 777, 110, 944, 555, 2151,
-	561, 562, 559, 560, 102, 558, 557, 556, 1097, 174,
-	1098, 825, 826, 827, 779, 563, 564, 470, 954, 1927,
-	1928, 1504, 1505, 1435, 34, 1494, 469, 70, 38, 39,
-	1611, 1689, 1754, 1926, 1610, 1753, 467, 1846, 1755, 1551,
-	169, 105, 1638, 182, 183, 1503, 914, 900, 916, 177,
-	178, 179, 837, 481, 893, 887, 888, 899, 574, 105,
-	838, 97, 954, 876, 110, 111, 100, 133, 922, 99,
-	98, 573, 1771, 780, 1521, 464, 153, 2154, 1835, 1340,
-	1341, 1342, 1995, 1993, 475, 913, 915, 491, 1338, 495,
-	489, 1256, 1807, 942, 105, 170, 1396, 885, 103, 69,
-	1574, 886, 887, 888, 1607, 480, 1829, 143, 950, 1280,
-	2202, 1288, 132, 1289, 1830, 1290, 103, 862, 904, 905,
-	902, 903, 1285, 920, 906, 1619, 869, 481, 1838, 844,
-	150, 843, 151, 1257, 2103, 1258, 901, 120, 121, 142,
-	141, 168, 1837, 894, 2170, 1282, 781, 921, 2051, 808,
-	1836, 1576, 950, 1284, 454, 456, 457, 1175, 473, 474,
-	482, 806, 1471, 818, 471, 472, 483, 458, 459, 487,
-	486, 481, 463, 460, 462, 468, 1286, 817, 816, 480,
-	466, 484, 815, 912, 481, 814, 911, 917, 2220, 137,
-	118, 144, 125, 117, 1283, 138, 139, 1945, 104, 154,
-	813, 812, 910, 811, 810, 805, 1553, 2052, 1494, 159,
-	126, 1624, 763, 188, 897, 2189, 104, 793, 173, 481,
-	763, 1195, 1194, 480, 129, 127, 122, 123, 124, 128,
-	107, 918, 1768, 1763, 119, 792, 480, 875, 493, 493,
-	493, 809, 775, 130, 949, 946, 947, 948, 953, 955,
-	952, 104, 951, 807, 603, 1839, 493, 493, 919, 945,
-	2217, 883, 1598, 889, 890, 891, 892, 1293, 2150, 930,
-	1609, 480, 936, 799, 828, 763, 1764, 1796, 2142, 761,
-	1606, 1691, 1693, 923, 1639, 799, 2155, 1885, 949, 946,
-	947, 948, 953, 955, 952, 1884, 951, 1883, 1766, 773,
-	772, 1761, 771, 945, 1627, 485, 1817, 1627, 872, 1626,
-	769, 146, 1626, 1762, 452, 180, 1860, 1618, 992, 993,
-	1617, 2136, 1510, 478, 2024, 1925, 1268, 1267, 1269, 1270,
-	1271, 834, 1715, 1658, 188, 1584, 1669, 799, 479, 1499,
-	1073, 1594, 1005, 878, 896, 866, 71, 980, 1750, 1449,
-	884, 927, 928, 1050, 970, 990, 898, 980, 799, 1862,
-	493, 908, 1049, 188, 140, 188, 188, 1666, 493, 177,
-	178, 179, 1769, 1767, 493, 799, 134, 1692, 1335, 135,
-	939, 612, 882, 937, 960, 2111, 92, 1008, 938, 820,
-	2119, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 799, 1078, 980, 868, 2215, 798, 1046,
-	2216, 1910, 2214, 1306, 802, 792, 1281, 1864, 1099, 1868,
-	798, 1863, 940, 1861, 803, 863, 1878, 864, 1866, 1776,
-	865, 93, 1063, 867, 177, 178, 179, 1865, 1387, 1420,
-	994, 995, 996, 997, 998, 999, 1000, 1001, 1002, 1003,
-	1867, 1869, 957, 1591, 1043, 1665, 1023, 1025, 1027, 1029,
-	1031, 1033, 1034, 1024, 1026, 1593, 1030, 1032, 960, 1035,
-	1172, 1420, 798, 1676, 833, 992, 993, 909, 1589, 1765,
-	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 1051, 808, 798, 1388, 881, 164, 165, 166, 167,
-	792, 795, 796, 806, 763, 1369, 992, 993, 789, 793,
-	798, 616, 1586, 1586, 1454, 1455, 802, 792, 188, 1367,
-	1368, 1366, 1157, 1451, 2204, 1930, 803, 788, 2039, 1307,
-	172, 2221, 1168, 1169, 1170, 1171, 1590, 1588, 798, 958,
-	959, 957, 959, 957, 804, 792, 795, 796, 493, 763,
-	1191, 69, 2205, 789, 793, 1066, 1664, 960, 1200, 960,
-	2038, 1951, 1204, 1365, 1663, 493, 493, 1784, 493, 1201,
-	493, 493, 1783, 493, 493, 493, 493, 493, 493, 973,
-	974, 975, 976, 977, 970, 1187, 1450, 980, 493, 958,
-	959, 957, 188, 1240, 1235, 1236, 958, 959, 957, 2222,
-	1173, 1174, 2194, 2120, 1556, 1276, 1180, 960, 1253, 602,
-	1261, 958, 959, 957, 960, 1199, 958, 959, 957, 493,
-	958, 959, 957, 1094, 1880, 1237, 768, 188, 1260, 960,
-	2195, 1643, 1644, 1645, 960, 188, 1259, 1299, 960, 188,
-	1275, 1887, 1197, 1197, 1357, 1359, 1360, 1164, 1163, 1251,
-	177, 178, 179, 1198, 1757, 188, 1358, 1273, 1243, 1244,
-	1156, 1245, 188, 1177, 1249, 1250, 1178, 1176, 599, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 493, 493,
-	493, 1263, 1209, 1242, 1210, 1061, 1212, 1214, 1190, 1888,
-	1218, 1220, 1222, 1224, 1226, 1785, 1241, 604, 605, 1274,
-	1310, 1308, 1309, 188, 177, 178, 179, 1314, 1568, 1316,
-	1317, 1318, 1319, 607, 1321, 1313, 1272, 1302, 1216, 958,
-	959, 957, 1320, 2207, 971, 972, 973, 974, 975, 976,
-	977, 970, 1238, 506, 980, 2206, 2196, 960, 2185, 1832,
-	1262, 1386, 1363, 532, 531, 534, 535, 536, 537, 2074,
-	1389, 1294, 533, 2036, 538, 778, 777, 110, 177, 178,
-	179, 2012, 1566, 1933, 493, 177, 178, 179, 1889, 1254,
-	1793, 1312, 177, 178, 179, 1781, 78, 1634, 1602, 1397,
-	1601, 1303, 1408, 1411, 1264, 1252, 1390, 1391, 1421, 1248,
-	1331, 1332, 1333, 1247, 1403, 1246, 586, 493, 493, 1958,
-	2188, 1958, 2148, 1958, 2137, 1345, 2101, 1364, 188, 1958,
-	586, 1958, 2109, 2054, 586, 1586, 586, 1495, 1398, 2022,
-	586, 493, 1958, 1963, 1943, 1942, 1939, 1940, 188, 1939,
-	1938, 493, 1444, 2100, 1008, 188, 1443, 188, 1462, 586,
-	1494, 1826, 1456, 1495, 1399, 188, 188, 1397, 1711, 1427,
-	1428, 1971, 493, 1711, 586, 493, 1160, 1811, 34, 1489,
-	1804, 1805, 1474, 586, 1809, 612, 493, 1898, 612, 956,
-	586, 1160, 1159, 1105, 1104, 34, 1909, 80, 1400, 1496,
-	1795, 2041, 1587, 1718, 1518, 1361, 1468, 1498, 1370, 1371,
-	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 1384, 1514, 1464, 1496, 1719, 1463, 1513, 1909,
-	1744, 2019, 1399, 1494, 1529, 1530, 1531, 1474, 1494, 1473,
-	956, 493, 1909, 2090, 2110, 188, 1231, 34, 493, 2042,
-	2043, 2044, 1958, 69, 1565, 1567, 1517, 1586, 1941, 1474,
-	1466, 1502, 1681, 1462, 1680, 1423, 1544, 493, 1492, 1462,
-	69, 579, 1586, 493, 1569, 1550, 1452, 1200, 1497, 1200,
-	1522, 1501, 1523, 1524, 1525, 1526, 1500, 1585, 1431, 1343,
-	1474, 1292, 1516, 1515, 1232, 1233, 1234, 1462, 1534, 1535,
-	1536, 1537, 1091, 783, 1572, 782, 2140, 69, 2063, 1970,
-	2030, 1162, 1543, 1831, 1579, 616, 1539, 493, 616, 1386,
-	1533, 1532, 69, 1278, 1386, 1386, 1545, 1192, 1188, 1158,
-	94, 1790, 2045, 174, 1582, 1789, 1583, 1834, 1540, 1541,
-	1561, 1562, 1563, 1555, 1557, 1554, 69, 1913, 1914, 1228,
-	2064, 571, 801, 1167, 1595, 2209, 2201, 1916, 1898, 188,
-	1545, 1578, 1197, 188, 188, 188, 188, 188, 1596, 1800,
-	1577, 1799, 1581, 188, 188, 188, 188, 2046, 2047, 800,
-	1790, 1798, 1597, 1559, 1336, 1295, 188, 1599, 1600, 1919,
-	1918, 1735, 1732, 188, 1229, 1230, 1736, 1479, 1482, 1483,
-	1484, 1480, 189, 1481, 1485, 189, 1733, 1913, 1914, 1731,
-	494, 1734, 189, 2191, 2172, 1060, 1890, 188, 493, 1737,
-	189, 1483, 1484, 1700, 2023, 1961, 1404, 1405, 1709, 1708,
-	1410, 1413, 1414, 2160, 2157, 1629, 1630, 2193, 2176, 2178,
-	1632, 494, 96, 2184, 494, 189, 494, 1633, 101, 2183,
-	1479, 1482, 1483, 1484, 1480, 1426, 1481, 1485, 1429, 1430,
-	2133, 1605, 2131, 1291, 572, 1363, 978, 979, 971, 972,
-	973, 974, 975, 976, 977, 970, 592, 1622, 980, 969,
-	968, 978, 979, 971, 972, 973, 974, 975, 976, 977,
-	970, 593, 181, 980, 1698, 171, 1794, 961, 184, 830,
-	829, 1053, 1699, 1982, 1789, 1845, 1660, 929, 1637, 592,
-	1416, 2088, 188, 1054, 1064, 1065, 595, 2017, 594, 1422,
-	188, 1819, 189, 1818, 593, 1417, 111, 1935, 1646, 1934,
-	1364, 1580, 189, 506, 1206, 1205, 1193, 189, 1651, 1454,
-	1455, 1564, 1018, 1447, 188, 1298, 2102, 589, 590, 595,
-	2058, 594, 1487, 580, 581, 188, 188, 188, 188, 188,
-	1659, 1707, 1725, 577, 1642, 1720, 583, 188, 2198, 1706,
-	2197, 188, 1055, 1058, 188, 188, 1675, 2181, 188, 188,
-	188, 1697, 1716, 1713, 2161, 1742, 2016, 1046, 1687, 1957,
-	1570, 1756, 584, 1704, 1695, 80, 2015, 1893, 1711, 2211,
-	2210, 585, 1670, 1667, 1074, 1067, 1703, 2211, 579, 1775,
-	1647, 1648, 1649, 1745, 2134, 1712, 1932, 1747, 1448, 78,
-	83, 75, 1, 465, 1432, 1714, 1044, 476, 1774, 2199,
-	1777, 1778, 1779, 1265, 1738, 1255, 1759, 1974, 1772, 1773,
-	188, 1727, 1728, 1743, 1730, 2060, 1748, 1964, 1751, 1548,
-	791, 493, 1726, 136, 1511, 1729, 1512, 493, 1302, 2144,
-	493, 91, 1200, 1808, 1550, 1760, 1812, 493, 756, 90,
-	794, 895, 1571, 2055, 1770, 1520, 1111, 1109, 1814, 1823,
-	1782, 586, 1110, 1108, 1113, 1112, 1107, 188, 1337, 490,
-	1486, 1100, 1792, 1791, 1068, 831, 455, 1944, 1822, 1334,
-	1603, 461, 988, 1705, 1752, 188, 613, 542, 1821, 606,
-	1904, 2182, 1180, 2158, 2156, 2130, 2084, 1398, 2159, 1813,
-	2128, 2192, 2175, 1519, 1446, 1056, 1820, 969, 968, 978,
-	979, 971, 972, 973, 974, 975, 976, 977, 970, 493,
-	2014, 980, 1892, 1399, 1674, 1386, 1017, 1418, 1083, 1841,
-	1840, 515, 1856, 1442, 1356, 530, 527, 528, 187, 1457,
-	1717, 488, 962, 513, 1843, 1857, 507, 1844, 187, 1075,
-	1478, 1476, 1849, 1475, 1296, 493, 187, 1087, 1915, 1877,
-	1911, 1081, 1461, 1608, 1828, 941, 188, 1855, 1871, 588,
-	502, 95, 600, 600, 1415, 2118, 493, 1858, 1641, 2003,
-	587, 187, 493, 493, 189, 60, 1899, 1725, 1870, 1856,
-	37, 1655, 1656, 497, 2168, 932, 596, 31, 1902, 1896,
-	30, 29, 28, 23, 22, 188, 21, 20, 19, 494,
-	494, 494, 1673, 25, 18, 17, 1908, 16, 106, 47,
-	44, 42, 113, 112, 45, 41, 870, 494, 494, 27,
-	26, 1917, 15, 14, 13, 12, 1921, 11, 1923, 10,
-	1924, 9, 5, 4, 1922, 935, 24, 1006, 2, 0,
-	0, 1304, 0, 1936, 1937, 1952, 0, 188, 187, 188,
-	188, 188, 1929, 0, 0, 493, 0, 0, 187, 0,
-	0, 1886, 0, 187, 1851, 1852, 1960, 0, 188, 0,
-	0, 0, 0, 0, 0, 0, 0, 1948, 1947, 1872,
-	1873, 0, 1874, 1875, 1965, 1975, 493, 493, 493, 1907,
-	188, 0, 1959, 1881, 1882, 189, 0, 1550, 1962, 1983,
-	1949, 1950, 1968, 1967, 2007, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1352, 1353, 1354, 1355, 0,
-	0, 494, 0, 0, 189, 0, 189, 189, 0, 494,
-	1986, 0, 0, 0, 0, 494, 0, 0, 0, 0,
-	1980, 1981, 0, 0, 0, 1991, 0, 0, 0, 0,
-	0, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 0, 0, 980, 0, 0, 1725, 0,
-	1406, 1407, 0, 2013, 0, 0, 1931, 0, 0, 2018,
-	0, 0, 0, 0, 2026, 0, 2027, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2032, 1988, 1989,
-	0, 1990, 0, 0, 1992, 2033, 1994, 506, 493, 493,
-	169, 2034, 0, 0, 2049, 0, 0, 0, 0, 0,
-	0, 493, 0, 2035, 493, 2037, 0, 2059, 2048, 0,
-	0, 0, 0, 0, 0, 111, 0, 2062, 0, 0,
-	2067, 0, 0, 0, 0, 0, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1508, 493,
-	493, 493, 188, 1984, 0, 2077, 2079, 2080, 0, 0,
-	0, 0, 0, 493, 2066, 493, 2073, 0, 0, 189,
-	0, 493, 0, 0, 0, 2081, 2091, 2096, 1902, 0,
-	2093, 2089, 1902, 2065, 2087, 0, 0, 2082, 0, 2095,
-	150, 0, 151, 188, 0, 2097, 2098, 0, 2099, 494,
-	0, 168, 0, 0, 493, 188, 0, 1546, 0, 0,
-	2112, 0, 2105, 2108, 0, 0, 494, 494, 0, 494,
-	187, 494, 494, 0, 494, 494, 494, 494, 494, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 494,
-	0, 2127, 0, 189, 0, 0, 0, 2135, 0, 1902,
-	0, 493, 493, 0, 0, 0, 0, 2138, 0, 154,
-	0, 0, 0, 2143, 2062, 2145, 0, 0, 0, 159,
-	494, 0, 0, 0, 0, 0, 0, 493, 189, 0,
-	2153, 493, 2162, 1725, 0, 0, 189, 2167, 2164, 0,
-	189, 0, 0, 0, 2171, 0, 0, 0, 0, 0,
-	2179, 2068, 2069, 2070, 2071, 2072, 189, 2180, 0, 2075,
-	2076, 0, 0, 189, 2001, 2190, 0, 0, 0, 0,
-	189, 189, 189, 189, 189, 189, 189, 189, 189, 494,
-	494, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 2208, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2218, 189, 2006, 600, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 964, 0, 967, 0,
-	187, 146, 187, 1090, 981, 982, 983, 984, 985, 986,
-	987, 0, 965, 966, 963, 969, 968, 978, 979, 971,
-	972, 973, 974, 975, 976, 977, 970, 0, 0, 980,
-	0, 0, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 494, 980, 0, 0, 0,
-	0, 169, 0, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 2165, 0, 0, 0, 111, 0, 494, 494,
-	0, 0, 0, 0, 0, 0, 0, 153, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1677,
-	0, 0, 494, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 494, 0, 0, 0, 189, 0, 189, 0,
-	0, 0, 0, 0, 0, 0, 189, 189, 1758, 1701,
-	1702, 1058, 2000, 494, 0, 0, 494, 0, 0, 0,
-	0, 150, 0, 151, 0, 0, 0, 494, 0, 0,
-	0, 0, 168, 0, 0, 187, 968, 978, 979, 971,
-	972, 973, 974, 975, 976, 977, 970, 0, 0, 980,
-	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 0, 0, 0, 0, 0, 164, 165, 166, 167,
-	0, 0, 0, 0, 0, 0, 0, 0, 1203, 0,
-	0, 0, 494, 0, 0, 0, 189, 0, 0, 494,
-	154, 0, 0, 0, 0, 0, 1401, 1402, 0, 0,
-	159, 0, 0, 1203, 1203, 0, 0, 0, 494, 187,
-	0, 0, 0, 0, 494, 0, 0, 0, 0, 0,
-	0, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 1999, 0, 980, 0, 0, 0, 1850,
-	1445, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 1301, 0, 494, 969,
-	968, 978, 979, 971, 972, 973, 974, 975, 976, 977,
-	970, 0, 187, 980, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 1322, 1323, 187, 187,
-	187, 187, 187, 187, 187, 0, 0, 0, 0, 0,
-	189, 0, 146, 0, 189, 189, 189, 189, 189, 0,
-	541, 0, 0, 0, 189, 189, 189, 189, 1998, 0,
-	187, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 189, 1879, 0, 0, 0, 0,
-	0, 0, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 0, 980, 0, 189, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
-	1894, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 600, 1301, 0, 0, 0, 600, 600, 0,
-	0, 600, 600, 600, 0, 0, 0, 1203, 0, 0,
-	614, 0, 0, 760, 0, 767, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 600, 600, 600, 600,
-	600, 0, 0, 0, 0, 1440, 0, 969, 968, 978,
-	979, 971, 972, 973, 974, 975, 976, 977, 970, 0,
-	0, 980, 0, 0, 0, 187, 0, 0, 0, 0,
-	0, 1301, 187, 189, 187, 0, 0, 0, 0, 0,
-	0, 189, 187, 187, 0, 0, 0, 0, 0, 0,
-	0, 147, 152, 149, 155, 156, 157, 158, 160, 161,
-	162, 163, 0, 0, 0, 189, 0, 164, 165, 166,
-	167, 0, 0, 0, 0, 0, 189, 189, 189, 189,
-	189, 0, 1652, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 189, 0, 0, 189, 189, 0, 0, 189,
-	189, 189, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 0, 980, 0, 0, 2005,
-	0, 0, 187, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 506, 0, 0, 0, 0, 0, 0, 2028,
-	0, 0, 2029, 0, 0, 2031, 0, 0, 0, 1653,
-	0, 189, 0, 1654, 0, 0, 0, 0, 0, 0,
-	0, 0, 494, 0, 1661, 1662, 0, 0, 494, 0,
-	1668, 494, 0, 1671, 1672, 0, 0, 0, 494, 0,
-	0, 1678, 0, 1679, 0, 0, 1682, 1683, 1684, 1685,
-	1686, 0, 0, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 1696, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	187, 187, 187, 187, 187, 0, 0, 0, 0, 0,
-	187, 187, 187, 187, 2086, 506, 0, 0, 1740, 1741,
-	494, 0, 0, 187, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 494, 0, 0,
-	0, 0, 0, 494, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 600, 600, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 614,
-	614, 0, 0, 600, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 931, 933, 0, 187,
-	0, 0, 0, 0, 0, 0, 0, 1440, 189, 0,
-	189, 189, 189, 0, 0, 0, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	600, 187, 0, 0, 0, 1853, 1854, 0, 0, 0,
-	0, 1203, 187, 187, 187, 187, 187, 494, 494, 494,
-	0, 189, 0, 0, 1739, 0, 0, 0, 187, 0,
-	0, 187, 187, 0, 0, 187, 1749, 1301, 0, 0,
-	0, 0, 0, 0, 1047, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1071, 1905, 0, 0, 0, 0, 0, 0, 614, 0,
-	0, 0, 0, 0, 1101, 0, 0, 0, 0, 0,
-	0, 0, 1920, 0, 0, 186, 0, 187, 0, 0,
-	0, 0, 0, 0, 0, 496, 0, 0, 0, 0,
-	0, 0, 1203, 575, 0, 0, 0, 0, 0, 0,
-	0, 0, 1301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 764, 494,
-	494, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 494, 0, 0, 494, 0, 0, 0, 0,
-	0, 0, 187, 544, 33, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	494, 494, 494, 189, 0, 600, 0, 33, 0, 0,
-	0, 0, 0, 0, 494, 1985, 494, 0, 0, 1987,
-	0, 0, 494, 0, 0, 860, 0, 0, 0, 0,
-	1996, 1997, 0, 0, 0, 871, 0, 0, 0, 0,
-	877, 0, 0, 0, 189, 0, 2011, 0, 0, 0,
-	0, 578, 0, 187, 0, 494, 189, 0, 0, 0,
-	0, 0, 0, 2020, 2021, 0, 1203, 2025, 760, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1202, 0, 0, 0, 1208, 1208, 0, 1208, 0,
-	1208, 1208, 187, 1217, 1208, 1208, 1208, 1208, 1208, 0,
-	0, 0, 494, 494, 0, 0, 1202, 1202, 760, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2053, 0, 0, 0, 494, 0,
-	0, 0, 494, 0, 0, 0, 0, 0, 0, 1277,
-	0, 0, 0, 0, 187, 0, 187, 187, 187, 0,
-	0, 0, 0, 0, 0, 1203, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 2078, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 614,
-	614, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2114, 2115,
-	2116, 2117, 0, 2121, 0, 2122, 2123, 2124, 0, 2125,
-	2126, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2149, 0,
-	0, 0, 0, 0, 1392, 0, 614, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1202, 0, 0, 0, 0, 0, 0, 879, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1424, 1425, 0,
-	0, 0, 0, 0, 0, 2186, 2187, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 169, 0, 0,
-	0, 1458, 0, 0, 0, 0, 0, 0, 1801, 0,
-	0, 1071, 0, 0, 614, 1128, 0, 0, 0, 1440,
-	0, 0, 111, 0, 133, 0, 0, 0, 0, 0,
-	0, 0, 614, 153, 0, 614, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 760, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 143, 0, 0, 0, 0, 132,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 150, 0, 151,
-	0, 0, 0, 0, 1183, 1184, 142, 141, 168, 0,
-	0, 767, 0, 0, 0, 0, 0, 0, 1560, 0,
-	0, 925, 925, 925, 0, 0, 0, 1077, 0, 0,
-	1088, 0, 0, 0, 0, 0, 0, 760, 1116, 0,
-	0, 33, 0, 767, 0, 0, 0, 0, 0, 0,
-	0, 0, 1203, 0, 989, 991, 137, 1185, 144, 0,
-	1182, 0, 138, 139, 0, 0, 154, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
-	0, 1129, 0, 0, 0, 1004, 0, 760, 0, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 0, 1019, 1022,
-	1022, 1022, 1028, 1022, 1022, 1028, 1022, 1036, 1037, 1038,
-	1039, 1040, 1041, 1042, 0, 0, 0, 0, 0, 1048,
-	0, 0, 33, 0, 0, 0, 0, 0, 1142, 1145,
-	1146, 1147, 1148, 1149, 1150, 0, 1151, 1152, 1153, 1154,
-	1155, 1130, 1131, 1132, 1133, 1114, 1115, 1143, 1084, 1117,
-	0, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 1106, 0, 0, 0, 0, 0, 1636, 0,
+	577, 2377, 2356, 2067, 2077, 2327, 1912, 1905, 521, 944,
+	2274, 2283, 1795, 2212, 1994, 1032, 1626, 1762, 1486, 520,
+	1995, 2063, 590, 2188, 1578, 1874, 1079, 536, 1796, 1991,
+	84, 3, 1593, 1598, 1859, 1234, 1878, 1860, 1782, 2006,
+	1539, 1953, 519, 148, 1858, 1086, 1722, 1435, 1600, 179,
+	1443, 1690, 191, 893, 481, 191, 1624, 550, 1216, 1852,
+	497, 623, 191, 1193, 792, 1123, 920, 1521, 1116, 1339,
+	191, 134, 1528, 1089, 1107, 1084, 766, 1106, 1488, 82,
+	584, 512, 1109, 1070, 1469, 523, 33, 1412, 773, 599,
+	1113, 968, 1668, 497, 798, 1306, 497, 191, 497, 774,
+	1560, 778, 620, 795, 1192, 827, 1223, 770, 793, 794,
+	1504, 1122, 1589, 1120, 805, 1446, 80, 1344, 1544, 1096,
+	1074, 887, 151, 942, 782, 1208, 111, 869, 112, 117,
+	118, 507, 1045, 14, 13, 12, 1188, 178, 1579, 85,
+	1046, 11, 8, 7, 6, 1897, 1896, 1655, 79, 2214,
+	1941, 1942, 180, 181, 182, 1293, 1401, 1483, 1484, 1400,
+	767, 605, 609, 1399, 1398, 1397, 1396, 585, 113, 510,
+	1389, 511, 119, 969, 191, 2313, 87, 88, 89, 90,
+	91, 92, 1760, 832, 191, 2271, 886, 2155, 2236, 191,
+	2040, 2171, 457, 2235, 2172, 508, 831, 830, 2386, 2324,
+	2376, 81, 2296, 1913, 2363, 2361, 617, 2261, 994, 993,
+	1003, 1004, 996, 997, 998, 999, 1000, 1001, 1002, 995,
+	1194, 2320, 1005, 1643, 2323, 808, 1970, 1712, 2119, 2295,
+	784, 624, 1761, 113, 2021, 2022, 809, 786, 979, 785,
+	2020, 1603, 829, 1940, 833, 834, 835, 1710, 1826, 1662,
+	969, 1825, 787, 1661, 1827, 843, 844, 1554, 847, 848,
+	849, 850, 840, 913, 853, 854, 855, 856, 857, 858,
+	859, 860, 861, 862, 863, 864, 865, 866, 867, 1485,
+	35, 1555, 1556, 72, 39, 40, 1124, 172, 1125, 1545,
+	845, 485, 906, 927, 108, 929, 185, 186, 1873, 900,
+	901, 113, 898, 180, 181, 182, 899, 900, 901, 889,
+	583, 912, 114, 581, 136, 979, 580, 177, 1843, 1572,
+	1602, 846, 2110, 156, 2108, 2298, 495, 788, 935, 975,
+	1383, 499, 926, 928, 1390, 1391, 1392, 493, 1917, 1918,
+	2089, 1879, 2088, 484, 1625, 35, 36, 37, 72, 39,
+	40, 106, 1307, 914, 146, 71, 1901, 1658, 2358, 135,
+	105, 870, 1376, 933, 1902, 76, 917, 918, 1929, 1320,
+	41, 67, 68, 919, 65, 69, 1327, 153, 1328, 154,
+	1329, 66, 907, 882, 1210, 1211, 145, 144, 171, 940,
+	562, 967, 568, 569, 566, 567, 2314, 565, 564, 563,
+	915, 916, 108, 173, 1684, 1283, 975, 570, 571, 852,
+	54, 1919, 851, 2086, 1921, 108, 1928, 100, 1924, 1923,
+	71, 2262, 103, 77, 1700, 102, 101, 44, 47, 50,
+	49, 925, 1075, 485, 924, 930, 140, 1212, 147, 485,
+	1209, 1309, 141, 142, 2232, 2166, 157, 1284, 1316, 1285,
+	923, 2346, 107, 1627, 1522, 825, 162, 191, 824, 2039,
+	823, 816, 180, 181, 182, 974, 971, 972, 973, 978,
+	980, 977, 106, 976, 485, 931, 814, 822, 821, 820,
+	970, 819, 497, 497, 497, 484, 818, 813, 1604, 789,
+	1660, 484, 44, 47, 50, 49, 52, 1202, 64, 2294,
+	497, 497, 1319, 191, 932, 826, 2167, 2381, 771, 936,
+	939, 1314, 896, 801, 902, 903, 904, 905, 2189, 1545,
+	2387, 2339, 474, 53, 75, 74, 484, 176, 62, 63,
+	51, 473, 954, 1689, 941, 2299, 771, 771, 2284, 800,
+	769, 471, 974, 971, 972, 973, 978, 980, 977, 1711,
+	976, 888, 1313, 817, 1222, 1221, 783, 970, 611, 149,
+	107, 110, 1763, 1765, 910, 2178, 55, 56, 815, 57,
+	58, 59, 60, 107, 807, 1930, 1915, 1914, 1649, 1332,
+	468, 191, 1295, 1294, 1296, 1297, 1298, 948, 836, 479,
+	934, 1868, 1657, 1979, 1978, 1977, 781, 1920, 780, 779,
+	938, 1889, 1672, 73, 1015, 1321, 945, 946, 497, 897,
+	885, 191, 143, 191, 191, 1692, 497, 1077, 777, 456,
+	1691, 807, 497, 807, 137, 620, 807, 138, 183, 1692,
+	1076, 1017, 1018, 485, 1691, 961, 960, 959, 1033, 1645,
+	2278, 1822, 2139, 958, 957, 955, 956, 1741, 2019, 1954,
+	842, 1787, 71, 1105, 2379, 1738, 807, 2380, 1764, 2378,
+	458, 460, 461, 1071, 477, 478, 486, 1730, 73, 1635,
+	475, 476, 487, 462, 463, 491, 490, 1550, 467, 464,
+	466, 472, 1100, 1030, 891, 484, 470, 488, 995, 1090,
+	1561, 1005, 1956, 1005, 909, 1500, 1048, 1050, 1052, 1054,
+	1056, 1058, 1059, 1374, 1049, 1051, 911, 1055, 1057, 806,
+	1060, 1068, 1088, 180, 181, 182, 800, 803, 804, 921,
+	771, 1384, 2181, 985, 797, 801, 982, 1078, 150, 155,
+	152, 158, 159, 160, 161, 163, 164, 165, 166, 895,
+	881, 2179, 985, 796, 167, 168, 169, 170, 180, 181,
+	182, 1958, 1437, 1962, 624, 1957, 806, 1955, 806, 1345,
+	2093, 806, 1960, 1644, 810, 800, 191, 810, 800, 895,
+	1184, 1959, 828, 1848, 811, 95, 2004, 811, 1308, 1126,
+	1195, 1196, 1197, 1198, 1961, 1963, 1017, 1018, 984, 982,
+	964, 806, 812, 841, 1017, 1018, 497, 880, 1218, 1381,
+	1972, 1470, 1840, 1835, 807, 985, 1227, 1470, 1438, 1748,
+	1231, 489, 1199, 497, 497, 1642, 497, 1228, 497, 497,
+	96, 497, 497, 497, 497, 497, 497, 1640, 816, 482,
+	814, 2024, 1682, 546, 547, 922, 497, 983, 984, 982,
+	191, 1267, 1262, 1263, 483, 1974, 1836, 1908, 1093, 1236,
+	1637, 1237, 894, 1239, 1241, 985, 1280, 1245, 1247, 1249,
+	1251, 1253, 1419, 1207, 1226, 1637, 2388, 497, 1838, 2154,
+	2153, 1833, 1214, 191, 1641, 1346, 1417, 1418, 1416, 191,
+	2045, 2364, 894, 1834, 175, 1683, 2350, 1856, 191, 1639,
+	1338, 1121, 191, 1200, 1201, 998, 999, 1000, 1001, 1002,
+	995, 1855, 1191, 1005, 1190, 1680, 1681, 1183, 191, 2365,
+	610, 1225, 1264, 172, 2351, 191, 1204, 1607, 1224, 1224,
+	1205, 1203, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 497, 497, 497, 2389, 1217, 1303, 191, 114, 806,
+	1302, 1300, 1841, 1839, 1288, 1287, 800, 803, 804, 156,
+	771, 1270, 1271, 1341, 797, 801, 1678, 1276, 1277, 1677,
+	1349, 1407, 1409, 1410, 191, 1347, 1348, 1353, 191, 1355,
+	1356, 1357, 1358, 1408, 1360, 1265, 1715, 1716, 1717, 1352,
+	983, 984, 982, 776, 615, 71, 1359, 1286, 1502, 1981,
+	1830, 1278, 1379, 1380, 1315, 1317, 877, 1415, 985, 1301,
+	1299, 612, 613, 153, 113, 154, 1436, 1333, 786, 1385,
+	785, 1290, 1272, 1269, 171, 1439, 1003, 1004, 996, 997,
+	998, 999, 1000, 1001, 1002, 995, 1351, 1268, 1005, 497,
+	1243, 180, 181, 182, 1736, 1829, 2367, 1982, 878, 2366,
+	1447, 876, 1735, 1505, 1506, 2352, 1413, 1458, 1461, 879,
+	1837, 1501, 2335, 1471, 1370, 1371, 1372, 1904, 1395, 2203,
+	1440, 1441, 497, 497, 180, 181, 182, 983, 984, 982,
+	1289, 2176, 157, 191, 1414, 191, 983, 984, 982, 1453,
+	2151, 2127, 162, 2027, 1983, 985, 1916, 1865, 497, 1493,
+	1853, 1699, 1449, 1653, 985, 191, 1652, 1342, 497, 1495,
+	1448, 1291, 191, 1033, 191, 1279, 2075, 1275, 1274, 1507,
+	1447, 1273, 191, 191, 578, 1477, 1478, 2072, 871, 497,
+	873, 875, 497, 874, 1927, 983, 984, 982, 620, 2052,
+	2385, 620, 1702, 497, 996, 997, 998, 999, 1000, 1001,
+	1002, 995, 1540, 985, 1005, 180, 181, 182, 1450, 1619,
+	1454, 1455, 2052, 2338, 1460, 1463, 1464, 2052, 2321, 1737,
+	2052, 2285, 1449, 180, 181, 182, 192, 1617, 1669, 192,
+	1519, 1580, 1581, 1582, 498, 1564, 192, 2052, 2279, 1476,
+	1515, 81, 1479, 1480, 192, 149, 2052, 594, 497, 180,
+	181, 182, 191, 1281, 1325, 497, 1323, 1568, 2249, 2250,
+	2372, 1616, 1618, 1543, 594, 2052, 2247, 498, 1075, 1565,
+	498, 192, 498, 1857, 497, 1595, 2360, 1517, 594, 83,
+	497, 2052, 2238, 2230, 1227, 2229, 1227, 2065, 983, 984,
+	982, 1552, 1551, 1548, 1636, 2169, 594, 983, 984, 982,
+	1783, 1567, 1566, 983, 984, 982, 985, 1637, 594, 1881,
+	1601, 2137, 594, 2052, 2057, 985, 1992, 624, 607, 594,
+	624, 985, 2037, 2036, 497, 2003, 1436, 2033, 2034, 2033,
+	2032, 1436, 1436, 1513, 594, 1867, 1573, 1569, 1574, 1575,
+	1576, 1577, 1633, 2003, 1634, 1513, 1608, 1605, 192, 2134,
+	1623, 1596, 1591, 1592, 1585, 1586, 1587, 1588, 192, 1606,
+	1612, 1613, 1614, 192, 1545, 1898, 191, 1546, 1629, 1525,
+	191, 191, 1628, 1648, 191, 191, 808, 191, 1650, 1651,
+	191, 1647, 191, 191, 513, 1596, 981, 809, 35, 1632,
+	1187, 1883, 191, 191, 191, 191, 35, 1224, 1876, 1877,
+	1646, 1525, 594, 981, 594, 191, 1546, 1187, 1186, 1132,
+	1131, 1783, 191, 1790, 150, 155, 152, 158, 159, 160,
+	161, 163, 164, 165, 166, 2052, 1816, 1638, 1524, 1547,
+	167, 168, 169, 170, 1545, 1514, 1791, 1549, 2156, 191,
+	2180, 2362, 191, 497, 2219, 191, 539, 538, 541, 542,
+	543, 544, 35, 2035, 1525, 540, 1656, 545, 1553, 1753,
+	1258, 1694, 1695, 71, 587, 1752, 1697, 1513, 1547, 594,
+	1671, 71, 71, 1698, 1637, 1620, 1545, 1503, 1481, 1525,
+	2003, 1393, 1637, 1331, 1687, 1118, 2157, 2158, 2159, 791,
+	1676, 790, 2282, 994, 993, 1003, 1004, 996, 997, 998,
+	999, 1000, 1001, 1002, 995, 1513, 1341, 1005, 1259, 1260,
+	1261, 2160, 2255, 2182, 1706, 994, 993, 1003, 1004, 996,
+	997, 998, 999, 1000, 1001, 1002, 995, 71, 2064, 1005,
+	2145, 1189, 1594, 2083, 1413, 1903, 1732, 1630, 1590, 71,
+	1584, 1583, 191, 1305, 1472, 1709, 1219, 1215, 1185, 97,
+	191, 1861, 1723, 1862, 1255, 177, 2161, 2162, 2007, 2008,
+	1906, 2373, 1414, 2319, 1718, 2287, 1530, 1533, 1534, 1535,
+	1531, 2251, 1532, 1536, 2187, 191, 2007, 2008, 1194, 1375,
+	2369, 2357, 1769, 2192, 2010, 1992, 191, 191, 191, 191,
+	191, 1731, 2254, 1797, 1776, 1872, 1862, 585, 191, 1256,
+	1257, 1871, 191, 1870, 1610, 191, 191, 1378, 1334, 191,
+	191, 191, 1792, 1747, 1809, 1785, 1534, 1535, 1807, 1788,
+	1727, 1728, 1828, 1808, 1071, 1759, 2013, 2012, 1767, 593,
+	1805, 192, 1814, 1804, 1803, 1806, 2347, 2322, 1984, 1775,
+	1847, 1745, 1772, 1087, 2138, 1817, 2055, 1784, 1781, 1819,
+	1780, 2304, 2301, 2349, 2326, 104, 498, 498, 498, 1844,
+	1845, 1799, 1800, 1798, 1802, 1786, 1801, 1831, 99, 1810,
+	1815, 191, 1341, 2328, 498, 498, 1770, 192, 1820, 1823,
+	2334, 2333, 497, 2275, 1771, 2273, 1330, 579, 497, 1866,
+	1832, 497, 1466, 1227, 1880, 1665, 600, 838, 497, 837,
+	1080, 2097, 947, 174, 1854, 1886, 187, 1467, 1863, 1861,
+	1895, 601, 1081, 1939, 1891, 1890, 1884, 114, 191, 184,
+	2217, 2029, 2122, 1601, 1530, 1533, 1534, 1535, 1531, 191,
+	1532, 1536, 191, 191, 1091, 1092, 603, 2028, 602, 1846,
+	497, 1849, 1850, 1851, 1893, 1631, 1233, 1232, 1220, 2132,
+	191, 1885, 1207, 1505, 1506, 192, 1498, 1449, 1615, 1337,
+	2286, 191, 2248, 1892, 2231, 1448, 600, 1714, 1864, 994,
+	993, 1003, 1004, 996, 997, 998, 999, 1000, 1001, 1002,
+	995, 601, 498, 1005, 2173, 192, 1907, 192, 192, 1538,
+	498, 497, 965, 1932, 588, 589, 498, 1436, 963, 1931,
+	1950, 591, 1779, 2354, 597, 598, 603, 83, 602, 1894,
+	1778, 2353, 2331, 2305, 2131, 2051, 1621, 592, 2130, 1952,
+	1934, 1987, 1783, 1935, 1937, 1708, 1943, 497, 1387, 2371,
+	2370, 587, 1742, 1739, 986, 1101, 1094, 1951, 191, 1965,
+	1949, 2371, 2276, 2026, 1964, 1499, 81, 86, 497, 70,
+	502, 1971, 1701, 1926, 497, 497, 1925, 1950, 1679, 1797,
+	1318, 2071, 1980, 1993, 1324, 1322, 2074, 78, 1, 469,
+	513, 1990, 1482, 1069, 480, 2355, 1292, 191, 1282, 1043,
+	2185, 2076, 2058, 1599, 799, 139, 1562, 1563, 2241, 94,
+	2001, 764, 93, 802, 2002, 908, 1622, 2087, 2253, 2170,
+	1842, 2011, 1571, 1138, 1136, 1137, 1135, 1140, 1139, 1996,
+	1082, 1085, 1134, 1382, 2015, 494, 2017, 2016, 2018, 548,
+	1537, 1127, 1095, 839, 459, 2038, 1373, 2046, 1654, 191,
+	465, 191, 191, 191, 2023, 1013, 1777, 497, 1824, 621,
+	192, 614, 1998, 2332, 2302, 2300, 2272, 2213, 2054, 2303,
+	191, 2270, 2348, 2325, 1570, 2042, 1497, 2041, 1083, 2129,
+	1986, 1746, 1042, 1468, 1110, 2059, 522, 2068, 191, 1492,
+	498, 1406, 2066, 537, 497, 191, 191, 2056, 497, 496,
+	497, 497, 534, 2078, 497, 497, 191, 498, 498, 2062,
+	498, 191, 498, 498, 2061, 498, 498, 498, 498, 498,
+	498, 535, 1508, 2098, 1789, 2030, 2031, 1601, 987, 514,
+	498, 2073, 622, 2053, 192, 768, 1102, 775, 1529, 1527,
+	1526, 1335, 1114, 2009, 2005, 1108, 1512, 2043, 2044, 1659,
+	1900, 966, 596, 509, 2101, 98, 1465, 2260, 1713, 2118,
+	595, 498, 872, 937, 61, 38, 501, 192, 2095, 2096,
+	2312, 2106, 950, 192, 604, 32, 31, 30, 29, 28,
+	23, 22, 192, 21, 20, 19, 192, 2128, 25, 18,
+	17, 16, 1797, 109, 2070, 48, 45, 43, 116, 115,
+	46, 42, 192, 2133, 883, 27, 26, 15, 10, 192,
+	9, 5, 2142, 4, 953, 24, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 498, 498, 498, 2141, 1031,
+	2, 192, 497, 497, 2149, 2148, 0, 2150, 0, 2152,
+	0, 2147, 0, 0, 0, 497, 0, 0, 0, 2163,
+	0, 0, 191, 0, 0, 0, 2175, 2164, 192, 0,
+	0, 0, 192, 497, 497, 0, 0, 0, 497, 0,
+	2174, 2103, 2104, 0, 2105, 0, 0, 2107, 0, 2109,
+	0, 0, 0, 0, 0, 2196, 0, 0, 2183, 0,
+	2190, 0, 0, 2193, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 497, 497, 497, 191, 2194, 2195,
+	0, 0, 0, 0, 0, 0, 0, 0, 497, 0,
+	497, 2202, 0, 498, 0, 2210, 497, 2216, 0, 2206,
+	2208, 2209, 2211, 2222, 1343, 2218, 0, 0, 0, 0,
+	0, 0, 0, 0, 2224, 0, 0, 2220, 191, 0,
+	2226, 2225, 0, 0, 0, 0, 498, 498, 0, 0,
+	191, 497, 497, 497, 0, 2240, 2234, 192, 191, 192,
+	2078, 2242, 1996, 0, 0, 0, 1996, 0, 0, 0,
+	0, 0, 498, 0, 2237, 0, 0, 0, 2245, 192,
+	0, 0, 498, 0, 0, 0, 192, 2121, 192, 0,
+	0, 0, 0, 0, 0, 0, 192, 192, 2269, 0,
+	0, 0, 0, 498, 2277, 0, 498, 0, 0, 0,
+	1402, 1403, 1404, 1405, 0, 0, 0, 498, 0, 0,
+	497, 2227, 2068, 2228, 2290, 2291, 497, 0, 0, 2078,
+	0, 0, 2280, 0, 994, 993, 1003, 1004, 996, 997,
+	998, 999, 1000, 1001, 1002, 995, 0, 1996, 1005, 497,
+	0, 2292, 0, 497, 2297, 1797, 0, 0, 2068, 2306,
+	0, 2317, 2308, 2315, 0, 1456, 1457, 0, 0, 0,
+	0, 0, 498, 0, 0, 2329, 192, 0, 2311, 498,
+	0, 2330, 0, 0, 0, 0, 0, 0, 0, 0,
+	2068, 497, 2340, 2344, 2342, 2345, 0, 0, 498, 0,
+	2078, 0, 513, 0, 498, 516, 0, 0, 0, 0,
+	0, 622, 622, 622, 993, 1003, 1004, 996, 997, 998,
+	999, 1000, 1001, 1002, 995, 0, 0, 1005, 2368, 949,
+	951, 0, 497, 497, 0, 0, 2374, 0, 0, 0,
+	0, 2078, 0, 2382, 2068, 0, 0, 2384, 498, 172,
+	2383, 0, 0, 0, 0, 1559, 0, 0, 2375, 0,
+	2390, 2391, 2116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 114, 0, 0, 0, 0, 0,
+	2115, 0, 0, 0, 0, 156, 0, 0, 0, 0,
+	192, 0, 0, 0, 192, 192, 0, 0, 192, 192,
+	0, 192, 0, 0, 192, 0, 192, 192, 0, 0,
+	0, 0, 0, 0, 1597, 0, 192, 192, 192, 192,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 192, 1098, 0, 153,
+	0, 154, 0, 0, 0, 622, 0, 0, 0, 0,
+	171, 1128, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 192, 498, 0, 192,
+	0, 994, 993, 1003, 1004, 996, 997, 998, 999, 1000,
+	1001, 1002, 995, 0, 0, 1005, 1451, 1452, 0, 994,
+	993, 1003, 1004, 996, 997, 998, 999, 1000, 1001, 1002,
+	995, 0, 190, 1005, 0, 0, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 989, 162, 992,
+	0, 0, 0, 0, 0, 1006, 1007, 1008, 1009, 1010,
+	1011, 1012, 1496, 990, 991, 988, 994, 993, 1003, 1004,
+	996, 997, 998, 999, 1000, 1001, 1002, 995, 0, 0,
+	1005, 0, 0, 0, 0, 1944, 0, 492, 2114, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 549, 0,
+	0, 0, 0, 0, 192, 994, 993, 1003, 1004, 996,
+	997, 998, 999, 1000, 1001, 1002, 995, 0, 0, 1005,
+	608, 608, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 192, 192, 192, 192, 513, 1707, 0, 0, 0,
+	0, 149, 192, 0, 0, 768, 192, 0, 0, 192,
+	192, 0, 0, 192, 192, 192, 0, 0, 1229, 0,
+	0, 0, 1235, 1235, 0, 1235, 0, 1235, 1235, 0,
+	1244, 1235, 1235, 1235, 1235, 1235, 0, 0, 0, 0,
+	0, 0, 0, 1229, 1229, 768, 0, 994, 993, 1003,
+	1004, 996, 997, 998, 999, 1000, 1001, 1002, 995, 0,
+	0, 1005, 0, 994, 993, 1003, 1004, 996, 997, 998,
+	999, 1000, 1001, 1002, 995, 192, 1304, 1005, 0, 1749,
+	0, 0, 0, 0, 0, 0, 498, 0, 0, 0,
+	0, 0, 498, 0, 0, 498, 0, 0, 0, 0,
+	0, 0, 498, 0, 0, 0, 0, 0, 0, 0,
+	1773, 1774, 1085, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 192, 192, 0, 0,
+	622, 622, 622, 0, 498, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
+	150, 155, 152, 158, 159, 160, 161, 163, 164, 165,
+	166, 0, 0, 0, 0, 0, 167, 168, 169, 170,
+	0, 0, 0, 0, 0, 498, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 498, 0, 0, 0, 0, 0, 0, 1442, 0,
+	622, 0, 192, 0, 0, 0, 0, 0, 0, 0,
+	2113, 0, 498, 0, 1229, 0, 0, 0, 498, 498,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1474, 1475, 0, 1725, 0, 0, 0, 1726, 0,
+	0, 192, 0, 0, 0, 0, 0, 0, 0, 1733,
+	1734, 0, 0, 0, 0, 1740, 0, 1509, 1743, 1744,
+	0, 0, 0, 0, 0, 0, 1750, 1098, 1751, 0,
+	622, 1754, 1755, 1756, 1757, 1758, 0, 1938, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1768, 622, 0,
+	0, 622, 0, 192, 0, 192, 192, 192, 0, 0,
+	0, 498, 768, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 0, 0, 1973, 0, 994,
+	993, 1003, 1004, 996, 997, 998, 999, 1000, 1001, 1002,
+	995, 0, 192, 1005, 1812, 1813, 0, 0, 498, 192,
+	192, 0, 498, 0, 498, 498, 0, 0, 498, 498,
+	192, 0, 1988, 0, 0, 192, 0, 775, 549, 0,
+	0, 0, 0, 0, 1611, 0, 0, 549, 549, 549,
+	549, 549, 549, 549, 549, 549, 549, 0, 0, 0,
+	0, 0, 0, 768, 1724, 0, 0, 0, 0, 775,
+	0, 0, 0, 0, 549, 0, 0, 0, 0, 0,
+	0, 0, 0, 549, 994, 993, 1003, 1004, 996, 997,
+	998, 999, 1000, 1001, 1002, 995, 0, 0, 1005, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 768, 549, 549, 0, 0, 0, 608,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 498, 498, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 498, 498, 0,
+	0, 0, 498, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1947, 1948, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2120, 0, 0, 0, 0, 498, 498,
+	498, 192, 1705, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 498, 0, 498, 0, 513, 0, 0, 0,
+	498, 0, 0, 2143, 0, 0, 2144, 0, 0, 2146,
+	0, 0, 0, 0, 551, 34, 0, 0, 1999, 0,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 498, 498, 498, 0, 2014,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 34,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1411, 0, 0, 1420, 1421, 1422, 1423,
+	1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433,
+	1434, 1230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 586, 0, 0, 0, 0,
+	0, 0, 0, 0, 498, 0, 1230, 1230, 0, 0,
+	498, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1473, 1229, 0, 0, 0, 0, 2215,
+	513, 0, 0, 498, 0, 0, 0, 498, 0, 0,
+	0, 0, 0, 0, 0, 1311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1340, 0, 0, 0, 549, 0,
+	0, 0, 0, 0, 2100, 498, 0, 0, 2102, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2111,
+	2112, 0, 0, 0, 1361, 1362, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2126, 0, 0, 0, 1377,
+	0, 0, 0, 0, 0, 0, 498, 498, 0, 0,
+	0, 1875, 2135, 2136, 0, 1229, 2140, 1882, 0, 0,
+	1875, 0, 0, 0, 0, 622, 0, 1887, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 549, 549, 549, 549, 0, 0,
+	549, 0, 0, 549, 549, 549, 549, 549, 549, 549,
+	549, 549, 549, 549, 549, 549, 549, 549, 0, 1922,
+	0, 0, 0, 2168, 0, 0, 0, 2318, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 608, 1340, 0, 0, 0, 608, 608, 549,
+	549, 608, 608, 608, 0, 2341, 0, 1230, 0, 0,
+	549, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	622, 0, 0, 0, 0, 0, 608, 608, 608, 608,
+	608, 0, 0, 0, 0, 1490, 549, 1494, 2207, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1235, 0, 0, 0,
+	0, 0, 0, 1340, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 622, 0, 0,
+	1229, 0, 0, 2000, 1235, 0, 0, 0, 0, 549,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2256, 2257, 2258, 2259, 0, 2263, 0, 2264,
+	2265, 2266, 0, 2267, 2268, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 549, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 768, 0, 0, 1229,
+	0, 0, 0, 0, 0, 2293, 0, 0, 0, 0,
+	0, 0, 0, 1719, 1720, 1721, 943, 943, 943, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 622, 0, 0, 34, 2081, 0, 2084,
+	2085, 0, 0, 2090, 2091, 0, 0, 0, 0, 0,
+	0, 0, 1014, 1016, 2336, 2337, 0, 0, 0, 0,
+	0, 0, 0, 2343, 0, 0, 0, 0, 0, 0,
+	0, 0, 1072, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1029, 0, 0, 2359, 1034, 1035, 1036,
+	1037, 1038, 1039, 1040, 1041, 0, 1044, 1047, 1047, 1047,
+	1053, 1047, 1047, 1053, 1047, 1061, 1062, 1063, 1064, 1065,
+	1066, 1067, 0, 0, 0, 1675, 0, 1073, 0, 0,
+	0, 34, 0, 1229, 189, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 500, 0, 0, 0, 0, 0,
+	0, 0, 582, 0, 0, 0, 0, 1111, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 772,
+	0, 1875, 2165, 0, 0, 0, 0, 1340, 0, 549,
+	549, 0, 0, 0, 1875, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2184, 2186, 0, 0, 0, 2191, 0, 0,
+	549, 549, 549, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 608, 608, 0,
+	0, 0, 0, 1875, 1875, 1875, 868, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 884, 2221, 608, 2223,
+	0, 890, 0, 549, 0, 1875, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1490, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 549, 549, 549, 0, 0, 0,
+	622, 622, 2246, 0, 0, 0, 608, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1230, 0, 1945,
+	1946, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1811, 0, 0, 0, 1966, 1967, 0, 1968, 1969, 0,
+	0, 0, 1821, 1340, 0, 0, 0, 0, 1975, 1976,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2289,
+	0, 0, 0, 0, 0, 1875, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1229, 0, 2307, 0,
+	0, 0, 1875, 0, 0, 0, 0, 0, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1340, 0,
+	0, 2025, 0, 114, 0, 136, 0, 0, 0, 0,
+	622, 0, 0, 0, 156, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 943, 943, 943, 0, 0,
+	0, 0, 0, 0, 0, 146, 0, 0, 0, 0,
+	135, 622, 1875, 0, 0, 0, 1386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 153, 0,
+	154, 549, 0, 0, 0, 123, 124, 145, 144, 171,
+	0, 0, 0, 0, 0, 0, 549, 549, 0, 0,
+	0, 0, 608, 0, 0, 0, 0, 0, 0, 892,
+	0, 549, 549, 0, 549, 549, 0, 0, 0, 0,
+	2099, 549, 0, 0, 0, 549, 549, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 121, 147,
+	128, 120, 0, 141, 142, 0, 0, 157, 0, 0,
+	0, 0, 0, 0, 0, 962, 549, 162, 129, 0,
+	0, 0, 0, 1230, 0, 0, 0, 0, 0, 0,
+	0, 0, 132, 130, 125, 126, 127, 131, 0, 0,
+	0, 0, 122, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 549, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1541, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1230, 0, 0, 0, 0, 0, 0, 0,
+	149, 0, 0, 1104, 0, 0, 1115, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 140, 0, 1144, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 134, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1239, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2080, 2197,
+	2198, 2199, 2200, 2201, 0, 0, 0, 2204, 2205, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 0, 549, 0, 0,
+	0, 0, 0, 0, 0, 137, 0, 0, 138, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 549, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1287, 0, 0, 0, 0, 0, 0, 0, 1297,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1311,
-	0, 0, 0, 0, 1202, 0, 1315, 0, 0, 0,
-	0, 0, 0, 0, 0, 1324, 1325, 1326, 1327, 1328,
-	1329, 1330, 0, 0, 0, 0, 0, 147, 152, 149,
-	155, 156, 157, 158, 160, 161, 162, 163, 0, 0,
-	0, 0, 0, 164, 165, 166, 167, 1088, 0, 0,
-	0, 34, 35, 36, 70, 38, 39, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 169, 0,
-	0, 74, 0, 0, 0, 0, 40, 66, 67, 1179,
-	64, 68, 0, 0, 0, 0, 0, 65, 0, 0,
-	0, 0, 0, 111, 0, 133, 0, 0, 0, 0,
-	0, 1803, 0, 0, 153, 1202, 0, 1810, 0, 0,
-	1803, 0, 0, 0, 0, 614, 53, 1815, 0, 0,
-	0, 0, 0, 0, 0, 0, 69, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 0, 0, 0, 0,
-	132, 925, 925, 925, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 150, 0,
-	151, 0, 0, 0, 0, 1183, 1184, 142, 141, 168,
-	0, 0, 1465, 0, 0, 0, 0, 0, 0, 1469,
-	0, 1472, 0, 0, 0, 0, 0, 0, 0, 614,
-	1491, 0, 0, 0, 0, 0, 0, 0, 43, 46,
-	49, 48, 51, 0, 63, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 137, 1185, 144,
-	0, 1182, 0, 138, 139, 1208, 0, 154, 0, 52,
-	73, 72, 0, 0, 61, 62, 50, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 0, 0, 1202,
-	0, 0, 1906, 1208, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1558,
-	0, 54, 55, 0, 56, 57, 58, 59, 0, 0,
+	549, 0, 0, 0, 0, 0, 1230, 549, 0, 0,
+	549, 0, 0, 549, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1490, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1133, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	155, 152, 158, 159, 160, 161, 163, 164, 165, 166,
+	0, 0, 0, 0, 172, 167, 168, 169, 170, 0,
+	0, 0, 0, 0, 0, 1206, 0, 2309, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 114,
+	0, 136, 0, 0, 0, 0, 0, 0, 0, 0,
+	156, 0, 0, 0, 0, 0, 549, 549, 549, 549,
+	549, 0, 1266, 0, 549, 549, 0, 0, 0, 1490,
+	0, 0, 0, 549, 549, 0, 0, 0, 0, 0,
+	0, 146, 0, 0, 0, 0, 135, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1326, 0, 0, 153, 0, 154, 0, 0, 0,
+	1336, 1210, 1211, 145, 144, 171, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 760, 0, 0, 1202, 146,
+	1350, 0, 1729, 0, 0, 586, 0, 1354, 0, 0,
+	0, 0, 0, 0, 0, 0, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 1212, 147, 0, 1209, 0, 141,
+	142, 0, 1766, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 0, 0, 1388, 0, 1155, 0,
+	1115, 0, 0, 0, 0, 0, 0, 0, 0, 1111,
+	0, 0, 0, 0, 0, 0, 1793, 1794, 0, 0,
+	1111, 1111, 1111, 1111, 1111, 0, 0, 0, 0, 1230,
+	0, 0, 0, 0, 549, 0, 1541, 0, 0, 1111,
+	0, 549, 0, 1111, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1976, 1977, 1978, 0,
-	0, 0, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1088, 0, 0, 0, 1612, 1613, 1614,
-	1615, 1616, 140, 0, 0, 0, 0, 1620, 1621, 1088,
-	1623, 0, 0, 0, 134, 0, 0, 135, 0, 0,
-	1628, 0, 0, 0, 0, 0, 0, 1631, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1202, 1635, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1143, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1516, 0, 0,
+	0, 0, 0, 1888, 1520, 0, 1523, 0, 0, 0,
+	0, 0, 0, 0, 0, 1542, 0, 0, 0, 143,
+	0, 0, 0, 0, 1156, 0, 0, 0, 0, 0,
+	0, 137, 0, 0, 138, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1803, 2050,
+	0, 1386, 1169, 1172, 1173, 1174, 1175, 1176, 1177, 0,
+	1178, 1179, 1180, 1181, 1182, 1157, 1158, 1159, 1160, 1141,
+	1142, 1170, 0, 1144, 1609, 1145, 1146, 1147, 1148, 1149,
+	1150, 1151, 1152, 1153, 1154, 1161, 1162, 1163, 1164, 1165,
+	1166, 1167, 1168, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1803, 0, 0, 614, 0, 0, 0, 147, 152,
-	149, 155, 156, 157, 158, 160, 161, 162, 163, 0,
-	0, 0, 0, 0, 164, 165, 166, 167, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1803,
-	1803, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2092, 0, 2094, 0, 0, 0, 0,
-	0, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 155, 152, 158, 159,
+	160, 161, 163, 164, 165, 166, 0, 0, 0, 0,
+	0, 167, 168, 169, 170, 0, 1997, 0, 34, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1171, 0, 0,
+	0, 1111, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1803, 0, 0, 0, 0, 1746,
+	0, 0, 0, 0, 0, 0, 0, 0, 1115, 0,
+	0, 0, 1663, 1664, 0, 0, 1666, 1667, 0, 1670,
+	0, 0, 1673, 0, 1674, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1685, 1686, 1115, 1688, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1693, 0, 0,
+	0, 0, 0, 0, 1696, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1657, 0, 0, 578, 0, 0, 0, 0, 0,
+	0, 1703, 0, 0, 1704, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 614, 614, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1694, 0, 0, 1797, 1202, 0, 2163, 0, 0,
-	0, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1084, 0, 0,
-	0, 0, 0, 0, 1721, 1722, 0, 0, 1084, 1084,
-	1084, 1084, 1084, 0, 0, 0, 0, 0, 0, 0,
-	0, 1827, 0, 0, 1490, 0, 0, 1084, 0, 0,
-	0, 1084, 0, 0, 0, 0, 0, 0, 0, 1842,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2117, 0, 0, 0, 0,
+	0, 0, 2123, 2124, 2125, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1891, 1816, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1818, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1997,
+	0, 34, 0, 1997, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1869, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1953, 0, 1954, 1955, 1956, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 34, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1966, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1903, 1979, 33, 0, 0, 0, 0,
+	1899, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1909, 0, 0, 1910, 1911, 0, 0, 0, 0,
+	0, 0, 0, 0, 1997, 0, 0, 0, 0, 0,
+	0, 0, 1933, 0, 0, 0, 34, 2281, 0, 0,
+	0, 0, 0, 1936, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2288, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1084, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2316, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1985, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1691,14 +1783,16 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2047, 0, 2048, 2049, 2050, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2002, 0, 0, 0, 0, 0, 0, 2008, 2009, 2010,
+	0, 0, 2060, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2069, 0, 0, 0, 0, 0, 0, 2079, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2092, 0,
+	0, 0, 0, 2094, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2113,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1706,2299 +1800,2401 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1903, 0, 33, 0, 1903, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 33, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2177, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1903, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 738, 725, 33, 2139, 674, 741,
-	645, 663, 750, 665, 668, 708, 625, 687, 331, 660,
-	0, 649, 621, 656, 622, 647, 676, 241, 680, 644,
-	727, 690, 740, 289, 0, 627, 650, 345, 710, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 747, 293, 697, 0, 391, 316, 0,
-	0, 0, 678, 730, 685, 721, 673, 709, 634, 696,
-	742, 661, 705, 743, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 2146, 2147, 0,
-	0, 0, 0, 0, 217, 0, 223, 702, 737, 658,
-	704, 237, 277, 243, 236, 407, 707, 753, 620, 699,
-	0, 623, 626, 749, 733, 653, 654, 0, 0, 0,
-	0, 0, 0, 0, 677, 686, 718, 671, 0, 0,
-	0, 0, 0, 0, 0, 0, 651, 0, 695, 0,
-	0, 0, 630, 624, 0, 0, 0, 0, 675, 0,
-	0, 0, 633, 0, 652, 719, 0, 618, 263, 628,
-	317, 723, 732, 672, 438, 736, 670, 669, 739, 714,
-	631, 729, 664, 288, 629, 285, 191, 205, 0, 662,
-	327, 366, 372, 728, 648, 657, 228, 655, 370, 341,
-	424, 213, 253, 363, 346, 368, 694, 712, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 643, 724, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	716, 752, 340, 371, 219, 426, 390, 638, 642, 636,
-	637, 688, 689, 639, 744, 745, 746, 720, 632, 0,
-	640, 641, 0, 726, 734, 735, 693, 190, 203, 291,
-	748, 360, 256, 449, 433, 429, 619, 635, 234, 646,
-	0, 0, 659, 666, 667, 679, 681, 682, 683, 684,
-	692, 700, 701, 703, 711, 713, 715, 717, 722, 731,
-	751, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 691, 698, 301, 250, 267, 276, 706, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 738, 725, 0,
-	0, 674, 741, 645, 663, 750, 665, 668, 708, 625,
-	687, 331, 660, 0, 649, 621, 656, 622, 647, 676,
-	241, 680, 644, 727, 690, 740, 289, 0, 627, 650,
-	345, 710, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 747, 293, 697, 0,
-	391, 316, 0, 0, 0, 678, 730, 685, 721, 673,
-	709, 634, 696, 742, 661, 705, 743, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	702, 737, 658, 704, 237, 277, 243, 236, 407, 707,
-	753, 620, 699, 0, 623, 626, 749, 733, 653, 654,
-	0, 0, 0, 0, 0, 0, 0, 677, 686, 718,
-	671, 0, 0, 0, 0, 0, 0, 1895, 0, 651,
-	0, 695, 0, 0, 0, 630, 624, 0, 0, 0,
-	0, 675, 0, 0, 0, 633, 0, 652, 719, 0,
-	618, 263, 628, 317, 723, 732, 672, 438, 736, 670,
-	669, 739, 714, 631, 729, 664, 288, 629, 285, 191,
-	205, 0, 662, 327, 366, 372, 728, 648, 657, 228,
-	655, 370, 341, 424, 213, 253, 363, 346, 368, 694,
-	712, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 643, 724, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 716, 752, 340, 371, 219, 426, 390,
-	638, 642, 636, 637, 688, 689, 639, 744, 745, 746,
-	720, 632, 0, 640, 641, 0, 726, 734, 735, 693,
-	190, 203, 291, 748, 360, 256, 449, 433, 429, 619,
-	635, 234, 646, 0, 0, 659, 666, 667, 679, 681,
-	682, 683, 684, 692, 700, 701, 703, 711, 713, 715,
-	717, 722, 731, 751, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 691, 698, 301, 250, 267,
-	276, 706, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	738, 725, 0, 0, 674, 741, 645, 663, 750, 665,
-	668, 708, 625, 687, 331, 660, 0, 649, 621, 656,
-	622, 647, 676, 241, 680, 644, 727, 690, 740, 289,
-	0, 627, 650, 345, 710, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 747,
-	293, 697, 0, 391, 316, 0, 0, 0, 678, 730,
-	685, 721, 673, 709, 634, 696, 742, 661, 705, 743,
-	279, 225, 195, 328, 392, 255, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 223, 702, 737, 658, 704, 237, 277, 243,
-	236, 407, 707, 753, 620, 699, 0, 623, 626, 749,
-	733, 653, 654, 0, 0, 0, 0, 0, 0, 0,
-	677, 686, 718, 671, 0, 0, 0, 0, 0, 0,
-	1750, 0, 651, 0, 695, 0, 0, 0, 630, 624,
-	0, 0, 0, 0, 675, 0, 0, 0, 633, 0,
-	652, 719, 0, 618, 263, 628, 317, 723, 732, 672,
-	438, 736, 670, 669, 739, 714, 631, 729, 664, 288,
-	629, 285, 191, 205, 0, 662, 327, 366, 372, 728,
-	648, 657, 228, 655, 370, 341, 424, 213, 253, 363,
-	346, 368, 694, 712, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 643, 724, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 716, 752, 340, 371,
-	219, 426, 390, 638, 642, 636, 637, 688, 689, 639,
-	744, 745, 746, 720, 632, 0, 640, 641, 0, 726,
-	734, 735, 693, 190, 203, 291, 748, 360, 256, 449,
-	433, 429, 619, 635, 234, 646, 0, 0, 659, 666,
-	667, 679, 681, 682, 683, 684, 692, 700, 701, 703,
-	711, 713, 715, 717, 722, 731, 751, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 691, 698,
-	301, 250, 267, 276, 706, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 738, 725, 0, 0, 674, 741, 645,
-	663, 750, 665, 668, 708, 625, 687, 331, 660, 0,
-	649, 621, 656, 622, 647, 676, 241, 680, 644, 727,
-	690, 740, 289, 0, 627, 650, 345, 710, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 747, 293, 697, 0, 391, 316, 0, 0,
-	0, 678, 730, 685, 721, 673, 709, 634, 696, 742,
-	661, 705, 743, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 702, 737, 658, 704,
-	237, 277, 243, 236, 407, 707, 753, 620, 699, 0,
-	623, 626, 749, 733, 653, 654, 0, 0, 0, 0,
-	0, 0, 0, 677, 686, 718, 671, 0, 0, 0,
-	0, 0, 0, 1467, 0, 651, 0, 695, 0, 0,
-	0, 630, 624, 0, 0, 0, 0, 675, 0, 0,
-	0, 633, 0, 652, 719, 0, 618, 263, 628, 317,
-	723, 732, 672, 438, 736, 670, 669, 739, 714, 631,
-	729, 664, 288, 629, 285, 191, 205, 0, 662, 327,
-	366, 372, 728, 648, 657, 228, 655, 370, 341, 424,
-	213, 253, 363, 346, 368, 694, 712, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	643, 724, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 716,
-	752, 340, 371, 219, 426, 390, 638, 642, 636, 637,
-	688, 689, 639, 744, 745, 746, 720, 632, 0, 640,
-	641, 0, 726, 734, 735, 693, 190, 203, 291, 748,
-	360, 256, 449, 433, 429, 619, 635, 234, 646, 0,
-	0, 659, 666, 667, 679, 681, 682, 683, 684, 692,
-	700, 701, 703, 711, 713, 715, 717, 722, 731, 751,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 691, 698, 301, 250, 267, 276, 706, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 738, 725, 0, 0,
-	674, 741, 645, 663, 750, 665, 668, 708, 625, 687,
-	331, 660, 0, 649, 621, 656, 622, 647, 676, 241,
-	680, 644, 727, 690, 740, 289, 0, 627, 650, 345,
-	710, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 747, 293, 697, 0, 391,
-	316, 0, 0, 0, 678, 730, 685, 721, 673, 709,
-	634, 696, 742, 661, 705, 743, 279, 225, 195, 328,
-	392, 255, 69, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 702,
-	737, 658, 704, 237, 277, 243, 236, 407, 707, 753,
-	620, 699, 0, 623, 626, 749, 733, 653, 654, 0,
-	0, 0, 0, 0, 0, 0, 677, 686, 718, 671,
-	0, 0, 0, 0, 0, 0, 0, 0, 651, 0,
-	695, 0, 0, 0, 630, 624, 0, 0, 0, 0,
-	675, 0, 0, 0, 633, 0, 652, 719, 0, 618,
-	263, 628, 317, 723, 732, 672, 438, 736, 670, 669,
-	739, 714, 631, 729, 664, 288, 629, 285, 191, 205,
-	0, 662, 327, 366, 372, 728, 648, 657, 228, 655,
-	370, 341, 424, 213, 253, 363, 346, 368, 694, 712,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 643, 724, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 716, 752, 340, 371, 219, 426, 390, 638,
-	642, 636, 637, 688, 689, 639, 744, 745, 746, 720,
-	632, 0, 640, 641, 0, 726, 734, 735, 693, 190,
-	203, 291, 748, 360, 256, 449, 433, 429, 619, 635,
-	234, 646, 0, 0, 659, 666, 667, 679, 681, 682,
-	683, 684, 692, 700, 701, 703, 711, 713, 715, 717,
-	722, 731, 751, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 691, 698, 301, 250, 267, 276,
-	706, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 738,
-	725, 0, 0, 674, 741, 645, 663, 750, 665, 668,
-	708, 625, 687, 331, 660, 0, 649, 621, 656, 622,
-	647, 676, 241, 680, 644, 727, 690, 740, 289, 0,
-	627, 650, 345, 710, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 747, 293,
-	697, 0, 391, 316, 0, 0, 0, 678, 730, 685,
-	721, 673, 709, 634, 696, 742, 661, 705, 743, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 702, 737, 658, 704, 237, 277, 243, 236,
-	407, 707, 753, 620, 699, 0, 623, 626, 749, 733,
-	653, 654, 0, 0, 0, 0, 0, 0, 0, 677,
-	686, 718, 671, 0, 0, 0, 0, 0, 0, 0,
-	0, 651, 0, 695, 0, 0, 0, 630, 624, 0,
-	0, 0, 0, 675, 0, 0, 0, 633, 0, 652,
-	719, 0, 618, 263, 628, 317, 723, 732, 672, 438,
-	736, 670, 669, 739, 714, 631, 729, 664, 288, 629,
-	285, 191, 205, 0, 662, 327, 366, 372, 728, 648,
-	657, 228, 655, 370, 341, 424, 213, 253, 363, 346,
-	368, 694, 712, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 643, 724, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 716, 752, 340, 371, 219,
-	426, 390, 638, 642, 636, 637, 688, 689, 639, 744,
-	745, 746, 720, 632, 0, 640, 641, 0, 726, 734,
-	735, 693, 190, 203, 291, 748, 360, 256, 449, 433,
-	429, 619, 635, 234, 646, 0, 0, 659, 666, 667,
-	679, 681, 682, 683, 684, 692, 700, 701, 703, 711,
-	713, 715, 717, 722, 731, 751, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 691, 698, 301,
-	250, 267, 276, 706, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 738, 725, 0, 0, 674, 741, 645, 663,
-	750, 665, 668, 708, 625, 687, 331, 660, 0, 649,
-	621, 656, 622, 647, 676, 241, 680, 644, 727, 690,
-	740, 289, 0, 627, 650, 345, 710, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 747, 293, 697, 0, 391, 316, 0, 0, 0,
-	678, 730, 685, 721, 673, 709, 634, 696, 742, 661,
-	705, 743, 279, 225, 195, 328, 392, 255, 0, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 702, 737, 658, 704, 237,
-	277, 243, 236, 407, 707, 753, 620, 699, 0, 623,
-	626, 749, 733, 653, 654, 0, 0, 0, 0, 0,
-	0, 0, 677, 686, 718, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 651, 0, 695, 0, 0, 0,
-	630, 624, 0, 0, 0, 0, 675, 0, 0, 0,
-	633, 0, 652, 719, 0, 618, 263, 628, 317, 723,
-	732, 672, 438, 736, 670, 669, 739, 714, 631, 729,
-	664, 288, 629, 285, 191, 205, 0, 662, 327, 366,
-	372, 728, 648, 657, 228, 655, 370, 341, 424, 213,
-	253, 363, 346, 368, 694, 712, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 755, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 643,
-	724, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 617, 754, 611, 610, 286, 295, 716, 752,
-	340, 371, 219, 426, 390, 638, 642, 636, 637, 688,
-	689, 639, 744, 745, 746, 720, 632, 0, 640, 641,
-	0, 726, 734, 735, 693, 190, 203, 291, 748, 360,
-	256, 449, 433, 429, 619, 635, 234, 646, 0, 0,
-	659, 666, 667, 679, 681, 682, 683, 684, 692, 700,
-	701, 703, 711, 713, 715, 717, 722, 731, 751, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	691, 698, 301, 250, 267, 276, 706, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 738, 725, 0, 0, 674,
-	741, 645, 663, 750, 665, 668, 708, 625, 687, 331,
-	660, 0, 649, 621, 656, 622, 647, 676, 241, 680,
-	644, 727, 690, 740, 289, 0, 627, 650, 345, 710,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 747, 293, 697, 0, 391, 316,
-	0, 0, 0, 678, 730, 685, 721, 673, 709, 634,
-	696, 742, 661, 705, 743, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 702, 737,
-	658, 704, 237, 277, 243, 236, 407, 707, 753, 620,
-	699, 0, 623, 626, 749, 733, 653, 654, 0, 0,
-	0, 0, 0, 0, 0, 677, 686, 718, 671, 0,
-	0, 0, 0, 0, 0, 0, 0, 651, 0, 695,
-	0, 0, 0, 630, 624, 0, 0, 0, 0, 675,
-	0, 0, 0, 633, 0, 652, 719, 0, 618, 263,
-	628, 317, 723, 732, 672, 438, 736, 670, 669, 739,
-	714, 631, 729, 664, 288, 629, 285, 191, 205, 0,
-	662, 327, 366, 372, 728, 648, 657, 228, 655, 370,
-	341, 424, 213, 253, 363, 346, 368, 694, 712, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 1092, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 755, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 643, 724, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 617, 754, 611, 610, 286,
-	295, 716, 752, 340, 371, 219, 426, 390, 638, 642,
-	636, 637, 688, 689, 639, 744, 745, 746, 720, 632,
-	0, 640, 641, 0, 726, 734, 735, 693, 190, 203,
-	291, 748, 360, 256, 449, 433, 429, 619, 635, 234,
-	646, 0, 0, 659, 666, 667, 679, 681, 682, 683,
-	684, 692, 700, 701, 703, 711, 713, 715, 717, 722,
-	731, 751, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 691, 698, 301, 250, 267, 276, 706,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 738, 725,
-	0, 0, 674, 741, 645, 663, 750, 665, 668, 708,
-	625, 687, 331, 660, 0, 649, 621, 656, 622, 647,
-	676, 241, 680, 644, 727, 690, 740, 289, 0, 627,
-	650, 345, 710, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 747, 293, 697,
-	0, 391, 316, 0, 0, 0, 678, 730, 685, 721,
-	673, 709, 634, 696, 742, 661, 705, 743, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 702, 737, 658, 704, 237, 277, 243, 236, 407,
-	707, 753, 620, 699, 0, 623, 626, 749, 733, 653,
-	654, 0, 0, 0, 0, 0, 0, 0, 677, 686,
-	718, 671, 0, 0, 0, 0, 0, 0, 0, 0,
-	651, 0, 695, 0, 0, 0, 630, 624, 0, 0,
-	0, 0, 675, 0, 0, 0, 633, 0, 652, 719,
-	0, 618, 263, 628, 317, 723, 732, 672, 438, 736,
-	670, 669, 739, 714, 631, 729, 664, 288, 629, 285,
-	191, 205, 0, 662, 327, 366, 372, 728, 648, 657,
-	228, 655, 370, 341, 424, 213, 253, 363, 346, 368,
-	694, 712, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 608, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 755, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 643, 724, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 617, 754,
-	611, 610, 286, 295, 716, 752, 340, 371, 219, 426,
-	390, 638, 642, 636, 637, 688, 689, 639, 744, 745,
-	746, 720, 632, 0, 640, 641, 0, 726, 734, 735,
-	693, 190, 203, 291, 748, 360, 256, 449, 433, 429,
-	619, 635, 234, 646, 0, 0, 659, 666, 667, 679,
-	681, 682, 683, 684, 692, 700, 701, 703, 711, 713,
-	715, 717, 722, 731, 751, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 691, 698, 301, 250,
-	267, 276, 706, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 1394, 0, 511, 0, 0, 0,
-	241, 0, 510, 0, 0, 0, 289, 0, 0, 1395,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 554, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 545, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 69, 0, 0, 177, 178, 179, 532,
-	531, 534, 535, 536, 537, 0, 0, 217, 533, 223,
-	538, 539, 540, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 508, 525, 0, 553, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 522, 523, 598, 0, 0,
-	0, 568, 0, 524, 0, 0, 517, 518, 520, 519,
-	521, 526, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 567, 0, 0, 438, 0, 0,
-	565, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	555, 566, 561, 562, 559, 560, 0, 558, 557, 556,
-	569, 547, 548, 549, 550, 552, 0, 563, 564, 551,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 511, 0, 0, 0, 241,
-	0, 510, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 554, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 545, 546, 0, 0,
-	0, 0, 0, 0, 1506, 0, 279, 225, 195, 328,
-	392, 255, 69, 0, 0, 177, 178, 179, 532, 531,
-	534, 535, 536, 537, 0, 0, 217, 533, 223, 538,
-	539, 540, 1507, 237, 277, 243, 236, 407, 0, 0,
-	0, 508, 525, 0, 553, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 522, 523, 0, 0, 0, 0,
-	568, 0, 524, 0, 0, 517, 518, 520, 519, 521,
-	526, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 567, 0, 0, 438, 0, 0, 565,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 555,
-	566, 561, 562, 559, 560, 0, 558, 557, 556, 569,
-	547, 548, 549, 550, 552, 0, 563, 564, 551, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 511, 0, 0, 0, 241, 0,
-	510, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 554, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 545, 546, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 69, 0, 586, 177, 178, 179, 532, 531, 534,
-	535, 536, 537, 0, 0, 217, 533, 223, 538, 539,
-	540, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	508, 525, 0, 553, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 522, 523, 0, 0, 0, 0, 568,
-	0, 524, 0, 0, 517, 518, 520, 519, 521, 526,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 567, 0, 0, 438, 0, 0, 565, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 555, 566,
-	561, 562, 559, 560, 0, 558, 557, 556, 569, 547,
-	548, 549, 550, 552, 0, 563, 564, 551, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 511, 0, 0, 0, 241, 0, 510,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 554, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 545, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 532, 531, 534, 535,
-	536, 537, 0, 0, 217, 533, 223, 538, 539, 540,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 508,
-	525, 0, 553, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 523, 598, 0, 0, 0, 568, 0,
-	524, 0, 0, 517, 518, 520, 519, 521, 526, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 567, 0, 0, 438, 0, 0, 565, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 555, 566, 561,
-	562, 559, 560, 0, 558, 557, 556, 569, 547, 548,
-	549, 550, 552, 0, 563, 564, 551, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 511, 0, 0, 0, 241, 0, 510, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 554, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 545, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 69,
-	0, 0, 177, 178, 179, 532, 1412, 534, 535, 536,
-	537, 0, 0, 217, 533, 223, 538, 539, 540, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 508, 525,
-	0, 553, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 522, 523, 598, 0, 0, 0, 568, 0, 524,
-	0, 0, 517, 518, 520, 519, 521, 526, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	567, 0, 0, 438, 0, 0, 565, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 555, 566, 561, 562,
-	559, 560, 0, 558, 557, 556, 569, 547, 548, 549,
-	550, 552, 0, 563, 564, 551, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 511, 0, 0, 0, 241, 0, 510, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 554, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 545, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 69, 0,
-	0, 177, 178, 179, 532, 1409, 534, 535, 536, 537,
-	0, 0, 217, 533, 223, 538, 539, 540, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 508, 525, 0,
-	553, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	522, 523, 598, 0, 0, 0, 568, 0, 524, 0,
-	0, 517, 518, 520, 519, 521, 526, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 567,
-	0, 0, 438, 0, 0, 565, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 555, 566, 561, 562, 559,
-	560, 0, 558, 557, 556, 569, 547, 548, 549, 550,
-	552, 0, 563, 564, 551, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 579, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 0,
-	0, 0, 0, 511, 0, 0, 0, 241, 0, 510,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 554, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 545, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 532, 531, 534, 535,
-	536, 537, 0, 0, 217, 533, 223, 538, 539, 540,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 508,
-	525, 0, 553, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 523, 0, 0, 0, 0, 568, 0,
-	524, 0, 0, 517, 518, 520, 519, 521, 526, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 567, 0, 0, 438, 0, 0, 565, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 555, 566, 561,
-	562, 559, 560, 0, 558, 557, 556, 569, 547, 548,
-	549, 550, 552, 0, 563, 564, 551, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 511, 0, 0, 0, 241, 0, 510, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 554, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 545, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 69,
-	0, 0, 177, 178, 179, 532, 531, 534, 535, 536,
-	537, 0, 0, 217, 533, 223, 538, 539, 540, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 508, 525,
-	0, 553, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 522, 523, 0, 0, 0, 0, 568, 0, 524,
-	0, 0, 517, 518, 520, 519, 521, 526, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	567, 0, 0, 438, 0, 0, 565, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 555, 566, 561, 562,
-	559, 560, 0, 558, 557, 556, 569, 547, 548, 549,
-	550, 552, 0, 563, 564, 551, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 554, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 545, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 69, 0,
-	0, 177, 178, 179, 532, 531, 534, 535, 536, 537,
-	0, 0, 217, 533, 223, 538, 539, 540, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 525, 0,
-	553, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	522, 523, 0, 0, 0, 0, 568, 0, 524, 0,
-	0, 517, 518, 520, 519, 521, 526, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 567,
-	0, 0, 438, 0, 0, 565, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 2166, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 555, 566, 561, 562, 559,
-	560, 0, 558, 557, 556, 569, 547, 548, 549, 550,
-	552, 0, 563, 564, 551, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	289, 0, 0, 0, 345, 0, 382, 227, 298, 296,
-	410, 251, 244, 240, 226, 273, 304, 343, 400, 337,
-	554, 293, 0, 0, 391, 316, 0, 0, 0, 0,
-	0, 545, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 225, 195, 328, 392, 255, 69, 0, 586,
-	177, 178, 179, 532, 531, 534, 535, 536, 537, 0,
-	0, 217, 533, 223, 538, 539, 540, 0, 237, 277,
-	243, 236, 407, 0, 0, 0, 0, 525, 0, 553,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 522,
-	523, 0, 0, 0, 0, 568, 0, 524, 0, 0,
-	517, 518, 520, 519, 521, 526, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 317, 567, 0,
-	0, 438, 0, 0, 565, 0, 0, 0, 0, 0,
-	288, 0, 285, 191, 205, 0, 0, 327, 366, 372,
-	0, 0, 0, 228, 0, 370, 341, 424, 213, 253,
-	363, 346, 368, 0, 0, 369, 294, 412, 358, 422,
-	439, 440, 235, 321, 430, 404, 436, 448, 206, 232,
-	335, 397, 427, 388, 314, 408, 409, 284, 387, 261,
-	194, 292, 198, 399, 420, 218, 380, 0, 0, 0,
-	200, 418, 396, 311, 281, 282, 199, 0, 362, 239,
-	259, 230, 330, 415, 416, 229, 450, 208, 435, 202,
-	209, 434, 323, 411, 419, 312, 303, 201, 417, 310,
-	302, 287, 249, 269, 356, 297, 357, 270, 319, 318,
-	320, 0, 196, 0, 393, 428, 451, 215, 0, 0,
-	406, 444, 447, 0, 359, 216, 260, 248, 355, 258,
-	290, 443, 445, 446, 214, 353, 266, 334, 423, 252,
-	431, 322, 210, 272, 389, 286, 295, 0, 0, 340,
-	371, 219, 426, 390, 555, 566, 561, 562, 559, 560,
-	0, 558, 557, 556, 569, 547, 548, 549, 550, 552,
-	0, 563, 564, 551, 190, 203, 291, 0, 360, 256,
-	449, 433, 429, 0, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 193,
-	204, 212, 221, 233, 246, 254, 264, 268, 271, 274,
-	275, 278, 283, 300, 305, 306, 307, 308, 324, 325,
-	326, 329, 332, 333, 336, 338, 339, 342, 348, 349,
-	350, 351, 352, 354, 361, 365, 373, 374, 375, 376,
-	377, 378, 379, 383, 384, 385, 386, 394, 398, 413,
-	414, 425, 437, 441, 265, 421, 442, 0, 299, 0,
-	0, 301, 250, 267, 276, 0, 432, 395, 207, 367,
-	257, 197, 224, 211, 231, 245, 247, 280, 309, 315,
-	344, 347, 262, 242, 222, 364, 220, 381, 401, 402,
-	403, 405, 313, 238, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 289,
-	0, 0, 0, 345, 0, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 554,
-	293, 0, 0, 391, 316, 0, 0, 0, 0, 0,
-	545, 546, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 225, 195, 328, 392, 255, 69, 0, 0, 177,
-	178, 179, 532, 531, 534, 535, 536, 537, 0, 0,
-	217, 533, 223, 538, 539, 540, 0, 237, 277, 243,
-	236, 407, 0, 0, 0, 0, 525, 0, 553, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 522, 523,
-	0, 0, 0, 0, 568, 0, 524, 0, 0, 517,
-	518, 520, 519, 521, 526, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 317, 567, 0, 0,
-	438, 0, 0, 565, 0, 0, 0, 0, 0, 288,
-	0, 285, 191, 205, 0, 0, 327, 366, 372, 0,
-	0, 0, 228, 0, 370, 341, 424, 213, 253, 363,
-	346, 368, 0, 0, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 0, 0, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 0, 0, 340, 371,
-	219, 426, 390, 555, 566, 561, 562, 559, 560, 0,
-	558, 557, 556, 569, 547, 548, 549, 550, 552, 0,
-	563, 564, 551, 190, 203, 291, 0, 360, 256, 449,
-	433, 429, 0, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 0, 0,
-	301, 250, 267, 276, 0, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 799, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 798, 438, 0,
-	0, 0, 0, 0, 0, 795, 796, 288, 763, 285,
-	191, 205, 789, 793, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 1070, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	1072, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 958,
-	959, 957, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 960, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 69, 0, 586, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 0, 0, 1439, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 1441, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 1437, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 757, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 763, 285, 191,
-	205, 761, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 1439, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 1441,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 34,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 69, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 1459, 0, 0, 1460, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 1103, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 1102,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 586, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 1441, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 0, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 0, 0,
-	0, 177, 178, 179, 0, 1072, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 0, 0, 0, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	289, 0, 0, 0, 345, 0, 382, 227, 298, 296,
-	410, 251, 244, 240, 226, 273, 304, 343, 400, 337,
-	0, 293, 0, 0, 391, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 225, 195, 328, 392, 255, 0, 0, 0,
-	177, 178, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 223, 0, 0, 0, 0, 237, 277,
-	243, 236, 407, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 317, 0, 0,
-	0, 438, 0, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 191, 205, 0, 0, 327, 366, 372,
-	0, 0, 0, 228, 0, 370, 341, 424, 213, 253,
-	363, 346, 368, 0, 0, 369, 294, 412, 358, 422,
-	439, 440, 235, 321, 430, 404, 436, 448, 206, 232,
-	335, 397, 427, 388, 314, 408, 409, 284, 387, 261,
-	194, 292, 198, 399, 420, 218, 380, 0, 0, 0,
-	200, 418, 396, 311, 281, 282, 199, 0, 362, 239,
-	259, 230, 330, 415, 416, 229, 450, 208, 435, 202,
-	209, 434, 323, 411, 419, 312, 303, 201, 417, 310,
-	302, 287, 249, 269, 356, 297, 357, 270, 319, 318,
-	320, 0, 196, 0, 393, 428, 451, 215, 0, 0,
-	406, 444, 447, 0, 359, 216, 260, 248, 355, 258,
-	290, 443, 445, 446, 214, 353, 266, 334, 423, 252,
-	431, 322, 210, 272, 389, 286, 295, 0, 0, 340,
-	371, 219, 426, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 190, 203, 291, 1344, 360, 256,
-	449, 433, 429, 0, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 193,
-	204, 212, 221, 233, 246, 254, 264, 268, 271, 274,
-	275, 278, 283, 300, 305, 306, 307, 308, 324, 325,
-	326, 329, 332, 333, 336, 338, 339, 342, 348, 349,
-	350, 351, 352, 354, 361, 365, 373, 374, 375, 376,
-	377, 378, 379, 383, 384, 385, 386, 394, 398, 413,
-	414, 425, 437, 441, 265, 421, 442, 0, 299, 0,
-	0, 301, 250, 267, 276, 0, 432, 395, 207, 367,
-	257, 197, 224, 211, 231, 245, 247, 280, 309, 315,
-	344, 347, 262, 242, 222, 364, 220, 381, 401, 402,
-	403, 405, 313, 238, 331, 0, 1227, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 289,
-	0, 0, 0, 345, 0, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 0,
-	293, 0, 0, 391, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 225, 195, 328, 392, 255, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 223, 0, 0, 0, 0, 237, 277, 243,
-	236, 407, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 317, 0, 0, 0,
-	438, 0, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 191, 205, 0, 0, 327, 366, 372, 0,
-	0, 0, 228, 0, 370, 341, 424, 213, 253, 363,
-	346, 368, 0, 0, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 0, 0, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 0, 0, 340, 371,
-	219, 426, 390, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 190, 203, 291, 0, 360, 256, 449,
-	433, 429, 0, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 0, 0,
-	301, 250, 267, 276, 0, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 331, 0, 1225, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 1223, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 1221, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 1219, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 1215, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	1213, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 1211,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 0, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 1186, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 0, 0, 0, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 1085, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 1076,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 934,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 499, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 498, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 185, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2239, 0, 0, 0, 0, 746, 733, 0,
+	2252, 682, 749, 653, 671, 758, 673, 676, 716, 633,
+	695, 335, 668, 0, 657, 629, 664, 630, 655, 684,
+	245, 688, 652, 735, 698, 748, 293, 0, 635, 658,
+	349, 718, 386, 231, 302, 300, 414, 255, 248, 244,
+	230, 277, 308, 347, 404, 341, 755, 297, 705, 0,
+	395, 320, 0, 0, 0, 686, 738, 693, 729, 681,
+	717, 642, 704, 750, 669, 713, 751, 283, 229, 198,
+	332, 396, 259, 0, 0, 0, 180, 181, 182, 0,
+	2243, 2244, 0, 0, 0, 0, 0, 221, 0, 227,
+	710, 745, 666, 712, 241, 281, 247, 240, 411, 715,
+	761, 628, 707, 0, 631, 634, 757, 741, 661, 662,
+	0, 0, 0, 0, 0, 0, 0, 685, 694, 726,
+	679, 0, 0, 0, 0, 0, 0, 0, 0, 659,
+	0, 703, 0, 0, 0, 638, 632, 0, 0, 0,
+	0, 683, 0, 0, 0, 641, 0, 660, 727, 0,
+	626, 267, 636, 321, 731, 740, 680, 442, 744, 678,
+	677, 747, 722, 639, 737, 672, 292, 637, 289, 194,
+	209, 0, 670, 331, 370, 376, 736, 656, 665, 232,
+	663, 374, 345, 428, 217, 257, 367, 350, 372, 702,
+	720, 373, 298, 416, 362, 426, 443, 444, 239, 325,
+	434, 408, 440, 452, 210, 236, 339, 401, 431, 392,
+	318, 412, 413, 288, 391, 265, 197, 296, 201, 202,
+	403, 424, 222, 384, 0, 0, 0, 204, 422, 400,
+	315, 285, 286, 203, 0, 366, 243, 263, 234, 334,
+	419, 420, 233, 454, 212, 439, 206, 213, 438, 327,
+	415, 423, 316, 307, 205, 421, 314, 306, 291, 253,
+	273, 360, 301, 361, 274, 323, 322, 324, 0, 199,
+	0, 397, 432, 455, 219, 651, 732, 410, 448, 451,
+	0, 363, 220, 264, 252, 359, 262, 294, 447, 449,
+	450, 218, 357, 270, 338, 427, 256, 435, 326, 214,
+	276, 393, 290, 299, 724, 760, 344, 375, 223, 430,
+	394, 646, 650, 644, 645, 696, 697, 647, 752, 753,
+	754, 728, 640, 0, 648, 649, 0, 734, 742, 743,
+	701, 193, 207, 295, 756, 364, 260, 453, 437, 433,
+	627, 643, 238, 654, 0, 0, 667, 674, 675, 687,
+	689, 690, 691, 692, 700, 708, 709, 711, 719, 721,
+	723, 725, 730, 739, 759, 195, 196, 208, 216, 225,
+	237, 250, 258, 268, 272, 275, 278, 279, 282, 287,
+	304, 309, 310, 311, 312, 328, 329, 330, 333, 336,
+	337, 340, 342, 343, 346, 352, 353, 354, 355, 356,
+	358, 365, 369, 377, 378, 379, 380, 381, 382, 383,
+	387, 388, 389, 390, 398, 402, 417, 418, 429, 441,
+	445, 269, 425, 446, 0, 303, 699, 706, 305, 254,
+	271, 280, 714, 436, 399, 211, 371, 261, 200, 228,
+	215, 235, 249, 251, 284, 313, 319, 348, 351, 266,
+	246, 226, 368, 224, 385, 405, 406, 407, 409, 317,
+	242, 746, 733, 0, 0, 682, 749, 653, 671, 758,
+	673, 676, 716, 633, 695, 335, 668, 0, 657, 629,
+	664, 630, 655, 684, 245, 688, 652, 735, 698, 748,
+	293, 0, 635, 658, 349, 718, 386, 231, 302, 300,
+	414, 255, 248, 244, 230, 277, 308, 347, 404, 341,
+	755, 297, 705, 0, 395, 320, 0, 0, 0, 686,
+	738, 693, 729, 681, 717, 642, 704, 750, 669, 713,
+	751, 283, 229, 198, 332, 396, 259, 0, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 227, 710, 745, 666, 712, 241, 281,
+	247, 240, 411, 715, 761, 628, 707, 0, 631, 634,
+	757, 741, 661, 662, 0, 0, 0, 0, 0, 0,
+	0, 685, 694, 726, 679, 0, 0, 0, 0, 0,
+	0, 1989, 0, 659, 0, 703, 0, 0, 0, 638,
+	632, 0, 0, 0, 0, 683, 0, 0, 0, 641,
+	0, 660, 727, 0, 626, 267, 636, 321, 731, 740,
+	680, 442, 744, 678, 677, 747, 722, 639, 737, 672,
+	292, 637, 289, 194, 209, 0, 670, 331, 370, 376,
+	736, 656, 665, 232, 663, 374, 345, 428, 217, 257,
+	367, 350, 372, 702, 720, 373, 298, 416, 362, 426,
+	443, 444, 239, 325, 434, 408, 440, 452, 210, 236,
+	339, 401, 431, 392, 318, 412, 413, 288, 391, 265,
+	197, 296, 201, 202, 403, 424, 222, 384, 0, 0,
+	0, 204, 422, 400, 315, 285, 286, 203, 0, 366,
+	243, 263, 234, 334, 419, 420, 233, 454, 212, 439,
+	206, 213, 438, 327, 415, 423, 316, 307, 205, 421,
+	314, 306, 291, 253, 273, 360, 301, 361, 274, 323,
+	322, 324, 0, 199, 0, 397, 432, 455, 219, 651,
+	732, 410, 448, 451, 0, 363, 220, 264, 252, 359,
+	262, 294, 447, 449, 450, 218, 357, 270, 338, 427,
+	256, 435, 326, 214, 276, 393, 290, 299, 724, 760,
+	344, 375, 223, 430, 394, 646, 650, 644, 645, 696,
+	697, 647, 752, 753, 754, 728, 640, 0, 648, 649,
+	0, 734, 742, 743, 701, 193, 207, 295, 756, 364,
+	260, 453, 437, 433, 627, 643, 238, 654, 0, 0,
+	667, 674, 675, 687, 689, 690, 691, 692, 700, 708,
+	709, 711, 719, 721, 723, 725, 730, 739, 759, 195,
+	196, 208, 216, 225, 237, 250, 258, 268, 272, 275,
+	278, 279, 282, 287, 304, 309, 310, 311, 312, 328,
+	329, 330, 333, 336, 337, 340, 342, 343, 346, 352,
+	353, 354, 355, 356, 358, 365, 369, 377, 378, 379,
+	380, 381, 382, 383, 387, 388, 389, 390, 398, 402,
+	417, 418, 429, 441, 445, 269, 425, 446, 0, 303,
+	699, 706, 305, 254, 271, 280, 714, 436, 399, 211,
+	371, 261, 200, 228, 215, 235, 249, 251, 284, 313,
+	319, 348, 351, 266, 246, 226, 368, 224, 385, 405,
+	406, 407, 409, 317, 242, 746, 733, 0, 0, 682,
+	749, 653, 671, 758, 673, 676, 716, 633, 695, 335,
+	668, 0, 657, 629, 664, 630, 655, 684, 245, 688,
+	652, 735, 698, 748, 293, 0, 635, 658, 349, 718,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 755, 297, 705, 0, 395, 320,
+	0, 0, 0, 686, 738, 693, 729, 681, 717, 642,
+	704, 750, 669, 713, 751, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 710, 745,
+	666, 712, 241, 281, 247, 240, 411, 715, 761, 628,
+	707, 0, 631, 634, 757, 741, 661, 662, 0, 0,
+	0, 0, 0, 0, 0, 685, 694, 726, 679, 0,
+	0, 0, 0, 0, 0, 1822, 0, 659, 0, 703,
+	0, 0, 0, 638, 632, 0, 0, 0, 0, 683,
+	0, 0, 0, 641, 0, 660, 727, 0, 626, 267,
+	636, 321, 731, 740, 680, 442, 744, 678, 677, 747,
+	722, 639, 737, 672, 292, 637, 289, 194, 209, 0,
+	670, 331, 370, 376, 736, 656, 665, 232, 663, 374,
+	345, 428, 217, 257, 367, 350, 372, 702, 720, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 651, 732, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 724, 760, 344, 375, 223, 430, 394, 646,
+	650, 644, 645, 696, 697, 647, 752, 753, 754, 728,
+	640, 0, 648, 649, 0, 734, 742, 743, 701, 193,
+	207, 295, 756, 364, 260, 453, 437, 433, 627, 643,
+	238, 654, 0, 0, 667, 674, 675, 687, 689, 690,
+	691, 692, 700, 708, 709, 711, 719, 721, 723, 725,
+	730, 739, 759, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 699, 706, 305, 254, 271, 280,
+	714, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 746,
+	733, 0, 0, 682, 749, 653, 671, 758, 673, 676,
+	716, 633, 695, 335, 668, 0, 657, 629, 664, 630,
+	655, 684, 245, 688, 652, 735, 698, 748, 293, 0,
+	635, 658, 349, 718, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 755, 297,
+	705, 0, 395, 320, 0, 0, 0, 686, 738, 693,
+	729, 681, 717, 642, 704, 750, 669, 713, 751, 283,
+	229, 198, 332, 396, 259, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 227, 710, 745, 666, 712, 241, 281, 247, 240,
+	411, 715, 761, 628, 707, 0, 631, 634, 757, 741,
+	661, 662, 0, 0, 0, 0, 0, 0, 0, 685,
+	694, 726, 679, 0, 0, 0, 0, 0, 0, 1518,
+	0, 659, 0, 703, 0, 0, 0, 638, 632, 0,
+	0, 0, 0, 683, 0, 0, 0, 641, 0, 660,
+	727, 0, 626, 267, 636, 321, 731, 740, 680, 442,
+	744, 678, 677, 747, 722, 639, 737, 672, 292, 637,
+	289, 194, 209, 0, 670, 331, 370, 376, 736, 656,
+	665, 232, 663, 374, 345, 428, 217, 257, 367, 350,
+	372, 702, 720, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 651, 732, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 724, 760, 344, 375,
+	223, 430, 394, 646, 650, 644, 645, 696, 697, 647,
+	752, 753, 754, 728, 640, 0, 648, 649, 0, 734,
+	742, 743, 701, 193, 207, 295, 756, 364, 260, 453,
+	437, 433, 627, 643, 238, 654, 0, 0, 667, 674,
+	675, 687, 689, 690, 691, 692, 700, 708, 709, 711,
+	719, 721, 723, 725, 730, 739, 759, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 699, 706,
+	305, 254, 271, 280, 714, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 746, 733, 0, 0, 682, 749, 653,
+	671, 758, 673, 676, 716, 633, 695, 335, 668, 0,
+	657, 629, 664, 630, 655, 684, 245, 688, 652, 735,
+	698, 748, 293, 0, 635, 658, 349, 718, 386, 231,
+	302, 300, 414, 255, 248, 244, 230, 277, 308, 347,
+	404, 341, 755, 297, 705, 0, 395, 320, 0, 0,
+	0, 686, 738, 693, 729, 681, 717, 642, 704, 750,
+	669, 713, 751, 283, 229, 198, 332, 396, 259, 71,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 227, 710, 745, 666, 712,
+	241, 281, 247, 240, 411, 715, 761, 628, 707, 0,
+	631, 634, 757, 741, 661, 662, 0, 0, 0, 0,
+	0, 0, 0, 685, 694, 726, 679, 0, 0, 0,
+	0, 0, 0, 0, 0, 659, 0, 703, 0, 0,
+	0, 638, 632, 0, 0, 0, 0, 683, 0, 0,
+	0, 641, 0, 660, 727, 0, 626, 267, 636, 321,
+	731, 740, 680, 442, 744, 678, 677, 747, 722, 639,
+	737, 672, 292, 637, 289, 194, 209, 0, 670, 331,
+	370, 376, 736, 656, 665, 232, 663, 374, 345, 428,
+	217, 257, 367, 350, 372, 702, 720, 373, 298, 416,
+	362, 426, 443, 444, 239, 325, 434, 408, 440, 452,
+	210, 236, 339, 401, 431, 392, 318, 412, 413, 288,
+	391, 265, 197, 296, 201, 202, 403, 424, 222, 384,
+	0, 0, 0, 204, 422, 400, 315, 285, 286, 203,
+	0, 366, 243, 263, 234, 334, 419, 420, 233, 454,
+	212, 439, 206, 213, 438, 327, 415, 423, 316, 307,
+	205, 421, 314, 306, 291, 253, 273, 360, 301, 361,
+	274, 323, 322, 324, 0, 199, 0, 397, 432, 455,
+	219, 651, 732, 410, 448, 451, 0, 363, 220, 264,
+	252, 359, 262, 294, 447, 449, 450, 218, 357, 270,
+	338, 427, 256, 435, 326, 214, 276, 393, 290, 299,
+	724, 760, 344, 375, 223, 430, 394, 646, 650, 644,
+	645, 696, 697, 647, 752, 753, 754, 728, 640, 0,
+	648, 649, 0, 734, 742, 743, 701, 193, 207, 295,
+	756, 364, 260, 453, 437, 433, 627, 643, 238, 654,
+	0, 0, 667, 674, 675, 687, 689, 690, 691, 692,
+	700, 708, 709, 711, 719, 721, 723, 725, 730, 739,
+	759, 195, 196, 208, 216, 225, 237, 250, 258, 268,
+	272, 275, 278, 279, 282, 287, 304, 309, 310, 311,
+	312, 328, 329, 330, 333, 336, 337, 340, 342, 343,
+	346, 352, 353, 354, 355, 356, 358, 365, 369, 377,
+	378, 379, 380, 381, 382, 383, 387, 388, 389, 390,
+	398, 402, 417, 418, 429, 441, 445, 269, 425, 446,
+	0, 303, 699, 706, 305, 254, 271, 280, 714, 436,
+	399, 211, 371, 261, 200, 228, 215, 235, 249, 251,
+	284, 313, 319, 348, 351, 266, 246, 226, 368, 224,
+	385, 405, 406, 407, 409, 317, 242, 746, 733, 0,
+	0, 682, 749, 653, 671, 758, 673, 676, 716, 633,
+	695, 335, 668, 0, 657, 629, 664, 630, 655, 684,
+	245, 688, 652, 735, 698, 748, 293, 0, 635, 658,
+	349, 718, 386, 231, 302, 300, 414, 255, 248, 244,
+	230, 277, 308, 347, 404, 341, 755, 297, 705, 0,
+	395, 320, 0, 0, 0, 686, 738, 693, 729, 681,
+	717, 642, 704, 750, 669, 713, 751, 283, 229, 198,
+	332, 396, 259, 0, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 227,
+	710, 745, 666, 712, 241, 281, 247, 240, 411, 715,
+	761, 628, 707, 0, 631, 634, 757, 741, 661, 662,
+	0, 0, 0, 0, 0, 0, 0, 685, 694, 726,
+	679, 0, 0, 0, 0, 0, 0, 0, 0, 659,
+	0, 703, 0, 0, 0, 638, 632, 0, 0, 0,
+	0, 683, 0, 0, 0, 641, 0, 660, 727, 0,
+	626, 267, 636, 321, 731, 740, 680, 442, 744, 678,
+	677, 747, 722, 639, 737, 672, 292, 637, 289, 194,
+	209, 0, 670, 331, 370, 376, 736, 656, 665, 232,
+	663, 374, 345, 428, 217, 257, 367, 350, 372, 702,
+	720, 373, 298, 416, 362, 426, 443, 444, 239, 325,
+	434, 408, 440, 452, 210, 236, 339, 401, 431, 392,
+	318, 412, 413, 288, 391, 265, 197, 296, 201, 202,
+	403, 424, 222, 384, 0, 0, 0, 204, 422, 400,
+	315, 285, 286, 203, 0, 366, 243, 263, 234, 334,
+	419, 420, 233, 454, 212, 439, 206, 213, 438, 327,
+	415, 423, 316, 307, 205, 421, 314, 306, 291, 253,
+	273, 360, 301, 361, 274, 323, 322, 324, 0, 199,
+	0, 397, 432, 455, 219, 651, 732, 410, 448, 451,
+	0, 363, 220, 264, 252, 359, 262, 294, 447, 449,
+	450, 218, 357, 270, 338, 427, 256, 435, 326, 214,
+	276, 393, 290, 299, 724, 760, 344, 375, 223, 430,
+	394, 646, 650, 644, 645, 696, 697, 647, 752, 753,
+	754, 728, 640, 0, 648, 649, 0, 734, 742, 743,
+	701, 193, 207, 295, 756, 364, 260, 453, 437, 433,
+	627, 643, 238, 654, 0, 0, 667, 674, 675, 687,
+	689, 690, 691, 692, 700, 708, 709, 711, 719, 721,
+	723, 725, 730, 739, 759, 195, 196, 208, 216, 225,
+	237, 250, 258, 268, 272, 275, 278, 279, 282, 287,
+	304, 309, 310, 311, 312, 328, 329, 330, 333, 336,
+	337, 340, 342, 343, 346, 352, 353, 354, 355, 356,
+	358, 365, 369, 377, 378, 379, 380, 381, 382, 383,
+	387, 388, 389, 390, 398, 402, 417, 418, 429, 441,
+	445, 269, 425, 446, 0, 303, 699, 706, 305, 254,
+	271, 280, 714, 436, 399, 211, 371, 261, 200, 228,
+	215, 235, 249, 251, 284, 313, 319, 348, 351, 266,
+	246, 226, 368, 224, 385, 405, 406, 407, 409, 317,
+	242, 746, 733, 0, 0, 682, 749, 653, 671, 758,
+	673, 676, 716, 633, 695, 335, 668, 0, 657, 629,
+	664, 630, 655, 684, 245, 688, 652, 735, 698, 748,
+	293, 0, 635, 658, 349, 718, 386, 231, 302, 300,
+	414, 255, 248, 244, 230, 277, 308, 347, 404, 341,
+	755, 297, 705, 0, 395, 320, 0, 0, 0, 686,
+	738, 693, 729, 681, 717, 642, 704, 750, 669, 713,
+	751, 283, 229, 198, 332, 396, 259, 0, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 227, 710, 745, 666, 712, 241, 281,
+	247, 240, 411, 715, 761, 628, 707, 0, 631, 634,
+	757, 741, 661, 662, 0, 0, 0, 0, 0, 0,
+	0, 685, 694, 726, 679, 0, 0, 0, 0, 0,
+	0, 0, 0, 659, 0, 703, 0, 0, 0, 638,
+	632, 0, 0, 0, 0, 683, 0, 0, 0, 641,
+	0, 660, 727, 0, 626, 267, 636, 321, 731, 740,
+	680, 442, 744, 678, 677, 747, 722, 639, 737, 672,
+	292, 637, 289, 194, 209, 0, 670, 331, 370, 376,
+	736, 656, 665, 232, 663, 374, 345, 428, 217, 257,
+	367, 350, 372, 702, 720, 373, 298, 416, 362, 426,
+	443, 444, 239, 325, 434, 408, 440, 452, 210, 236,
+	339, 401, 431, 392, 318, 412, 413, 288, 391, 265,
+	197, 296, 201, 202, 403, 424, 222, 384, 0, 0,
+	0, 204, 422, 400, 315, 285, 286, 203, 0, 366,
+	243, 263, 234, 334, 419, 420, 233, 454, 212, 439,
+	206, 763, 438, 327, 415, 423, 316, 307, 205, 421,
+	314, 306, 291, 253, 273, 360, 301, 361, 274, 323,
+	322, 324, 0, 199, 0, 397, 432, 455, 219, 651,
+	732, 410, 448, 451, 0, 363, 220, 264, 252, 359,
+	262, 294, 447, 449, 450, 218, 357, 270, 338, 427,
+	256, 435, 625, 762, 619, 618, 290, 299, 724, 760,
+	344, 375, 223, 430, 394, 646, 650, 644, 645, 696,
+	697, 647, 752, 753, 754, 728, 640, 0, 648, 649,
+	0, 734, 742, 743, 701, 193, 207, 295, 756, 364,
+	260, 453, 437, 433, 627, 643, 238, 654, 0, 0,
+	667, 674, 675, 687, 689, 690, 691, 692, 700, 708,
+	709, 711, 719, 721, 723, 725, 730, 739, 759, 195,
+	196, 208, 216, 225, 237, 250, 258, 268, 272, 275,
+	278, 279, 282, 287, 304, 309, 310, 311, 312, 328,
+	329, 330, 333, 336, 337, 340, 342, 343, 346, 352,
+	353, 354, 355, 356, 358, 365, 369, 377, 378, 379,
+	380, 381, 382, 383, 387, 388, 389, 390, 398, 402,
+	417, 418, 429, 441, 445, 269, 425, 446, 0, 303,
+	699, 706, 305, 254, 271, 280, 714, 436, 399, 211,
+	371, 261, 200, 228, 215, 235, 249, 251, 284, 313,
+	319, 348, 351, 266, 246, 226, 368, 224, 385, 405,
+	406, 407, 409, 317, 242, 746, 733, 0, 0, 682,
+	749, 653, 671, 758, 673, 676, 716, 633, 695, 335,
+	668, 0, 657, 629, 664, 630, 655, 684, 245, 688,
+	652, 735, 698, 748, 293, 0, 635, 658, 349, 718,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 755, 297, 705, 0, 395, 320,
+	0, 0, 0, 686, 738, 693, 729, 681, 717, 642,
+	704, 750, 669, 713, 751, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 710, 745,
+	666, 712, 241, 281, 247, 240, 411, 715, 761, 628,
+	707, 0, 631, 634, 757, 741, 661, 662, 0, 0,
+	0, 0, 0, 0, 0, 685, 694, 726, 679, 0,
+	0, 0, 0, 0, 0, 0, 0, 659, 0, 703,
+	0, 0, 0, 638, 632, 0, 0, 0, 0, 683,
+	0, 0, 0, 641, 0, 660, 727, 0, 626, 267,
+	636, 321, 731, 740, 680, 442, 744, 678, 677, 747,
+	722, 639, 737, 672, 292, 637, 289, 194, 209, 0,
+	670, 331, 370, 376, 736, 656, 665, 232, 663, 374,
+	345, 428, 217, 257, 367, 350, 372, 702, 720, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 1119,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 763, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 651, 732, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 625, 762, 619, 618,
+	290, 299, 724, 760, 344, 375, 223, 430, 394, 646,
+	650, 644, 645, 696, 697, 647, 752, 753, 754, 728,
+	640, 0, 648, 649, 0, 734, 742, 743, 701, 193,
+	207, 295, 756, 364, 260, 453, 437, 433, 627, 643,
+	238, 654, 0, 0, 667, 674, 675, 687, 689, 690,
+	691, 692, 700, 708, 709, 711, 719, 721, 723, 725,
+	730, 739, 759, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 699, 706, 305, 254, 271, 280,
+	714, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 746,
+	733, 0, 0, 682, 749, 653, 671, 758, 673, 676,
+	716, 633, 695, 335, 668, 0, 657, 629, 664, 630,
+	655, 684, 245, 688, 652, 735, 698, 748, 293, 0,
+	635, 658, 349, 718, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 755, 297,
+	705, 0, 395, 320, 0, 0, 0, 686, 738, 693,
+	729, 681, 717, 642, 704, 750, 669, 713, 751, 283,
+	229, 198, 332, 396, 259, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 227, 710, 745, 666, 712, 241, 281, 247, 240,
+	411, 715, 761, 628, 707, 0, 631, 634, 757, 741,
+	661, 662, 0, 0, 0, 0, 0, 0, 0, 685,
+	694, 726, 679, 0, 0, 0, 0, 0, 0, 0,
+	0, 659, 0, 703, 0, 0, 0, 638, 632, 0,
+	0, 0, 0, 683, 0, 0, 0, 641, 0, 660,
+	727, 0, 626, 267, 636, 321, 731, 740, 680, 442,
+	744, 678, 677, 747, 722, 639, 737, 672, 292, 637,
+	289, 194, 209, 0, 670, 331, 370, 376, 736, 656,
+	665, 232, 663, 374, 345, 428, 217, 257, 367, 350,
+	372, 702, 720, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 616, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 763,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 651, 732, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	625, 762, 619, 618, 290, 299, 724, 760, 344, 375,
+	223, 430, 394, 646, 650, 644, 645, 696, 697, 647,
+	752, 753, 754, 728, 640, 0, 648, 649, 0, 734,
+	742, 743, 701, 193, 207, 295, 756, 364, 260, 453,
+	437, 433, 627, 643, 238, 654, 0, 0, 667, 674,
+	675, 687, 689, 690, 691, 692, 700, 708, 709, 711,
+	719, 721, 723, 725, 730, 739, 759, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 699, 706,
+	305, 254, 271, 280, 714, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 335, 0, 0, 1444, 0, 518, 0,
+	0, 0, 245, 0, 517, 0, 0, 0, 293, 0,
+	0, 1445, 349, 0, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 561, 297,
+	0, 0, 395, 320, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	229, 198, 332, 396, 259, 71, 0, 0, 180, 181,
+	182, 539, 538, 541, 542, 543, 544, 0, 0, 221,
+	540, 227, 545, 546, 547, 0, 241, 281, 247, 240,
+	411, 0, 0, 0, 515, 532, 0, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 530, 606,
+	0, 0, 0, 575, 0, 531, 0, 0, 524, 525,
+	527, 526, 528, 533, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 321, 574, 0, 0, 442,
+	0, 0, 572, 0, 0, 0, 0, 0, 292, 0,
+	289, 194, 209, 0, 0, 331, 370, 376, 0, 0,
+	0, 232, 0, 374, 345, 428, 217, 257, 367, 350,
+	372, 0, 0, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 0, 0, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 0, 0, 344, 375,
+	223, 430, 394, 562, 573, 568, 569, 566, 567, 0,
+	565, 564, 563, 576, 554, 555, 556, 557, 559, 0,
+	570, 571, 558, 193, 207, 295, 0, 364, 260, 453,
+	437, 433, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 0, 0,
+	305, 254, 271, 280, 0, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 335, 0, 0, 0, 0, 518, 0,
+	0, 0, 245, 0, 517, 0, 0, 0, 293, 0,
+	0, 0, 349, 0, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 561, 297,
+	0, 0, 395, 320, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 0, 0, 1557, 0, 283,
+	229, 198, 332, 396, 259, 71, 0, 0, 180, 181,
+	182, 539, 538, 541, 542, 543, 544, 0, 0, 221,
+	540, 227, 545, 546, 547, 1558, 241, 281, 247, 240,
+	411, 0, 0, 0, 515, 532, 0, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 530, 0,
+	0, 0, 0, 575, 0, 531, 0, 0, 524, 525,
+	527, 526, 528, 533, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 321, 574, 0, 0, 442,
+	0, 0, 572, 0, 0, 0, 0, 0, 292, 0,
+	289, 194, 209, 0, 0, 331, 370, 376, 0, 0,
+	0, 232, 0, 374, 345, 428, 217, 257, 367, 350,
+	372, 0, 0, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 0, 0, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 0, 0, 344, 375,
+	223, 430, 394, 562, 573, 568, 569, 566, 567, 0,
+	565, 564, 563, 576, 554, 555, 556, 557, 559, 0,
+	570, 571, 558, 193, 207, 295, 0, 364, 260, 453,
+	437, 433, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 0, 0,
+	305, 254, 271, 280, 0, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 335, 0, 0, 0, 0, 518, 0,
+	0, 0, 245, 0, 517, 0, 0, 0, 293, 0,
+	0, 0, 349, 0, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 561, 297,
+	0, 0, 395, 320, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	229, 198, 332, 396, 259, 71, 0, 594, 180, 181,
+	182, 539, 538, 541, 542, 543, 544, 0, 0, 221,
+	540, 227, 545, 546, 547, 0, 241, 281, 247, 240,
+	411, 0, 0, 0, 515, 532, 0, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 530, 0,
+	0, 0, 0, 575, 0, 531, 0, 0, 524, 525,
+	527, 526, 528, 533, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 321, 574, 0, 0, 442,
+	0, 0, 572, 0, 0, 0, 0, 0, 292, 0,
+	289, 194, 209, 0, 0, 331, 370, 376, 0, 0,
+	0, 232, 0, 374, 345, 428, 217, 257, 367, 350,
+	372, 0, 0, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 0, 0, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 0, 0, 344, 375,
+	223, 430, 394, 562, 573, 568, 569, 566, 567, 0,
+	565, 564, 563, 576, 554, 555, 556, 557, 559, 0,
+	570, 571, 558, 193, 207, 295, 0, 364, 260, 453,
+	437, 433, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 0, 0,
+	305, 254, 271, 280, 0, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 335, 0, 0, 0, 0, 518, 0,
+	0, 0, 245, 0, 517, 0, 0, 0, 293, 0,
+	0, 0, 349, 0, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 561, 297,
+	0, 0, 395, 320, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	229, 198, 332, 396, 259, 71, 0, 0, 180, 181,
+	182, 539, 538, 541, 542, 543, 544, 0, 0, 221,
+	540, 227, 545, 546, 547, 0, 241, 281, 247, 240,
+	411, 0, 0, 0, 515, 532, 0, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 530, 606,
+	0, 0, 0, 575, 0, 531, 0, 0, 524, 525,
+	527, 526, 528, 533, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 321, 574, 0, 0, 442,
+	0, 0, 572, 0, 0, 0, 0, 0, 292, 0,
+	289, 194, 209, 0, 0, 331, 370, 376, 0, 0,
+	0, 232, 0, 374, 345, 428, 217, 257, 367, 350,
+	372, 0, 0, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 0, 0, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 0, 0, 344, 375,
+	223, 430, 394, 562, 573, 568, 569, 566, 567, 0,
+	565, 564, 563, 576, 554, 555, 556, 557, 559, 0,
+	570, 571, 558, 193, 207, 295, 0, 364, 260, 453,
+	437, 433, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 0, 0,
+	305, 254, 271, 280, 0, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 335, 0, 0, 0, 0, 518, 0,
+	0, 0, 245, 0, 517, 0, 0, 0, 293, 0,
+	0, 0, 349, 0, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 561, 297,
+	0, 0, 395, 320, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	229, 198, 332, 396, 259, 71, 0, 0, 180, 181,
+	182, 539, 1462, 541, 542, 543, 544, 0, 0, 221,
+	540, 227, 545, 546, 547, 0, 241, 281, 247, 240,
+	411, 0, 0, 0, 515, 532, 0, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 530, 606,
+	0, 0, 0, 575, 0, 531, 0, 0, 524, 525,
+	527, 526, 528, 533, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 321, 574, 0, 0, 442,
+	0, 0, 572, 0, 0, 0, 0, 0, 292, 0,
+	289, 194, 209, 0, 0, 331, 370, 376, 0, 0,
+	0, 232, 0, 374, 345, 428, 217, 257, 367, 350,
+	372, 0, 0, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 0, 0, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 0, 0, 344, 375,
+	223, 430, 394, 562, 573, 568, 569, 566, 567, 0,
+	565, 564, 563, 576, 554, 555, 556, 557, 559, 0,
+	570, 571, 558, 193, 207, 295, 0, 364, 260, 453,
+	437, 433, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 0, 0,
+	305, 254, 271, 280, 0, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 335, 0, 0, 0, 0, 518, 0,
+	0, 0, 245, 0, 517, 0, 0, 0, 293, 0,
+	0, 0, 349, 0, 386, 231, 302, 300, 414, 255,
+	248, 244, 230, 277, 308, 347, 404, 341, 561, 297,
+	0, 0, 395, 320, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	229, 198, 332, 396, 259, 71, 0, 0, 180, 181,
+	182, 539, 1459, 541, 542, 543, 544, 0, 0, 221,
+	540, 227, 545, 546, 547, 0, 241, 281, 247, 240,
+	411, 0, 0, 0, 515, 532, 0, 560, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 529, 530, 606,
+	0, 0, 0, 575, 0, 531, 0, 0, 524, 525,
+	527, 526, 528, 533, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 321, 574, 0, 0, 442,
+	0, 0, 572, 0, 0, 0, 0, 0, 292, 0,
+	289, 194, 209, 0, 0, 331, 370, 376, 0, 0,
+	0, 232, 0, 374, 345, 428, 217, 257, 367, 350,
+	372, 0, 0, 373, 298, 416, 362, 426, 443, 444,
+	239, 325, 434, 408, 440, 452, 210, 236, 339, 401,
+	431, 392, 318, 412, 413, 288, 391, 265, 197, 296,
+	201, 202, 403, 424, 222, 384, 0, 0, 0, 204,
+	422, 400, 315, 285, 286, 203, 0, 366, 243, 263,
+	234, 334, 419, 420, 233, 454, 212, 439, 206, 213,
+	438, 327, 415, 423, 316, 307, 205, 421, 314, 306,
+	291, 253, 273, 360, 301, 361, 274, 323, 322, 324,
+	0, 199, 0, 397, 432, 455, 219, 0, 0, 410,
+	448, 451, 0, 363, 220, 264, 252, 359, 262, 294,
+	447, 449, 450, 218, 357, 270, 338, 427, 256, 435,
+	326, 214, 276, 393, 290, 299, 0, 0, 344, 375,
+	223, 430, 394, 562, 573, 568, 569, 566, 567, 0,
+	565, 564, 563, 576, 554, 555, 556, 557, 559, 0,
+	570, 571, 558, 193, 207, 295, 0, 364, 260, 453,
+	437, 433, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 208,
+	216, 225, 237, 250, 258, 268, 272, 275, 278, 279,
+	282, 287, 304, 309, 310, 311, 312, 328, 329, 330,
+	333, 336, 337, 340, 342, 343, 346, 352, 353, 354,
+	355, 356, 358, 365, 369, 377, 378, 379, 380, 381,
+	382, 383, 387, 388, 389, 390, 398, 402, 417, 418,
+	429, 441, 445, 269, 425, 446, 0, 303, 0, 0,
+	305, 254, 271, 280, 0, 436, 399, 211, 371, 261,
+	200, 228, 215, 235, 249, 251, 284, 313, 319, 348,
+	351, 266, 246, 226, 368, 224, 385, 405, 406, 407,
+	409, 317, 242, 587, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 335, 0, 0, 0,
+	0, 518, 0, 0, 0, 245, 0, 517, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 561, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 552, 553, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 71, 0,
+	0, 180, 181, 182, 539, 538, 541, 542, 543, 544,
+	0, 0, 221, 540, 227, 545, 546, 547, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 515, 532, 0,
+	560, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	529, 530, 0, 0, 0, 0, 575, 0, 531, 0,
+	0, 524, 525, 527, 526, 528, 533, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 574,
+	0, 0, 442, 0, 0, 572, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 562, 573, 568, 569,
+	566, 567, 0, 565, 564, 563, 576, 554, 555, 556,
+	557, 559, 0, 570, 571, 558, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	0, 518, 0, 0, 0, 245, 0, 517, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 561, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 552, 553, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 71, 0,
+	0, 180, 181, 182, 539, 538, 541, 542, 543, 544,
+	0, 0, 221, 540, 227, 545, 546, 547, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 515, 532, 0,
+	560, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	529, 530, 0, 0, 0, 0, 575, 0, 531, 0,
+	0, 524, 525, 527, 526, 528, 533, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 574,
+	0, 0, 442, 0, 0, 572, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 562, 573, 568, 569,
+	566, 567, 0, 565, 564, 563, 576, 554, 555, 556,
+	557, 559, 0, 570, 571, 558, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 561, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 552, 553, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 71, 0,
+	0, 180, 181, 182, 539, 538, 541, 542, 543, 544,
+	0, 0, 221, 540, 227, 545, 546, 547, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 0, 532, 0,
+	560, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	529, 530, 0, 0, 0, 0, 575, 0, 531, 0,
+	0, 524, 525, 527, 526, 528, 533, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 574,
+	0, 0, 442, 0, 0, 572, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 2310, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 562, 573, 568, 569,
+	566, 567, 0, 565, 564, 563, 576, 554, 555, 556,
+	557, 559, 0, 570, 571, 558, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 561, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 552, 553, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 71, 0,
+	594, 180, 181, 182, 539, 538, 541, 542, 543, 544,
+	0, 0, 221, 540, 227, 545, 546, 547, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 0, 532, 0,
+	560, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	529, 530, 0, 0, 0, 0, 575, 0, 531, 0,
+	0, 524, 525, 527, 526, 528, 533, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 574,
+	0, 0, 442, 0, 0, 572, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 562, 573, 568, 569,
+	566, 567, 0, 565, 564, 563, 576, 554, 555, 556,
+	557, 559, 0, 570, 571, 558, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 561, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 552, 553, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 71, 0,
+	0, 180, 181, 182, 539, 538, 541, 542, 543, 544,
+	0, 0, 221, 540, 227, 545, 546, 547, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 0, 532, 0,
+	560, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	529, 530, 0, 0, 0, 0, 575, 0, 531, 0,
+	0, 524, 525, 527, 526, 528, 533, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 574,
+	0, 0, 442, 0, 0, 572, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 562, 573, 568, 569,
+	566, 567, 0, 565, 564, 563, 576, 554, 555, 556,
+	557, 559, 0, 570, 571, 558, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 0, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 227, 0, 0, 0, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 994, 993, 1003, 1004,
+	996, 997, 998, 999, 1000, 1001, 1002, 995, 0, 0,
+	1005, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 0,
+	0, 0, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 807, 0, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 0, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 227, 0, 0, 0, 0, 241,
+	281, 247, 240, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 0,
+	0, 806, 442, 0, 0, 0, 0, 0, 0, 803,
+	804, 292, 771, 289, 194, 209, 797, 801, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 335, 0, 0, 0,
+	1097, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 293, 0, 0, 0, 349, 0, 386, 231, 302,
+	300, 414, 255, 248, 244, 230, 277, 308, 347, 404,
+	341, 0, 297, 0, 0, 395, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 229, 198, 332, 396, 259, 0, 0,
+	0, 180, 181, 182, 0, 1099, 0, 0, 0, 0,
+	0, 0, 221, 0, 227, 0, 0, 0, 0, 241,
+	281, 247, 240, 411, 983, 984, 982, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 985, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 267, 0, 321, 0,
+	0, 0, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 292, 0, 289, 194, 209, 0, 0, 331, 370,
+	376, 0, 0, 0, 232, 0, 374, 345, 428, 217,
+	257, 367, 350, 372, 0, 0, 373, 298, 416, 362,
+	426, 443, 444, 239, 325, 434, 408, 440, 452, 210,
+	236, 339, 401, 431, 392, 318, 412, 413, 288, 391,
+	265, 197, 296, 201, 202, 403, 424, 222, 384, 0,
+	0, 0, 204, 422, 400, 315, 285, 286, 203, 0,
+	366, 243, 263, 234, 334, 419, 420, 233, 454, 212,
+	439, 206, 213, 438, 327, 415, 423, 316, 307, 205,
+	421, 314, 306, 291, 253, 273, 360, 301, 361, 274,
+	323, 322, 324, 0, 199, 0, 397, 432, 455, 219,
+	0, 0, 410, 448, 451, 0, 363, 220, 264, 252,
+	359, 262, 294, 447, 449, 450, 218, 357, 270, 338,
+	427, 256, 435, 326, 214, 276, 393, 290, 299, 0,
+	0, 344, 375, 223, 430, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 207, 295, 0,
+	364, 260, 453, 437, 433, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 208, 216, 225, 237, 250, 258, 268, 272,
+	275, 278, 279, 282, 287, 304, 309, 310, 311, 312,
+	328, 329, 330, 333, 336, 337, 340, 342, 343, 346,
+	352, 353, 354, 355, 356, 358, 365, 369, 377, 378,
+	379, 380, 381, 382, 383, 387, 388, 389, 390, 398,
+	402, 417, 418, 429, 441, 445, 269, 425, 446, 0,
+	303, 0, 0, 305, 254, 271, 280, 0, 436, 399,
+	211, 371, 261, 200, 228, 215, 235, 249, 251, 284,
+	313, 319, 348, 351, 266, 246, 226, 368, 224, 385,
+	405, 406, 407, 409, 317, 242, 35, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 71, 0, 594, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 1489, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 1491, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 1487, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 765, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 771, 289, 194, 209, 769,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 1489, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 1491, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 35,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 71, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 1510, 0, 0, 1511, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 1130, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 1129, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 594, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 2082, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 71, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 1491, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 1099, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 1394, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 1312, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 1310, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1254, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1252, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1250, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1248, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1246, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1242, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1240, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 1238, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 293, 0, 0,
+	0, 349, 0, 386, 231, 302, 300, 414, 255, 248,
+	244, 230, 277, 308, 347, 404, 341, 0, 297, 0,
+	0, 395, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 229,
+	198, 332, 396, 259, 1213, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	227, 0, 0, 0, 0, 241, 281, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 321, 0, 0, 0, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 0, 289,
+	194, 209, 0, 0, 331, 370, 376, 0, 0, 0,
+	232, 0, 374, 345, 428, 217, 257, 367, 350, 372,
+	0, 0, 373, 298, 416, 362, 426, 443, 444, 239,
+	325, 434, 408, 440, 452, 210, 236, 339, 401, 431,
+	392, 318, 412, 413, 288, 391, 265, 197, 296, 201,
+	202, 403, 424, 222, 384, 0, 0, 0, 204, 422,
+	400, 315, 285, 286, 203, 0, 366, 243, 263, 234,
+	334, 419, 420, 233, 454, 212, 439, 206, 213, 438,
+	327, 415, 423, 316, 307, 205, 421, 314, 306, 291,
+	253, 273, 360, 301, 361, 274, 323, 322, 324, 0,
+	199, 0, 397, 432, 455, 219, 0, 0, 410, 448,
+	451, 0, 363, 220, 264, 252, 359, 262, 294, 447,
+	449, 450, 218, 357, 270, 338, 427, 256, 435, 326,
+	214, 276, 393, 290, 299, 0, 0, 344, 375, 223,
+	430, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 207, 295, 0, 364, 260, 453, 437,
+	433, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 208, 216,
+	225, 237, 250, 258, 268, 272, 275, 278, 279, 282,
+	287, 304, 309, 310, 311, 312, 328, 329, 330, 333,
+	336, 337, 340, 342, 343, 346, 352, 353, 354, 355,
+	356, 358, 365, 369, 377, 378, 379, 380, 381, 382,
+	383, 387, 388, 389, 390, 398, 402, 417, 418, 429,
+	441, 445, 269, 425, 446, 0, 303, 0, 0, 305,
+	254, 271, 280, 0, 436, 399, 211, 371, 261, 200,
+	228, 215, 235, 249, 251, 284, 313, 319, 348, 351,
+	266, 246, 226, 368, 224, 385, 405, 406, 407, 409,
+	317, 242, 1112, 0, 0, 0, 0, 0, 0, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 0, 0, 0, 0, 1103, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 952, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 506, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 505, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 503, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 504,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 188, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 293, 0, 0, 0, 349, 0,
+	386, 231, 302, 300, 414, 255, 248, 244, 230, 277,
+	308, 347, 404, 341, 0, 297, 0, 0, 395, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 229, 198, 332, 396,
+	259, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 227, 0, 0,
+	0, 0, 241, 281, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 321, 0, 0, 0, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 0, 289, 194, 209, 0,
+	0, 331, 370, 376, 0, 0, 0, 232, 0, 374,
+	345, 428, 217, 257, 367, 350, 372, 0, 0, 373,
+	298, 416, 362, 426, 443, 444, 239, 325, 434, 408,
+	440, 452, 210, 236, 339, 401, 431, 392, 318, 412,
+	413, 288, 391, 265, 197, 296, 201, 202, 403, 424,
+	222, 384, 0, 0, 0, 204, 422, 400, 315, 285,
+	286, 203, 0, 366, 243, 263, 234, 334, 419, 420,
+	233, 454, 212, 439, 206, 213, 438, 327, 415, 423,
+	316, 307, 205, 421, 314, 306, 291, 253, 273, 360,
+	301, 361, 274, 323, 322, 324, 0, 199, 0, 397,
+	432, 455, 219, 0, 0, 410, 448, 451, 0, 363,
+	220, 264, 252, 359, 262, 294, 447, 449, 450, 218,
+	357, 270, 338, 427, 256, 435, 326, 214, 276, 393,
+	290, 299, 0, 0, 344, 375, 223, 430, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	207, 295, 0, 364, 260, 453, 437, 433, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 208, 216, 225, 237, 250,
+	258, 268, 272, 275, 278, 279, 282, 287, 304, 309,
+	310, 311, 312, 328, 329, 330, 333, 336, 337, 340,
+	342, 343, 346, 352, 353, 354, 355, 356, 358, 365,
+	369, 377, 378, 379, 380, 381, 382, 383, 387, 388,
+	389, 390, 398, 402, 417, 418, 429, 441, 445, 269,
+	425, 446, 0, 303, 0, 0, 305, 254, 271, 280,
+	0, 436, 399, 211, 371, 261, 200, 228, 215, 235,
+	249, 251, 284, 313, 319, 348, 351, 266, 246, 226,
+	368, 224, 385, 405, 406, 407, 409, 317, 242,
 }
 
 var yyPact = [...]int{
-	4075, -1000, -339, 1534, -1000, -1000, -1000, -1000, -1000, -1000,
+	339, -1000, -321, 1781, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1499, 1161, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 545, 1169, 137, 1416, 275, 172, 928, 392, 119,
-	27059, 391, 113, 27508, -1000, 102, -1000, 94, 27508, 98,
-	26610, -1000, -1000, -278, 12658, 1343, 27, 14, 27508, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1185, 1452, 1468,
-	1495, 1011, 1407, -1000, 10849, 10849, 327, 327, 327, 9053,
-	-1000, -1000, 16712, 27508, 27508, 1176, 387, 928, 378, 376,
-	375, 314, -113, -1000, -1000, -1000, -1000, 1416, -1000, -1000,
-	170, -1000, 190, 1143, -1000, 1141, -1000, 569, 586, 247,
-	295, 283, 246, 245, 243, 242, 227, 224, 220, 219,
-	208, -1000, 511, 511, -171, -175, 1955, 304, 304, 304,
-	348, 1386, 1385, -1000, 548, -1000, 511, 511, 157, 511,
-	511, 511, 511, 165, 163, 511, 511, 511, 511, 511,
-	511, 511, 511, 511, 511, 511, 511, 511, 511, 511,
-	27508, -1000, 144, 512, 555, 1416, 158, -1000, -1000, -1000,
-	27508, 385, 928, 309, 309, 27508, -1000, 433, -1000, -1000,
+	-1000, -1000, -1000, 1731, 1386, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 694, 1408, 253, 1627, 4143, 240, 980, 465,
+	132, 28650, 456, 378, 29100, -1000, 108, -1000, 92, 29100,
+	99, 28200, -1000, -1000, -264, 13317, 1586, 31, 28, 29100,
+	23, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1398, 1713, 1723, 1740, 1176, 1684, -1000, 11504, 11504, 391,
+	391, 391, 9704, -1000, -1000, 17380, 29100, 29100, 1418, 455,
+	980, 435, 434, 432, 388, -99, -1000, -1000, -1000, -1000,
+	1627, -1000, -1000, 184, -1000, 293, 1349, -1000, 1347, -1000,
+	545, 594, 289, 370, 355, 288, 283, 281, 280, 279,
+	262, 260, 257, 310, -1000, 654, 654, -149, -150, 2374,
+	368, 368, 368, 422, 1605, 1603, -1000, 627, -1000, 654,
+	654, 178, 654, 654, 654, 654, 206, 203, 654, 654,
+	654, 654, 654, 654, 654, 654, 654, 654, 654, 654,
+	654, 654, 654, 29100, -1000, 148, 965, 679, 1627, 175,
+	-1000, -1000, -1000, 29100, 447, 980, 383, 383, 29100, -1000,
+	534, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4024,24 +4220,26 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 29100, 756, 756, 17,
+	756, 756, 756, 756, 83, 530, 26, -1000, 54, 191,
+	157, 164, 707, 130, 68, -1000, -1000, 153, 305, -1000,
+	756, 7848, 7848, 7848, -1000, 1611, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 421, -1000, -1000, -1000, -1000, 29100,
+	27750, 274, 29100, 1718, 672, -1000, 1712, -1000, -1000, 106,
+	-1000, -1000, 1244, 873, -1000, 13317, 2437, 1331, 1331, -1000,
+	-1000, 480, -1000, -1000, 14667, 14667, 14667, 14667, 14667, 14667,
+	14667, 14667, 14667, 14667, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1331, 533,
+	-1000, 12867, 1331, 1331, 1331, 1331, 1331, 1331, 1331, 1331,
+	13317, 1331, 1331, 1331, 1331, 1331, 1331, 1331, 1331, 1331,
+	1331, 1331, 1331, 1331, 1331, 1331, 1331, -1000, -1000, -1000,
+	29100, -1000, 1331, 1124, 1731, -1000, 1386, -1000, -1000, -1000,
+	1620, 13317, 13317, 1731, -1000, 1527, 11504, -1000, -1000, 1614,
+	-1000, -1000, -1000, -1000, 754, 1764, -1000, 16017, 532, 1763,
+	27300, -1000, 20543, 26850, 1343, 9240, -24, -1000, -1000, -1000,
+	661, 19193, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 27508, 609, 609, 53, 609, 609, 609, 609,
-	85, 420, 13, -1000, 78, 151, 149, 155, 589, 123,
-	63, -1000, -1000, 153, 103, -1000, 609, 7201, 7201, 7201,
-	-1000, 1396, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	343, -1000, -1000, -1000, -1000, 27508, 26161, 258, 544, -1000,
-	-1000, -1000, 49, -1000, -1000, 1078, 753, -1000, 12658, 2116,
-	1146, 1146, -1000, -1000, 407, -1000, -1000, 14005, 14005, 14005,
-	14005, 14005, 14005, 14005, 14005, 14005, 14005, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1146, 432, -1000, 12209, 1146, 1146, 1146, 1146, 1146,
-	1146, 1146, 1146, 12658, 1146, 1146, 1146, 1146, 1146, 1146,
-	1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146,
-	-1000, -1000, -1000, 27508, -1000, 1146, 1499, -1000, 1161, -1000,
-	-1000, -1000, 1401, 12658, 12658, 1499, -1000, 1279, 10849, -1000,
-	-1000, 1374, -1000, -1000, -1000, -1000, 701, 1513, -1000, 15352,
-	430, 1512, 25712, -1000, 19419, 25263, 1140, 8590, -61, -1000,
-	-1000, -1000, 540, 18521, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4053,425 +4251,453 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1611, 1267, 29100, -1000, -1000, 4747, 980,
+	-1000, 1407, -1000, 1265, -1000, 1390, 148, 388, 1444, 980,
+	980, 980, 980, 702, -1000, -1000, -1000, 654, 654, 302,
+	4143, 4599, -1000, -1000, -1000, 26393, 1406, 980, -1000, 1405,
+	-1000, 1659, 385, 592, 592, 980, -1000, -1000, 29100, 980,
+	1658, 1657, 29100, 29100, -1000, 25943, -1000, 25493, 25043, 941,
+	29100, 24593, 24143, 23693, 23243, 22793, -1000, 1464, -1000, 1370,
+	-1000, -1000, -1000, 29100, 29100, 29100, 36, -1000, -1000, 29100,
+	980, -1000, -1000, 938, 924, 654, 654, 923, 1023, 1020,
+	1019, 654, 654, 902, 1017, 1105, 224, 898, 856, 855,
+	981, 1013, 125, 911, 910, 847, 29100, 1402, -1000, 137,
+	660, 237, 22343, 348, 285, 442, 1112, 1110, 29100, -1000,
+	160, 1627, 1585, 1341, 413, 383, 1475, 29100, 1675, 980,
+	-1000, 8312, -1000, -1000, 1009, 13317, -1000, 747, 707, 707,
+	-1000, -1000, -1000, -1000, -1000, -1000, 756, 29100, 747, -1000,
+	-1000, -1000, 707, 756, 29100, 756, 756, 756, 756, 707,
+	756, 29100, 29100, 29100, 29100, 29100, 29100, 29100, 29100, 29100,
+	7848, 7848, 7848, 577, 1445, 147, 29100, 1474, 756, 726,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 98, -1000,
+	-1000, 571, -1000, -1000, 1781, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1755, 29100, -96, -1000, 1339, 21893, -1000, -270,
+	-271, -272, -273, -1000, -1000, -1000, -277, -280, -1000, -1000,
+	-1000, 13317, 13317, 13317, 13317, 863, 598, 14667, 904, 750,
+	14667, 14667, 14667, 14667, 14667, 14667, 14667, 14667, 14667, 14667,
+	14667, 14667, 14667, 14667, 14667, 664, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 980, -1000, 1765, 1299, 1299, 550,
+	550, 550, 550, 550, 550, 550, 550, 550, 15117, 10154,
+	8312, 1176, 1261, 1731, 11504, 11504, 13317, 13317, 12404, 11954,
+	11504, 1610, 687, 873, 29100, -1000, -1000, 14217, -1000, -1000,
+	-1000, -1000, -1000, 1135, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 29100, 29100, 11504, 11504, 11504, 11504, 11504, -1000, 1336,
+	-1000, -155, 16930, 13317, 29100, -1000, 1723, 1176, 1614, 1669,
+	1775, 567, 969, 1335, -1000, 1018, 1723, 18743, 1363, -1000,
+	1614, -1000, -1000, -1000, 29100, -1000, -1000, 21443, -1000, -1000,
+	7384, 29100, 256, 29100, -1000, 1337, 1601, -1000, -1000, -1000,
+	1706, 18293, 29100, 1334, 1295, -1000, -1000, 527, 8776, -24,
+	-1000, 8776, 1316, -1000, -54, -32, 10604, 547, -1000, -1000,
+	-1000, 2374, 15567, 1194, -1000, 39, -1000, -1000, -1000, 1390,
+	-1000, 1390, 1390, 1390, 1390, 36, 36, 36, 36, -1000,
+	-1000, -1000, -1000, -1000, 1400, 1399, -1000, 1390, 1390, 1390,
+	1390, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1397, 1397,
+	1397, 1391, 1391, 362, -1000, 13317, 146, 29100, 1668, 828,
+	137, 29100, 1471, -1000, 29100, 1444, 1444, 1444, -1000, 1674,
+	1079, 1061, -1000, 1333, -1000, -1000, 1739, -1000, -1000, 775,
+	724, 722, 597, 29100, 121, 255, -1000, 333, -1000, 29100,
+	1396, 1656, 592, 980, -1000, 980, -1000, -1000, -1000, -1000,
+	519, -1000, -1000, 980, 1332, -1000, 1340, 783, 721, 768,
+	709, 1332, -1000, -1000, -119, 1332, -1000, 1332, -1000, 1332,
+	-1000, 1332, -1000, 1332, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 608, 29100, 121, 664, -1000, 412, -1000, -1000,
+	664, 664, -1000, -1000, -1000, -1000, 1008, 1005, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1396, 1031, 27508, -1000, -1000,
-	3604, 928, -1000, 1168, -1000, 1029, -1000, 1150, 144, 314,
-	1199, 928, 928, 928, 928, 600, -1000, -1000, -1000, 511,
-	511, 202, 275, 4093, -1000, -1000, -1000, 24807, 1167, 928,
-	-1000, 1166, -1000, 1427, 292, 496, 496, 928, -1000, -1000,
-	27508, 928, 1426, 1425, 27508, 27508, -1000, 24358, -1000, 23909,
-	23460, 869, 27508, 23011, 22562, 22113, 21664, 21215, -1000, 1239,
-	-1000, 1136, -1000, -1000, -1000, 27508, 27508, 27508, 39, -1000,
-	-1000, 27508, 928, -1000, -1000, 847, 834, 511, 511, 812,
-	947, 945, 941, 511, 511, 800, 937, 921, 150, 787,
-	779, 761, 891, 936, 110, 867, 850, 756, 27508, 1162,
-	-1000, 134, 538, 181, 230, 199, 27508, 135, 1416, 1342,
-	1129, 341, 309, 1232, 27508, 1441, 928, -1000, 7664, -1000,
-	-1000, 933, 12658, -1000, 641, 589, 589, -1000, -1000, -1000,
-	-1000, -1000, -1000, 609, 27508, 641, -1000, -1000, -1000, 589,
-	609, 27508, 609, 609, 609, 609, 589, 609, 27508, 27508,
-	27508, 27508, 27508, 27508, 27508, 27508, 27508, 7201, 7201, 7201,
-	492, -1000, 1231, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	97, -1000, -1000, -1000, -1000, -1000, 1534, -1000, -1000, -1000,
-	-110, 1127, 20766, -1000, -282, -283, -285, -290, -1000, -1000,
-	-1000, -291, -298, -1000, -1000, -1000, 12658, 12658, 12658, 12658,
-	786, 499, 14005, 710, 633, 14005, 14005, 14005, 14005, 14005,
-	14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005,
-	590, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 928,
-	-1000, 1522, 896, 896, 444, 444, 444, 444, 444, 444,
-	444, 444, 444, 14454, 9502, 7664, 1011, 1027, 1499, 10849,
-	10849, 12658, 12658, 11747, 11298, 10849, 1408, 565, 753, 27508,
-	-1000, -1000, 13556, -1000, -1000, -1000, -1000, -1000, 953, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 27508, 27508, 10849, 10849,
-	10849, 10849, 10849, -1000, 1126, -1000, -170, 16263, 12658, 1468,
-	1011, 1374, 1436, 1528, 461, 744, 1114, -1000, 729, 1468,
-	18072, 1135, -1000, 1374, -1000, -1000, -1000, 27508, -1000, -1000,
-	20317, -1000, -1000, 6738, 27508, 204, 27508, -1000, 1128, 1307,
-	-1000, -1000, -1000, 1449, 17623, 27508, 1071, 1045, -1000, -1000,
-	429, 8127, -61, -1000, 8127, 1099, -1000, -25, -51, 9951,
-	419, -1000, -1000, -1000, 1955, 14903, 1041, -1000, 35, -1000,
-	-1000, -1000, 1150, -1000, 1150, 1150, 1150, 1150, 39, 39,
-	39, 39, -1000, -1000, -1000, -1000, -1000, 1160, 1159, -1000,
-	1150, 1150, 1150, 1150, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -319, 29100, 427, 135, 165, 29100,
+	29100, 1604, -1000, 29100, 29100, 1084, 29100, 1084, 439, 29100,
+	-1000, 29100, 29100, -1000, 654, -1000, 801, -1000, -1000, -1000,
+	198, 29100, 29100, 29100, 29100, 451, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 873, 29100, -1000, -1000, 756, 756, -1000,
+	-1000, 29100, 756, -1000, -1000, -1000, -1000, -1000, -1000, 756,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1155, 1155, 1155, 1151, 1151, 285, -1000, 12658, 105,
-	27508, 1434, 755, 134, 27508, 1230, -1000, 27508, 1199, 1199,
-	1199, -1000, 1437, 914, 860, -1000, 1112, -1000, -1000, 1493,
-	-1000, -1000, 614, 637, 626, 484, 27508, 117, 193, -1000,
-	277, -1000, 27508, 1153, 1422, 496, 928, -1000, 928, -1000,
-	-1000, -1000, -1000, 425, -1000, -1000, 928, 1110, -1000, 1095,
-	671, 612, 670, 587, 1110, -1000, -1000, -139, 1110, -1000,
-	1110, -1000, 1110, -1000, 1110, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 550, 27508, 117, 590, -1000,
-	336, -1000, -1000, 590, 590, -1000, -1000, -1000, -1000, 932,
-	930, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -332, 27508, 355,
-	122, 186, 27508, 27508, 27508, 27508, 27508, 402, -1000, -1000,
-	-1000, 159, 27508, 27508, 27508, 27508, 369, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 753, 27508, -1000, -1000, 609, 609,
-	-1000, -1000, 27508, 609, -1000, -1000, -1000, -1000, -1000, -1000,
-	609, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 929, 27508, 27508, -1000, -1000,
-	-1000, -1000, -1000, 93, -29, 183, -1000, -1000, -1000, -1000,
-	1464, -1000, 753, 499, 674, 583, -1000, -1000, 773, -1000,
-	-1000, 2664, -1000, -1000, -1000, -1000, 710, 14005, 14005, 14005,
-	1270, 2664, 2643, 1255, 2256, 444, 684, 684, 454, 454,
-	454, 454, 454, 831, 831, -1000, -1000, -1000, -1000, 953,
-	-1000, -1000, -1000, 953, 10849, 10849, 1107, 1146, 423, -1000,
-	1185, -1000, -1000, 1468, 996, 996, 722, 672, 595, 1511,
-	996, 564, 1510, 996, 996, 10849, -1000, -1000, 597, -1000,
-	12658, 953, -1000, 1518, 1102, 1100, 996, 953, 953, 996,
-	996, 27508, -1000, -273, -1000, -41, 450, 1146, -1000, 19868,
-	-1000, -1000, 953, 1078, 1401, -1000, -1000, 1375, -1000, 1285,
-	12658, 12658, 12658, -1000, -1000, -1000, 1401, 1469, -1000, 1295,
-	1294, 1505, 10849, 19419, 1374, -1000, -1000, -1000, 422, 1505,
-	1092, 1146, -1000, 27508, 19419, 19419, 19419, 19419, 19419, -1000,
-	1266, 1249, -1000, 1263, 1248, 1276, 27508, -1000, 1020, 1011,
-	17623, 204, 1076, 19419, 27508, -1000, -1000, 19419, 27508, 6275,
-	-1000, 1099, -61, -39, -1000, -1000, -1000, -1000, 753, -1000,
-	806, -1000, 2276, -1000, 340, -1000, -1000, -1000, -1000, 443,
-	32, -1000, -1000, 39, 39, -1000, -1000, 419, 525, 419,
-	419, 419, 927, 927, -1000, -1000, -1000, -1000, -1000, 723,
-	-1000, -1000, -1000, 718, -1000, -1000, 852, 1223, 105, -1000,
-	-1000, 511, 922, 1378, -1000, -1000, 1037, 352, -1000, 27508,
-	-1000, 1228, 1218, 1216, -1000, -1000, -1000, -1000, -1000, 3612,
-	27508, 1018, -1000, 108, 27508, 1021, 27508, -1000, 1014, 27508,
-	-1000, 928, -1000, -1000, 7664, -1000, 27508, 1146, -1000, -1000,
-	-1000, -1000, 383, 1413, 1411, 117, 108, 419, 928, -1000,
-	-1000, -1000, -1000, -1000, -335, 998, 27508, 133, -1000, 1152,
-	894, -1000, 1183, -1000, -1000, -1000, -1000, 114, 178, 161,
-	329, -1000, 366, 1223, 27508, -1000, -1000, -1000, 589, -1000,
-	-1000, 589, -1000, -1000, -1000, -1000, -1000, -1000, 1393, -34,
-	-309, -1000, -306, -1000, -1000, -1000, -1000, 1270, 2664, 2380,
-	-1000, 14005, 14005, -1000, -1000, 996, 996, 10849, 7664, 1499,
-	1401, -1000, -1000, 412, 590, 412, 14005, 14005, -1000, 14005,
-	14005, -1000, -129, 1101, 549, -1000, 12658, 749, -1000, -1000,
-	14005, 14005, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 373, 371, 363, 27508, -1000, -1000, -1000, 841, 920,
-	1277, 753, 753, -1000, -1000, 27508, -1000, -1000, -1000, -1000,
-	1503, 12658, -1000, 1097, -1000, 5812, 1468, 1205, 27508, 1146,
-	1534, 15814, 27508, 1080, -1000, 533, 1307, 1194, 1204, 1254,
-	-1000, -1000, -1000, -1000, 1247, -1000, 1246, -1000, -1000, -1000,
-	-1000, -1000, 1011, 1505, 19419, 1075, -1000, 1075, -1000, 415,
-	-1000, -1000, -1000, -38, -56, -1000, -1000, -1000, 1955, -1000,
-	-1000, -1000, 667, 14005, 1526, -1000, 915, 1420, -1000, 1418,
-	-1000, -1000, 419, 419, -1000, -1000, -1000, -1000, -1000, -1000,
-	987, -1000, 984, 1096, 982, 59, -1000, 1174, 1392, 511,
-	511, -1000, 712, -1000, 928, -1000, 27508, -1000, 27508, 27508,
-	27508, 1492, 1090, -1000, 27508, -1000, -1000, 27508, -1000, -1000,
-	1291, 105, 980, -1000, -1000, -1000, 193, 27508, -1000, 896,
-	108, -1000, -1000, -1000, -1000, -1000, -1000, 1148, -1000, -1000,
-	-1000, 1008, -1000, -140, 928, 27508, 27508, 27508, -1000, 27508,
-	-1000, -1000, -1000, 609, 609, -1000, 1391, -1000, 928, -1000,
-	14005, 2664, 2664, -1000, -1000, 953, -1000, 1468, -1000, 953,
-	1150, 1150, -1000, 1150, 1151, -1000, 1150, 84, 1150, 83,
-	953, 953, 2548, 2463, 2342, 2154, 1146, -120, -1000, 753,
-	12658, 2133, 1772, 1146, 1146, 1146, 973, 913, 39, -1000,
-	-1000, -1000, 1501, 1489, 753, -1000, -1000, -1000, 1409, 1034,
-	1069, -1000, -1000, 10400, 977, 1290, 414, 973, 1499, 27508,
-	12658, -1000, -1000, 12658, 1149, -1000, 12658, -1000, -1000, -1000,
-	1499, 1499, 1075, -1000, -1000, 453, -1000, -1000, -1000, -1000,
-	-1000, 2664, -80, -1000, -1000, -1000, -1000, -1000, 39, 905,
-	39, 711, -1000, 679, -1000, -1000, -213, -1000, -1000, 1091,
-	1222, -1000, -1000, 1148, -1000, -1000, -1000, 27508, 27508, -1000,
-	-1000, 188, -1000, 265, 971, -1000, -169, -1000, -1000, 1447,
-	27508, -1000, -1000, 7664, -1000, -1000, 1147, 1196, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2664, -1000, 1401, -1000, -1000, 205,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14005, 14005,
-	14005, 14005, 14005, 1468, 901, 753, 14005, 14005, 18970, 27508,
-	27508, 17161, 39, 6, -1000, 12658, 12658, 1402, -1000, 1146,
-	-1000, 1109, 27508, 1146, 27508, -1000, 1468, -1000, 753, 753,
-	27508, 753, 1468, -1000, -1000, 419, -1000, 419, 990, 963,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1443, 1090,
-	-1000, 173, 27508, -1000, 193, -1000, -177, -178, 1161, 969,
-	1082, -1000, 507, 27508, 27508, -1000, -1000, -1000, 1518, 1518,
-	1518, 1518, 502, 953, -1000, 1518, 1518, 967, -1000, 967,
-	967, 450, -267, -1000, 1339, 1336, 753, 1078, 1524, -1000,
-	1146, 1534, 411, 1069, -1000, -1000, 961, -1000, -1000, -1000,
-	-1000, -1000, 1161, 1146, 1145, -1000, -1000, -1000, 177, -1000,
-	7664, 5349, 959, -1000, -1000, -1000, -1000, -1000, 953, 169,
-	-157, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6, 269,
-	-1000, 1302, 1300, 1487, 27508, 1069, 27508, -1000, 177, 13107,
-	27508, -1000, -48, -1000, -1000, -1000, -1000, -1000, 1183, -1000,
-	1275, -134, -166, 1308, 1310, 1310, 1336, 1480, 1324, 1317,
-	-1000, 890, 1067, -1000, -1000, 1518, 953, 957, 280, -1000,
-	-1000, -140, -1000, 1274, -1000, 1306, 769, -1000, -1000, -1000,
-	-1000, 888, -1000, 1473, 1471, -1000, -1000, -1000, 1203, 136,
-	-1000, -146, -1000, 691, -1000, -1000, -1000, 887, 875, 1202,
-	-1000, 1509, -1000, -164, -1000, -1000, -1000, -1000, -1000, 1517,
-	469, 469, -167, -1000, -1000, -1000, 251, 741, -1000, -1000,
-	-1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1003, 220, -1000, 1048, 29100, -1000,
+	-1000, 29100, 29100, -1000, 8312, -1000, 13317, 13317, 1752, -1000,
+	-1000, -1000, -1000, 29, -65, 207, -1000, -1000, -1000, -1000,
+	1687, -1000, 873, 598, 680, 617, -1000, -1000, 878, -1000,
+	-1000, 2584, -1000, -1000, -1000, -1000, 904, 14667, 14667, 14667,
+	1304, 2584, 2965, 885, 2214, 550, 760, 760, 548, 548,
+	548, 548, 548, 1001, 1001, -1000, -1000, -1000, -1000, 1135,
+	-1000, -1000, -1000, 1135, 11504, 11504, 1325, 1331, 517, -1000,
+	1398, -1000, -1000, 1723, 1191, 1191, 960, 1136, 643, 1761,
+	1191, 635, 1760, 1191, 1191, 11504, -1000, -1000, 693, -1000,
+	13317, 1135, -1000, 1326, 1323, 1317, 1191, 1135, 1135, 1191,
+	1191, 29100, -1000, -251, -1000, -81, 491, 1331, -1000, 20993,
+	-1000, -1000, 1135, 1244, -1000, 1620, -1000, -1000, 1577, -1000,
+	1524, 13317, 13317, 13317, -1000, -1000, -1000, 1620, 1730, -1000,
+	1536, 1534, 1749, 11504, 20543, 1614, -1000, -1000, -1000, 501,
+	1749, 1322, 1331, -1000, 29100, 20543, 20543, 20543, 20543, 20543,
+	-1000, 1511, 1510, -1000, 1507, 1495, 1491, 29100, -1000, 1259,
+	1176, 18293, 256, 1292, 20543, 29100, -1000, -1000, 20543, 29100,
+	6920, -1000, 1316, -24, -64, -1000, -1000, -1000, -1000, 873,
+	-1000, 947, -1000, 908, -1000, 361, -1000, -1000, -1000, -1000,
+	773, 37, -1000, -1000, 36, 36, -1000, -1000, 547, 629,
+	547, 547, 547, 1002, 1002, -1000, -1000, -1000, -1000, -1000,
+	812, -1000, -1000, -1000, 798, -1000, -1000, 1130, 1459, 146,
+	-1000, -1000, 654, 999, 1591, -1000, -1000, 1192, 426, -1000,
+	29100, -1000, 1470, 1468, 1462, -1000, -1000, -1000, -1000, -1000,
+	282, 29100, 1256, -1000, 117, 29100, 1166, 29100, -1000, 1248,
+	29100, -1000, 980, -1000, -1000, 8312, -1000, 29100, 1331, -1000,
+	-1000, -1000, -1000, 438, 1625, 1624, 121, 117, 547, 980,
+	-1000, -1000, -1000, -1000, -1000, -322, 1222, 29100, 143, -1000,
+	1394, 972, -1000, 1426, 1703, 752, -1000, -1000, 29100, -1000,
+	-1000, 29100, 29100, -139, 411, 410, 998, 134, 393, 29100,
+	215, 214, 1040, 212, 161, 409, -1000, 437, 1459, 29100,
+	-1000, -1000, -1000, 707, -1000, -1000, 707, -1000, -1000, -1000,
+	29100, -1000, -1000, -1000, -1000, -1000, 1331, 873, 13317, -1000,
+	1621, -69, -295, -1000, -292, -1000, -1000, -1000, -1000, 1304,
+	2584, 2476, -1000, 14667, 14667, -1000, -1000, 1191, 1191, 11504,
+	8312, 1731, 1620, -1000, -1000, 505, 664, 505, 14667, 14667,
+	-1000, 14667, 14667, -1000, -113, 1203, 683, -1000, 13317, 730,
+	-1000, -1000, 14667, 14667, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 431, 430, 429, 29100, -1000, -1000, -1000,
+	949, 996, 1519, 873, 873, -1000, -1000, 29100, -1000, -1000,
+	-1000, -1000, 1747, 13317, -1000, 1312, -1000, 6456, 1723, 1452,
+	29100, 1331, 1781, 16480, 29100, 1338, -1000, 658, 1601, 1425,
+	1451, 1443, -1000, -1000, -1000, -1000, 1504, -1000, 1503, -1000,
+	-1000, -1000, -1000, -1000, 1176, 1749, 20543, 1227, -1000, 1227,
+	-1000, 498, -1000, -1000, -1000, -72, -82, -1000, -1000, -1000,
+	2374, -1000, -1000, -1000, 733, 14667, 1773, -1000, 995, 1648,
+	-1000, 1632, -1000, -1000, 547, 547, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1187, -1000, 1185, 1311, 1180, 80, -1000, 1416,
+	1617, 654, 654, -1000, 791, -1000, 980, -1000, 29100, -1000,
+	29100, 29100, 29100, 1738, 1283, -1000, 29100, -1000, -1000, 29100,
+	-1000, -1000, 1532, 146, 1171, -1000, -1000, -1000, 255, 29100,
+	-1000, 1299, 117, -1000, -1000, -1000, -1000, -1000, -1000, 1387,
+	-1000, -1000, -1000, 1144, -1000, -139, 980, 29100, 654, -1000,
+	1033, 1022, -1000, 8312, 29100, 29100, -1000, 20093, 1392, 29100,
+	29100, 208, 116, 29100, 29100, 29100, 642, -1000, -1000, -1000,
+	29100, -1000, -1000, -1000, 756, 756, -1000, -1000, 873, -1000,
+	1609, -1000, 980, -1000, 14667, 2584, 2584, -1000, -1000, 1135,
+	-1000, 1723, -1000, 1135, 1390, 1390, -1000, 1390, 1391, -1000,
+	1390, 84, 1390, 82, 1135, 1135, 2880, 2568, 2390, 2372,
+	1331, -107, -1000, 873, 13317, 2135, 1580, 1331, 1331, 1331,
+	1165, 993, 36, -1000, -1000, -1000, 1743, 1737, 873, -1000,
+	-1000, -1000, 1661, 1183, 1207, -1000, -1000, 11054, 1169, 1530,
+	492, 1165, 1731, 29100, 13317, -1000, -1000, 13317, 1389, -1000,
+	13317, -1000, -1000, -1000, 1731, 1731, 1227, -1000, -1000, 506,
+	-1000, -1000, -1000, -1000, -1000, 2584, 71, -1000, -1000, -1000,
+	-1000, -1000, 36, 992, 36, 781, -1000, 780, -1000, -1000,
+	-192, -1000, -1000, 1348, 1421, -1000, -1000, 1387, -1000, -1000,
+	-1000, 29100, 29100, -1000, -1000, 245, -1000, 324, 1153, -1000,
+	-151, -1000, -1000, 1701, 29100, -1000, -1000, -1000, -1000, -139,
+	983, 29100, 399, -1000, 623, -1000, 1298, -1000, 604, -1000,
+	-1000, 1372, 29100, 29100, 1440, 340, 340, 29100, -1000, -1000,
+	-1000, -1000, 1450, 734, -1000, -1000, -1000, -1000, -1000, 2584,
+	-1000, 1620, -1000, -1000, 219, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 14667, 14667, 14667, 14667, 14667, 1723, 971,
+	873, 14667, 14667, 19643, 29100, 29100, 17830, 36, 9, -1000,
+	13317, 13317, 1631, -1000, 1331, -1000, 1330, 29100, 1331, 29100,
+	-1000, 1723, -1000, 873, 873, 29100, 873, 1723, -1000, -1000,
+	547, -1000, 547, 1142, 1140, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1681, 1283, -1000, 243, 29100, -1000, 255,
+	-1000, -153, -158, 1386, 1139, -1000, -1000, -1000, -1000, 29100,
+	8312, 5992, 29100, 1123, 1679, 1116, 1437, 29100, 1460, -1000,
+	-1000, -1000, 1371, -1000, -1000, -1000, -1000, 1326, 1326, 1326,
+	1326, 79, 1135, -1000, 1326, 1326, 1104, -1000, 1104, 1104,
+	491, -244, -1000, 1582, 1579, 873, 1244, 1772, -1000, 1331,
+	1781, 490, 1207, -1000, -1000, 1095, -1000, -1000, -1000, -1000,
+	-1000, 1386, 1331, 1351, -1000, -1000, -1000, 196, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1078, 1677, 1431, 1331, 8312,
+	-1000, 980, -1000, -1000, -1000, 29100, -1000, -1000, -1000, -1000,
+	1135, 159, -141, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	9, 277, -1000, 1540, 1538, 1736, 29100, 1207, 29100, -1000,
+	196, 13767, 29100, -1000, -37, 1426, 1331, 980, 13317, 1429,
+	-1000, -121, 1075, -1000, 1518, -117, -145, 1544, 1564, 1564,
+	1579, 1735, 1576, 1574, -1000, 964, 1201, -1000, -1000, 1326,
+	1135, 1070, 346, -1000, -1000, -139, 13317, -139, 1121, 980,
+	8312, 269, -1000, 1517, -1000, 1542, 813, -1000, -1000, -1000,
+	-1000, 957, -1000, 1734, 1726, -1000, -1000, -1000, 1448, 144,
+	-1000, 1121, -1000, 1133, -137, -1000, 1300, -138, -1000, 808,
+	-1000, -1000, -1000, 951, 948, 1447, -1000, 1759, -1000, 1117,
+	1427, 8312, 29100, -143, -1000, -1000, -1000, -1000, -1000, 1771,
+	476, 476, 1426, 980, -1000, 1047, -146, -1000, -1000, -1000,
+	343, 836, -1000, -139, -139, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1788, 1787, 14, 82, 79, 1786, 1785, 1783, 1782,
-	130, 125, 122, 1781, 1779, 1777, 1775, 1774, 1773, 1772,
-	1770, 1769, 1766, 1765, 1764, 62, 120, 38, 40, 124,
-	1763, 1762, 45, 1761, 1760, 1759, 127, 126, 470, 1758,
-	128, 1757, 1755, 1754, 1753, 1748, 1747, 1746, 1744, 1743,
-	1742, 1741, 1740, 1737, 151, 1736, 1735, 9, 1734, 47,
-	1733, 1730, 1725, 1720, 1719, 84, 1718, 1715, 1714, 111,
-	1711, 1710, 44, 336, 49, 72, 1709, 1705, 80, 770,
-	1704, 100, 119, 1703, 908, 1702, 39, 97, 85, 1701,
-	41, 1700, 1698, 102, 1697, 1694, 1693, 68, 1691, 1690,
-	3134, 1689, 67, 75, 19, 32, 1686, 1683, 1682, 1680,
-	33, 162, 1679, 1677, 28, 1676, 1675, 146, 1674, 81,
-	27, 1673, 16, 18, 21, 1671, 83, 1668, 8, 50,
-	31, 1667, 77, 1666, 1664, 1662, 1660, 25, 1645, 73,
-	101, 22, 1644, 1643, 4, 11, 1642, 1641, 1640, 1638,
-	1636, 1635, 10, 1634, 1633, 1631, 56, 1630, 6, 24,
-	71, 116, 29, 7, 1629, 143, 1626, 26, 114, 65,
-	108, 1624, 1623, 1622, 849, 55, 134, 1621, 1620, 46,
-	1619, 118, 117, 1617, 1362, 1616, 1615, 78, 1271, 2560,
-	13, 109, 1614, 1611, 1627, 52, 74, 23, 1610, 1609,
-	1608, 121, 112, 63, 863, 42, 1606, 1605, 1604, 1603,
-	1602, 1597, 1596, 87, 34, 20, 105, 30, 1595, 1594,
-	1593, 66, 37, 1592, 107, 106, 70, 86, 1591, 115,
-	96, 57, 1590, 60, 1589, 1588, 1581, 1579, 43, 1576,
-	1574, 1573, 1570, 104, 88, 64, 35, 1569, 36, 91,
-	99, 89, 1567, 17, 123, 12, 1565, 3, 0, 1557,
-	5, 138, 1368, 113, 1555, 1553, 1, 1549, 2, 1547,
-	1546, 76, 1544, 1543, 1542, 1541, 3253, 1439, 110, 1540,
-	139,
+	0, 2050, 2049, 30, 86, 80, 2035, 2034, 2033, 2031,
+	144, 143, 142, 2030, 2028, 141, 135, 134, 133, 2027,
+	2026, 2025, 2024, 2021, 2020, 71, 125, 34, 37, 130,
+	2019, 2018, 44, 2017, 2016, 2015, 128, 126, 561, 2013,
+	122, 2011, 2010, 2009, 2008, 2005, 2004, 2003, 2001, 2000,
+	1999, 1998, 1997, 1996, 1995, 139, 1994, 1992, 11, 1990,
+	51, 1986, 1985, 1984, 1983, 1982, 1980, 1979, 91, 1978,
+	1977, 1976, 116, 1975, 1973, 46, 115, 50, 73, 1972,
+	1971, 89, 884, 1970, 95, 127, 1969, 1258, 1966, 40,
+	77, 74, 1965, 39, 1964, 1963, 90, 1962, 1961, 1960,
+	72, 1959, 1958, 3812, 1956, 68, 1951, 82, 17, 38,
+	1949, 19, 1948, 1944, 42, 2335, 1942, 1941, 27, 1922,
+	1913, 140, 1911, 87, 15, 1909, 14, 57, 20, 1906,
+	85, 1904, 8, 56, 36, 1903, 84, 1902, 1901, 1900,
+	1899, 45, 1898, 75, 110, 22, 1896, 1894, 5, 13,
+	1893, 1892, 1891, 1889, 1887, 1886, 10, 1885, 1884, 1883,
+	26, 1882, 25, 21, 67, 35, 29, 12, 1881, 138,
+	1879, 28, 113, 65, 111, 1878, 1876, 1875, 910, 66,
+	149, 1870, 1868, 53, 1866, 121, 124, 1865, 1608, 1864,
+	1863, 61, 1114, 1859, 9, 119, 1862, 1861, 2532, 69,
+	78, 18, 1860, 1855, 1853, 131, 123, 47, 891, 41,
+	1852, 1848, 1847, 1846, 1845, 1844, 1843, 136, 24, 100,
+	112, 32, 1842, 1840, 1839, 23, 1838, 1837, 59, 76,
+	1836, 109, 108, 64, 114, 1835, 117, 106, 58, 1833,
+	105, 1832, 1831, 1829, 1828, 43, 1827, 1826, 1825, 1824,
+	99, 88, 48, 63, 1823, 33, 104, 94, 107, 1822,
+	16, 129, 4, 1821, 6, 1820, 0, 3, 7, 137,
+	1595, 103, 1818, 1816, 1, 1815, 2, 1814, 1813, 83,
+	1812, 1809, 1808, 1807, 3274, 1484, 118, 1806, 1805, 1804,
+	120, 92, 1801, 1800, 1798, 1796, 1793, 1792, 1790, 1789,
+	1787, 132,
 }
 
 var yyR1 = [...]int{
-	0, 274, 275, 275, 1, 1, 1, 1, 1, 1,
+	0, 282, 283, 283, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 258, 258, 258, 261, 261, 21,
-	50, 3, 3, 3, 3, 2, 2, 8, 9, 4,
-	5, 5, 10, 10, 61, 61, 11, 12, 12, 12,
-	12, 278, 278, 95, 95, 93, 93, 94, 94, 160,
-	160, 13, 14, 14, 170, 170, 169, 169, 169, 171,
-	171, 171, 171, 204, 204, 15, 15, 15, 15, 15,
-	70, 70, 260, 260, 259, 257, 257, 256, 256, 255,
-	23, 24, 33, 33, 33, 33, 34, 35, 262, 262,
-	234, 39, 39, 38, 38, 38, 38, 40, 40, 37,
-	37, 36, 36, 236, 236, 223, 223, 235, 235, 235,
-	235, 235, 235, 235, 222, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 206, 206, 206, 206,
-	209, 209, 207, 207, 207, 207, 207, 207, 207, 207,
-	207, 208, 208, 208, 208, 208, 210, 210, 210, 210,
-	210, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 211, 211, 211, 212, 212, 212, 212,
-	212, 212, 212, 212, 221, 221, 213, 213, 216, 216,
-	217, 217, 217, 218, 218, 219, 219, 214, 214, 214,
-	215, 215, 215, 224, 248, 248, 247, 247, 245, 245,
-	245, 245, 233, 233, 242, 242, 242, 242, 242, 232,
-	232, 228, 228, 228, 229, 229, 230, 230, 227, 227,
-	231, 231, 244, 244, 243, 225, 225, 226, 226, 250,
-	250, 250, 250, 251, 267, 268, 266, 266, 266, 266,
-	266, 59, 59, 59, 183, 183, 183, 240, 240, 239,
-	239, 239, 241, 241, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 178, 178, 178, 265, 265, 265, 265,
-	265, 265, 264, 264, 264, 237, 237, 237, 263, 263,
-	129, 129, 130, 130, 30, 30, 30, 30, 30, 30,
-	29, 29, 29, 25, 25, 25, 25, 25, 25, 25,
+	1, 1, 1, 1, 1, 266, 266, 266, 269, 269,
+	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
+	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
+	12, 12, 286, 286, 98, 98, 96, 96, 97, 97,
+	164, 164, 13, 14, 14, 174, 174, 173, 173, 173,
+	175, 175, 175, 175, 208, 208, 15, 15, 15, 15,
+	15, 73, 73, 268, 268, 267, 264, 264, 263, 263,
+	262, 265, 265, 265, 265, 265, 225, 225, 226, 226,
+	106, 106, 23, 24, 33, 33, 33, 33, 34, 35,
+	270, 270, 241, 39, 39, 38, 38, 38, 38, 40,
+	40, 37, 37, 36, 36, 243, 243, 230, 230, 242,
+	242, 242, 242, 242, 242, 242, 229, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 210, 210,
+	210, 210, 213, 213, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 212, 212, 212, 212, 212, 214, 214,
+	214, 214, 214, 215, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 216, 216,
+	216, 216, 216, 216, 216, 216, 228, 228, 217, 217,
+	220, 220, 221, 221, 221, 222, 222, 223, 223, 218,
+	218, 218, 219, 219, 219, 231, 255, 255, 254, 254,
+	252, 252, 252, 252, 240, 240, 249, 249, 249, 249,
+	249, 239, 239, 235, 235, 235, 236, 236, 237, 237,
+	234, 234, 238, 238, 251, 251, 250, 232, 232, 233,
+	233, 257, 257, 257, 257, 258, 275, 276, 274, 274,
+	274, 274, 274, 60, 60, 60, 187, 187, 187, 247,
+	247, 246, 246, 246, 248, 248, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 182, 182, 182, 273, 273,
+	273, 273, 273, 273, 272, 272, 272, 244, 244, 244,
+	271, 271, 133, 133, 134, 134, 30, 30, 30, 30,
+	30, 30, 29, 29, 29, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 31, 31, 26, 26, 26,
-	26, 26, 26, 26, 26, 26, 16, 16, 16, 16,
+	25, 25, 25, 25, 25, 25, 25, 31, 31, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 220, 220, 220, 252, 252, 253, 253,
-	17, 22, 22, 18, 18, 18, 18, 19, 19, 41,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 224, 224, 224, 259, 259, 260,
+	260, 17, 22, 22, 18, 18, 18, 18, 19, 19,
+	41, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 269, 269, 177, 177, 185, 185, 176,
-	176, 175, 175, 175, 179, 179, 179, 180, 180, 273,
-	273, 273, 43, 43, 45, 45, 46, 47, 47, 199,
-	199, 200, 200, 48, 49, 60, 60, 60, 60, 60,
-	60, 62, 62, 62, 7, 7, 7, 7, 56, 56,
-	56, 6, 6, 44, 44, 51, 270, 270, 271, 272,
-	272, 272, 272, 52, 20, 20, 20, 20, 20, 20,
-	77, 77, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 71, 71, 71, 66, 66, 279,
-	54, 55, 55, 69, 69, 69, 63, 63, 63, 68,
-	68, 68, 74, 74, 76, 76, 76, 76, 76, 78,
-	78, 78, 78, 78, 78, 73, 73, 75, 75, 75,
-	75, 192, 192, 192, 191, 191, 85, 85, 86, 86,
-	87, 87, 88, 88, 88, 127, 103, 103, 159, 159,
-	158, 158, 161, 161, 89, 89, 89, 89, 90, 90,
-	91, 91, 92, 92, 198, 198, 197, 197, 197, 196,
-	196, 96, 96, 96, 98, 97, 97, 97, 97, 99,
-	99, 101, 101, 100, 100, 102, 104, 104, 104, 104,
-	104, 105, 105, 84, 84, 84, 84, 84, 84, 84,
-	84, 173, 173, 107, 107, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 118, 118, 118, 118, 118,
-	118, 108, 108, 108, 108, 108, 108, 108, 72, 72,
-	119, 119, 119, 126, 120, 120, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	115, 115, 115, 115, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 280, 280, 117, 116, 116, 116, 116,
-	116, 116, 116, 67, 67, 67, 67, 67, 203, 203,
-	203, 205, 205, 205, 205, 205, 205, 205, 205, 205,
-	205, 205, 205, 205, 133, 133, 64, 64, 131, 131,
-	132, 134, 134, 128, 128, 128, 110, 110, 110, 110,
-	110, 110, 110, 110, 112, 112, 112, 135, 135, 136,
-	136, 137, 137, 138, 138, 139, 140, 140, 140, 141,
-	141, 141, 141, 32, 32, 32, 32, 32, 27, 27,
-	27, 27, 28, 28, 28, 79, 79, 79, 79, 81,
-	81, 80, 80, 57, 57, 58, 58, 58, 82, 82,
-	83, 83, 83, 83, 156, 156, 156, 142, 142, 142,
-	142, 148, 148, 148, 144, 144, 146, 146, 146, 147,
-	147, 147, 145, 151, 151, 153, 153, 152, 152, 150,
-	150, 155, 155, 154, 154, 149, 149, 109, 109, 109,
-	109, 109, 157, 157, 157, 157, 162, 162, 122, 122,
-	124, 124, 123, 125, 163, 163, 167, 164, 164, 168,
-	168, 168, 168, 168, 165, 165, 166, 166, 193, 193,
-	193, 172, 172, 184, 184, 181, 181, 182, 182, 174,
-	174, 186, 186, 186, 53, 121, 121, 249, 249, 246,
-	189, 189, 190, 190, 194, 194, 195, 195, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 276, 277, 201, 202,
-	202, 202,
+	42, 277, 277, 181, 181, 189, 189, 180, 180, 179,
+	179, 179, 183, 183, 183, 184, 184, 281, 281, 281,
+	43, 43, 45, 45, 46, 47, 47, 203, 203, 204,
+	204, 48, 49, 61, 61, 61, 61, 61, 61, 63,
+	63, 63, 7, 7, 7, 7, 7, 7, 7, 7,
+	57, 57, 57, 6, 6, 6, 6, 6, 6, 6,
+	298, 288, 65, 289, 291, 292, 295, 290, 293, 287,
+	296, 64, 297, 294, 227, 227, 54, 54, 299, 44,
+	44, 51, 278, 278, 279, 280, 280, 280, 280, 52,
+	20, 20, 20, 20, 20, 20, 80, 80, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	74, 74, 74, 69, 69, 300, 55, 56, 56, 72,
+	72, 72, 66, 66, 66, 71, 71, 71, 77, 77,
+	79, 79, 79, 79, 79, 81, 81, 81, 81, 81,
+	81, 76, 76, 78, 78, 78, 78, 196, 196, 196,
+	195, 195, 88, 88, 89, 89, 90, 90, 91, 91,
+	91, 131, 107, 107, 163, 163, 162, 162, 165, 165,
+	92, 92, 92, 92, 93, 93, 94, 94, 95, 95,
+	202, 202, 201, 201, 201, 200, 200, 99, 99, 99,
+	101, 100, 100, 100, 100, 102, 102, 104, 104, 103,
+	103, 105, 108, 108, 108, 108, 108, 109, 109, 87,
+	87, 87, 87, 87, 87, 87, 87, 177, 177, 111,
+	111, 110, 110, 110, 110, 110, 110, 110, 110, 110,
+	110, 122, 122, 122, 122, 122, 122, 112, 112, 112,
+	112, 112, 112, 112, 75, 75, 123, 123, 123, 130,
+	124, 124, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 119, 119, 119, 119,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 301,
+	301, 121, 120, 120, 120, 120, 120, 120, 120, 70,
+	70, 70, 70, 70, 207, 207, 207, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	137, 137, 67, 67, 135, 135, 136, 138, 138, 132,
+	132, 132, 114, 114, 114, 114, 114, 114, 114, 114,
+	116, 116, 116, 139, 139, 140, 140, 141, 141, 142,
+	142, 143, 144, 144, 144, 145, 145, 145, 145, 32,
+	32, 32, 32, 32, 27, 27, 27, 27, 28, 28,
+	28, 82, 82, 82, 82, 84, 84, 83, 83, 58,
+	58, 59, 59, 59, 85, 85, 86, 86, 86, 86,
+	160, 160, 160, 146, 146, 146, 146, 152, 152, 152,
+	148, 148, 150, 150, 150, 151, 151, 151, 149, 155,
+	155, 157, 157, 156, 156, 154, 154, 159, 159, 158,
+	158, 153, 153, 113, 113, 113, 113, 113, 161, 161,
+	161, 161, 166, 166, 126, 126, 128, 128, 127, 129,
+	167, 167, 171, 168, 168, 172, 172, 172, 172, 172,
+	169, 169, 170, 170, 197, 197, 197, 176, 176, 188,
+	188, 185, 185, 186, 186, 178, 178, 190, 190, 190,
+	53, 125, 125, 256, 256, 253, 193, 193, 194, 194,
+	198, 198, 199, 199, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
+	192, 192, 192, 284, 285, 205, 206, 206, 206,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 0, 1, 2,
-	3, 5, 6, 6, 7, 4, 6, 5, 7, 8,
-	1, 3, 7, 8, 1, 1, 9, 9, 8, 7,
-	7, 1, 1, 1, 3, 1, 3, 1, 3, 0,
-	4, 3, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 6, 11, 2,
-	0, 2, 0, 2, 1, 0, 2, 1, 3, 3,
-	5, 3, 6, 7, 7, 7, 4, 2, 1, 1,
-	4, 0, 1, 1, 1, 2, 2, 0, 1, 4,
-	4, 4, 4, 2, 4, 1, 3, 1, 1, 3,
-	4, 3, 3, 3, 3, 0, 2, 3, 3, 4,
-	2, 3, 3, 2, 3, 2, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 4, 4, 2, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 1, 0, 1, 0, 2, 2,
-	0, 2, 2, 5, 0, 1, 1, 2, 1, 3,
-	2, 3, 0, 1, 3, 3, 3, 4, 2, 0,
-	2, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	0, 1, 1, 3, 3, 3, 1, 3, 1, 10,
-	11, 11, 12, 5, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 1, 0, 1, 2, 0, 1, 1,
-	3, 2, 1, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
+	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
+	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
+	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
+	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 1, 1, 2, 2, 6, 11,
+	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
+	3, 3, 5, 5, 7, 3, 0, 1, 0, 1,
+	0, 3, 5, 3, 6, 7, 7, 7, 4, 2,
+	1, 1, 4, 0, 1, 1, 1, 2, 2, 0,
+	1, 4, 4, 4, 4, 2, 4, 1, 3, 1,
+	1, 3, 4, 3, 3, 3, 3, 0, 2, 3,
+	3, 4, 2, 3, 3, 2, 3, 2, 3, 1,
+	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2, 1, 2,
+	2, 2, 2, 4, 4, 2, 2, 3, 3, 3,
+	3, 1, 1, 1, 1, 1, 6, 6, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 0, 3,
+	0, 5, 0, 3, 5, 0, 1, 0, 1, 0,
+	2, 2, 0, 2, 2, 5, 0, 1, 1, 2,
+	1, 3, 2, 3, 0, 1, 3, 3, 3, 4,
+	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
+	1, 10, 11, 11, 12, 5, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 1, 0, 1, 2, 0,
+	1, 1, 3, 2, 1, 2, 3, 3, 4, 4,
+	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	0, 2, 0, 2, 0, 1, 5, 1, 3, 7,
-	1, 3, 3, 1, 2, 2, 2, 5, 5, 5,
-	6, 6, 5, 5, 2, 2, 2, 2, 3, 3,
-	3, 4, 1, 3, 5, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 4, 4, 2,
-	10, 3, 6, 7, 5, 5, 5, 12, 7, 5,
-	9, 5, 3, 7, 4, 4, 4, 4, 3, 3,
-	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 0, 2, 2, 1, 3, 8, 8,
-	3, 3, 5, 6, 6, 5, 4, 3, 2, 3,
-	3, 3, 7, 3, 3, 3, 3, 4, 7, 5,
-	2, 4, 4, 4, 4, 4, 5, 5, 4, 4,
-	4, 4, 4, 4, 4, 4, 4, 4, 4, 2,
-	4, 2, 4, 5, 4, 3, 3, 5, 2, 3,
-	3, 3, 3, 1, 1, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
-	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
-	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 3, 3, 2, 2, 3, 1, 3, 2, 1,
-	2, 1, 2, 2, 3, 3, 6, 4, 7, 6,
-	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
-	1, 1, 1, 3, 3, 3, 3, 7, 0, 3,
-	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
-	5, 0, 2, 1, 3, 3, 3, 2, 3, 1,
-	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
-	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 5, 6, 4, 4, 6, 6, 6, 8,
-	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 2, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 0, 3, 3, 3, 0,
-	3, 1, 1, 0, 4, 0, 1, 1, 0, 3,
-	1, 3, 2, 1, 0, 2, 4, 0, 9, 3,
-	5, 0, 3, 3, 0, 1, 0, 2, 2, 0,
-	2, 2, 2, 0, 3, 0, 3, 0, 3, 0,
-	4, 0, 3, 0, 4, 0, 1, 2, 1, 5,
-	4, 4, 1, 3, 3, 5, 0, 5, 1, 3,
-	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
-	3, 3, 3, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 0, 2, 0, 3, 0,
-	1, 0, 1, 1, 5, 0, 1, 0, 1, 2,
+	3, 3, 3, 4, 5, 0, 2, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 0, 2, 0, 2, 0, 1, 5, 1,
+	3, 7, 1, 3, 3, 1, 2, 2, 2, 5,
+	5, 5, 6, 6, 5, 5, 2, 2, 2, 2,
+	3, 3, 3, 4, 1, 3, 5, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 4,
+	4, 2, 10, 3, 6, 7, 8, 5, 5, 5,
+	7, 7, 8, 4, 6, 8, 6, 7, 12, 12,
+	16, 16, 9, 9, 8, 8, 7, 7, 6, 9,
+	15, 8, 5, 3, 7, 4, 4, 4, 4, 3,
+	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
+	8, 3, 3, 5, 6, 6, 5, 4, 3, 2,
+	3, 3, 3, 7, 3, 3, 3, 3, 4, 7,
+	5, 2, 4, 4, 4, 4, 4, 5, 5, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	2, 4, 2, 4, 5, 4, 3, 6, 4, 5,
+	4, 3, 5, 4, 4, 5, 2, 3, 3, 3,
+	3, 1, 1, 0, 1, 0, 1, 1, 1, 0,
+	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
+	2, 1, 1, 2, 1, 1, 5, 0, 1, 0,
+	1, 2, 3, 0, 3, 3, 3, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 3, 5, 3, 4, 6, 5, 6,
+	2, 1, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 2, 1, 1, 2, 4, 1, 2,
+	2, 3, 1, 3, 2, 1, 2, 1, 2, 2,
+	3, 3, 6, 4, 7, 6, 1, 3, 2, 2,
+	2, 2, 1, 1, 1, 3, 2, 1, 1, 1,
+	0, 1, 1, 0, 3, 0, 2, 0, 2, 1,
+	2, 2, 0, 1, 1, 0, 1, 1, 0, 1,
+	0, 1, 2, 3, 4, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
+	1, 1, 0, 2, 1, 3, 1, 1, 1, 3,
+	3, 3, 3, 7, 0, 3, 1, 3, 1, 3,
+	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
+	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
+	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
+	3, 3, 0, 5, 4, 5, 5, 0, 2, 1,
+	3, 3, 3, 2, 3, 1, 2, 0, 3, 1,
+	1, 3, 3, 4, 4, 5, 3, 4, 5, 6,
+	2, 1, 2, 1, 2, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 0, 2, 1, 1, 1, 3,
+	1, 3, 1, 1, 1, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 3, 1, 1, 1, 1, 4, 5, 5, 6,
+	4, 4, 6, 6, 6, 8, 8, 8, 8, 9,
+	8, 5, 4, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 8, 8, 0,
+	2, 3, 4, 4, 4, 4, 4, 4, 4, 0,
+	3, 4, 7, 3, 1, 1, 1, 2, 3, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 2, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 0, 3, 3, 3, 0, 3, 1, 1, 0,
+	4, 0, 1, 1, 0, 3, 1, 3, 2, 1,
+	0, 2, 4, 0, 9, 3, 5, 0, 3, 3,
+	0, 1, 0, 2, 2, 0, 2, 2, 2, 0,
+	3, 0, 3, 0, 3, 0, 4, 0, 3, 0,
+	4, 0, 1, 2, 1, 5, 4, 4, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 0, 2, 0, 3, 0, 1, 0, 1, 1,
+	5, 0, 1, 0, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4511,460 +4737,494 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -274, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -282, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
 	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -4, -276, 6, 7, 8, -61, 10, 11,
-	31, -23, -33, 153, -34, -24, 154, -35, 156, 155,
-	191, 157, 184, 71, 226, 227, 229, 230, 231, 232,
-	-62, 189, 190, 159, 35, 42, 32, 33, 36, 81,
-	9, 328, 186, 185, 26, -275, 468, -69, 5, -137,
-	16, -3, -54, -279, -54, -54, -54, -54, -54, -54,
-	-234, -236, 81, 126, 81, -70, -184, 164, 173, 172,
-	169, -262, 107, 219, 319, 162, -39, -38, -37, -36,
-	-40, 30, -30, -31, -254, -29, -26, 158, 155, 199,
-	102, 103, 191, 192, 193, 157, 175, 190, 194, 189,
-	208, -25, 77, 32, 341, 344, -241, 154, 160, 161,
-	329, 105, 104, 72, 156, -238, 276, 445, -40, 447,
-	95, 97, 446, 41, 164, 448, 449, 450, 451, 174,
-	452, 453, 454, 455, 461, 462, 463, 464, 106, 5,
-	163, -262, -79, 286, 77, -261, -258, 84, 85, 86,
-	163, -184, 164, 165, -262, 163, -100, -194, -258, -188,
-	338, 177, 372, 373, 224, 77, 276, 445, 226, 240,
-	234, 261, 253, 339, 374, 178, 212, 442, 251, 254,
-	306, 447, 375, 192, 298, 281, 289, 95, 229, 315,
-	460, 376, 458, 97, 446, 76, 48, 41, 187, 249,
-	245, 448, 213, 377, 349, 206, 105, 102, 467, 243,
-	47, 28, 457, 104, 46, 449, 378, 450, 291, 266,
-	436, 45, 303, 193, 379, 80, 343, 444, 293, 244,
-	290, 223, 456, 159, 380, 428, 300, 437, 381, 267,
-	271, 382, 307, 49, 383, 384, 438, 103, 385, 75,
-	451, 238, 239, 386, 221, 176, 309, 265, 174, 34,
-	294, 340, 225, 55, 200, 310, 43, 269, 42, 432,
-	387, 435, 264, 260, 50, 388, 389, 390, 391, 452,
-	263, 237, 259, 466, 218, 453, 59, 161, 273, 272,
-	274, 207, 305, 256, 392, 393, 394, 181, 78, 395,
-	246, 19, 396, 397, 301, 214, 398, 53, 399, 400,
-	313, 190, 401, 51, 454, 38, 195, 455, 402, 403,
-	404, 405, 406, 299, 407, 292, 268, 270, 202, 288,
-	342, 408, 242, 194, 459, 409, 182, 443, 196, 199,
-	189, 314, 183, 410, 411, 412, 413, 414, 415, 416,
-	230, 461, 40, 417, 418, 419, 420, 222, 217, 308,
-	317, 58, 79, 278, 421, 441, 236, 215, 422, 227,
-	52, 462, 463, 464, 209, 465, 284, 106, 219, 220,
-	44, 257, 201, 423, 424, 247, 248, 262, 235, 258,
-	228, 429, 203, 302, 191, 425, 316, 216, 279, 346,
-	208, 304, 440, 345, 255, 252, 210, 426, 165, 204,
-	205, 427, 430, 295, 285, 296, 297, 286, 211, 344,
-	250, 280, 163, -165, 281, -185, 282, 283, 294, 295,
-	300, -177, 301, 299, 202, -273, 307, 163, 302, 153,
-	144, 291, 292, 285, 286, 211, -269, -258, 450, 465,
-	306, 254, 287, 293, 308, 432, 297, 296, -194, 228,
-	-199, 233, -189, -258, -188, 231, -100, -60, 428, 157,
-	-201, -201, -71, 432, 434, -120, -84, -106, 110, -111,
-	30, 24, -110, -107, -128, -125, -126, 144, 145, 147,
-	146, 148, 133, 134, 141, 111, 149, -115, -113, -114,
-	-116, 88, 87, 96, 89, 90, 91, 92, 98, 99,
-	100, -189, -194, -123, -276, 65, 66, 329, 330, 331,
-	332, 337, 333, 113, 54, 318, 327, 326, 325, 322,
-	323, 320, 321, 335, 336, 168, 319, 162, 139, 328,
-	-258, -188, 41, 284, 284, -100, -5, -4, -276, 6,
-	21, 22, -141, 18, 17, -277, 83, -63, -76, 60,
-	61, -78, 22, 37, 64, 62, -55, -75, 135, -84,
-	-194, -75, -174, 167, -174, -174, -164, -204, 228, -168,
-	308, 307, -190, -166, -189, -187, -165, 305, 158, 347,
-	109, 23, 25, 112, 144, 17, 113, 36, 160, 175,
-	143, 171, 329, 153, 69, 348, 320, 321, 318, 324,
-	331, 332, 319, 282, 30, 11, 350, 26, 185, 22,
-	37, 137, 155, 116, 117, 188, 24, 186, 100, 353,
-	20, 72, 180, 12, 173, 14, 354, 355, 15, 168,
-	167, 128, 164, 67, 9, 149, 27, 125, 63, 356,
-	29, 357, 358, 359, 360, 65, 126, 18, 322, 323,
-	32, 433, 361, 337, 197, 139, 70, 56, 434, 110,
-	362, 363, 98, 364, 101, 73, 439, 107, 16, 68,
-	39, 365, 198, 366, 170, 367, 311, 368, 127, 156,
-	328, 66, 369, 162, 283, 6, 334, 31, 184, 172,
-	64, 370, 163, 115, 335, 336, 166, 99, 5, 169,
-	33, 10, 71, 74, 325, 326, 327, 54, 341, 114,
-	13, 371, 312, 108, 306, 254, -235, 126, -222, -226,
-	-189, 179, -251, 175, -100, -244, -243, -189, -79, 163,
-	-258, 164, 164, 164, -182, 168, 328, -36, -37, -165,
-	143, 196, 82, 82, -226, -225, -224, -263, 198, 179,
-	-250, -242, 171, 180, -232, 172, 173, -227, 164, 29,
-	-263, -227, 170, 180, 198, 198, 106, 198, 106, 198,
-	198, 198, 198, 198, 198, 198, 198, 198, 195, -233,
-	118, -233, 345, 345, -238, -263, -263, -263, 166, 34,
-	34, -186, -227, 166, 23, -233, -233, -165, 143, -233,
-	-233, -233, -233, 206, 206, -233, -233, -233, -233, -233,
-	-233, -233, -233, -233, -233, -233, -233, -233, -233, -233,
-	-100, -82, 213, 153, 155, 158, 73, 118, -38, 208,
-	-22, -100, 163, -258, -181, 168, -181, -100, 150, -100,
-	-179, 126, 13, -179, -176, 284, 288, 289, 290, -179,
-	-179, -179, -179, 209, 298, -228, 164, 34, 176, 284,
-	209, 298, 209, 210, 209, 210, 209, -175, 12, 128,
-	319, 303, 300, 202, 163, 203, 165, 304, -258, 435,
-	210, 284, 205, -179, -202, -276, -190, -202, -202, 31,
-	166, -189, -56, -189, 88, -7, -3, -11, -10, -12,
-	118, -77, 284, -65, 144, 450, 436, 437, 438, 435,
-	299, 443, 441, 439, 209, 440, 82, 109, 107, 108,
-	125, -84, -108, 128, 110, 126, 127, 112, 130, 129,
-	140, 133, 134, 135, 136, 137, 138, 139, 131, 132,
-	143, 118, 119, 120, 121, 122, 123, 124, -173, -276,
-	-126, -276, 151, 152, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -276, 150, -2, -120, -4, -276,
-	-276, -276, -276, -276, -276, -276, -276, -133, -84, -276,
-	-280, -117, -276, -280, -117, -280, -117, -280, -276, -280,
-	-117, -280, -117, -280, -280, -117, -276, -276, -276, -276,
-	-276, -276, -276, -201, -270, -271, -103, -100, -276, -137,
-	-3, -54, -156, 20, 32, -84, -138, -139, -84, -137,
-	56, -73, -75, -78, 60, 61, 94, 12, -192, -191,
-	23, -189, 88, 150, 12, -101, 27, -100, -86, -87,
-	-88, -89, -103, -127, -276, 12, -93, -94, -100, -102,
-	-194, 82, 228, -168, -204, -170, -169, 309, 311, 118,
-	-193, -189, 88, 30, 83, 82, -100, -206, -209, -211,
-	-210, -212, -207, -208, 251, 252, 144, 255, 257, 258,
-	259, 260, 261, 262, 263, 264, 265, 266, 31, 187,
-	247, 248, 249, 250, 267, 268, 269, 270, 271, 272,
-	273, 274, 234, 253, 339, 235, 236, 237, 238, 239,
-	240, 242, 243, 244, 245, 246, -261, -258, 81, 83,
-	82, -213, 81, -82, -182, -249, -246, 74, -258, -258,
-	-258, -258, 110, -233, -233, 195, -29, -26, -254, 16,
-	-25, -26, 158, 102, 103, 155, 81, -222, 81, -231,
-	-261, -258, 81, 29, 170, 169, -230, -227, -230, -231,
-	-258, -128, -189, -194, -258, 29, 29, -161, -189, -161,
-	-161, 21, -161, 21, -161, 21, 89, -189, -161, 21,
-	-161, 21, -161, 21, -161, 21, -161, 21, 30, 75,
-	76, 30, 78, 79, 80, -128, -128, -222, -165, -100,
-	-258, 89, 89, -233, -233, 89, 88, 88, 88, -233,
-	-233, 89, 88, -258, 88, -264, 181, 223, 225, 89,
-	89, 89, 89, 30, 88, -265, 30, 457, 456, 458,
-	459, 460, 89, 30, 89, 30, 89, -189, 81, -81,
-	215, 118, 204, 204, 163, 163, 217, -100, 216, 218,
-	220, 41, 82, 166, -181, 73, -95, -100, 24, -258,
-	-195, -194, -187, 88, -84, -229, 12, 128, -175, -175,
-	-179, -100, -229, -175, -179, -100, -179, -179, -179, -179,
-	-175, -179, -194, -194, -100, -100, -100, -100, -100, -100,
-	-100, -202, -202, -202, -180, 126, 73, -200, 231, 265,
-	429, 430, 431, 82, 341, -93, 435, 435, 435, 435,
-	435, 435, -84, -84, -84, -84, -118, 98, 110, 99,
-	100, -111, -119, -123, -126, 93, 128, 126, 127, 112,
-	-111, -111, -111, -111, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -111, -203, -258, 88, 144, -258,
-	-110, -110, -189, -74, 22, 37, -73, -190, -195, -187,
-	-69, -277, -277, -137, -73, -73, -84, -84, -128, 88,
-	-73, -128, 88, -73, -73, -68, 22, 37, -131, -132,
-	114, -128, -277, -111, -189, -189, -73, -74, -74, -73,
-	-73, 82, -272, 311, 312, 433, -197, 198, -196, 23,
-	-194, 88, -121, -120, -141, -277, -142, 27, 10, 128,
-	82, 19, 82, -140, 25, 26, -141, -112, -189, 89,
-	92, -85, 82, 12, -78, -100, -191, 135, -195, -100,
-	-160, 198, -100, 31, 82, -96, -98, -97, -99, 63,
-	67, 69, 64, 65, 66, 70, -198, 23, -86, -3,
-	-276, -100, -93, -278, 82, 12, 74, -278, 82, 150,
-	-168, -170, 82, 310, 312, 313, 73, 101, -84, -215,
-	143, -240, -239, -238, -222, -224, -225, -226, 83, -143,
-	-218, 279, -213, -213, -213, -213, -213, -214, -165, -214,
-	-214, -214, 81, 81, -213, -213, -213, -213, -216, 81,
-	-216, -216, -217, 81, -217, -251, -84, -248, -247, -245,
-	-246, 174, 95, 341, -243, -140, 89, -81, -100, 73,
-	-189, -249, -249, -249, 24, -258, 88, -258, 88, 82,
-	17, -223, -222, -129, 223, -253, 198, -250, -244, 81,
-	29, -230, -231, -231, 150, -258, 82, 27, 106, 106,
-	106, 106, 341, 155, 31, -222, -129, -203, 166, -203,
-	-203, 88, 88, -178, 465, -93, 165, 222, -83, 324,
-	88, 84, -100, -100, -100, -100, -100, 158, 155, 206,
-	-100, -100, -93, -100, 82, -59, 183, 178, -100, -179,
-	-179, -100, -179, -179, 88, -100, -189, -65, 311, 341,
-	20, -66, 20, 98, 99, 100, -119, -111, -111, -111,
-	-72, 188, 109, -277, -277, -73, -73, -276, 150, -5,
-	-141, -277, -277, 82, 74, 23, 12, 12, -277, 12,
-	12, -277, -277, -73, -134, -132, 116, -84, -277, -277,
-	82, 82, -277, -277, -277, -277, -277, -271, 432, 312,
-	-104, 71, 167, 72, -276, -196, -277, -156, 39, 47,
-	58, -84, -84, -139, -156, -172, 20, 12, 54, 54,
-	-105, 13, -75, -86, -78, 150, -105, -109, 31, 54,
-	-3, -276, -276, -163, -167, -128, -87, -88, -88, -87,
-	-88, 63, 63, 63, 68, 63, 68, 63, -97, -194,
-	-277, -277, -3, -160, 74, -86, -100, -86, -102, -194,
-	135, -169, -171, 314, 311, 317, -258, 88, 82, -238,
-	-226, 98, 110, 30, 73, 276, 95, 170, 29, 169,
-	-219, 280, -214, -214, -215, -258, 144, -215, -215, -215,
-	-221, 88, -221, 89, 89, 83, -32, -27, -28, 32,
-	77, -245, -233, 88, 38, 83, 165, -100, 73, 73,
-	73, 16, -158, -189, 82, 83, -130, 224, -128, 83,
-	-189, 83, -158, -231, -190, -189, -276, 163, 30, 30,
-	-129, -130, -215, -258, 467, 466, 83, -100, -80, 213,
-	221, 81, 85, -260, 74, 204, 276, 204, 207, 166,
-	-59, -32, -100, -175, -175, 32, 311, 444, 442, -72,
-	109, -111, -111, -277, -277, -74, -190, -137, -156, -205,
-	144, 251, 187, 249, 245, 265, 256, 278, 247, 279,
-	-203, -205, -111, -111, -111, -111, 338, -137, 117, -84,
-	115, -111, -111, 164, 164, 164, -161, 40, 88, 88,
-	59, -100, -135, 14, -84, 135, -141, -162, 73, -163,
-	-122, -124, -123, -276, -157, -277, -189, -161, -105, 82,
-	118, -91, -90, 73, 74, -92, 73, -90, 63, 63,
-	-277, -105, -86, -105, -105, 150, 311, 315, 316, -238,
-	98, -111, 10, 88, 29, 29, -215, -215, 83, 82,
-	83, 82, 83, 82, -183, 378, 110, -28, -27, -233,
-	-233, 89, -258, -100, -100, -100, -100, 17, 82, -222,
-	-128, 54, -248, 83, -252, -253, -100, -110, -130, -159,
-	81, 83, -257, 341, -259, -258, -189, -189, -189, -100,
-	-179, -179, 32, -258, -111, -277, -141, -277, -213, -213,
-	-213, -217, -213, 239, -213, 239, -277, -277, 20, 20,
-	20, 20, -276, -64, 334, -84, 82, 82, -276, -276,
-	-276, -277, 88, -214, -136, 15, 17, 28, -162, 82,
-	-277, -277, 82, 54, 150, -277, -137, -167, -84, -84,
-	81, -84, -137, -105, -114, -214, 88, -214, 89, 89,
-	378, 30, 78, 79, 80, 30, 75, 76, -159, -158,
-	-189, 200, 182, -277, 82, -220, 341, 344, 23, -158,
-	-256, -255, -190, 81, 74, -156, -214, -258, -111, -111,
-	-111, -111, -111, -141, 88, -111, -111, -158, -277, -158,
-	-158, -197, -214, -145, -150, -176, -84, -120, 29, -124,
-	54, -3, -189, -122, -189, -141, -158, -141, -215, -215,
-	83, 83, 23, 201, -100, -253, 345, 345, -3, 83,
-	82, 118, -158, -100, -277, -277, -277, -277, -67, 128,
-	341, -277, -277, -277, -277, -277, -277, -104, -148, 428,
-	-151, 43, -152, 44, 10, -122, 150, 83, -3, -276,
-	81, -57, 341, -255, -237, -190, 88, 89, 83, -277,
-	339, 70, 342, -145, 48, 257, -153, 52, -154, -149,
-	53, 17, -163, -189, -57, -111, 197, -158, -58, 212,
-	432, -260, 59, 340, 343, -146, 50, -144, 49, -144,
-	-152, 17, -155, 45, 46, 88, -277, -277, 83, 175,
-	-257, 59, -147, 51, 73, 101, 88, 17, 17, -267,
-	-268, 73, 214, 341, 73, 101, 88, 88, -268, 73,
-	11, 10, 342, -266, 183, 178, 181, 31, -266, 343,
-	177, 30, 98,
+	-52, -53, -54, -4, -284, 6, 7, 8, -62, 10,
+	11, 31, -23, -33, 153, -34, -24, 154, -35, 156,
+	155, 191, 157, 184, 71, 227, 228, 230, 231, 232,
+	233, -63, 189, 190, 159, 35, 42, 32, 33, 36,
+	-299, 81, 9, 329, 186, 185, 26, 84, -283, 469,
+	-72, 5, -141, 16, -3, -55, -300, -55, -55, -55,
+	-55, -55, -55, -241, -243, 81, 126, 81, -73, -188,
+	164, 173, 172, 169, -270, 107, 219, 320, 162, -39,
+	-38, -37, -36, -40, 30, -30, -31, -261, -29, -26,
+	158, 155, 199, 102, 103, 191, 192, 193, 157, 175,
+	190, 194, 189, 208, -25, 77, 32, 342, 345, -248,
+	154, 160, 161, 330, 105, 104, 72, 156, -245, 277,
+	446, -40, 448, 95, 97, 447, 41, 164, 449, 450,
+	451, 452, 174, 453, 454, 455, 456, 462, 463, 464,
+	465, 106, 5, 163, -270, -82, 287, 77, -269, -266,
+	84, 85, 86, 163, -188, 164, 165, -270, 163, -103,
+	-198, -266, -192, 339, 177, 373, 374, 224, 77, 277,
+	446, 226, 227, 241, 235, 262, 254, 340, 375, 178,
+	212, 443, 252, 255, 307, 448, 376, 192, 299, 282,
+	290, 95, 230, 316, 461, 377, 459, 97, 447, 76,
+	48, 41, 187, 250, 246, 449, 213, 378, 350, 206,
+	105, 102, 468, 244, 47, 28, 458, 104, 46, 450,
+	379, 451, 292, 267, 437, 45, 304, 193, 380, 80,
+	344, 445, 294, 245, 291, 223, 457, 159, 381, 429,
+	301, 438, 382, 268, 272, 383, 308, 49, 384, 385,
+	439, 103, 386, 75, 452, 239, 240, 387, 221, 176,
+	310, 266, 174, 34, 295, 341, 225, 55, 200, 311,
+	43, 270, 42, 433, 388, 436, 265, 261, 50, 389,
+	390, 391, 392, 453, 264, 238, 260, 467, 218, 454,
+	59, 161, 274, 273, 275, 207, 306, 257, 393, 394,
+	395, 181, 78, 396, 247, 19, 397, 398, 302, 214,
+	399, 53, 400, 401, 314, 190, 402, 51, 455, 38,
+	195, 456, 403, 404, 405, 406, 407, 300, 408, 293,
+	269, 271, 202, 289, 343, 409, 243, 194, 460, 410,
+	182, 444, 196, 199, 189, 315, 183, 411, 412, 413,
+	414, 415, 416, 417, 231, 462, 40, 418, 419, 420,
+	421, 222, 217, 309, 318, 58, 79, 279, 422, 442,
+	237, 215, 423, 228, 52, 463, 464, 465, 209, 466,
+	285, 106, 219, 220, 44, 258, 201, 424, 425, 248,
+	249, 263, 236, 259, 229, 430, 203, 303, 191, 426,
+	317, 216, 280, 347, 208, 305, 441, 346, 256, 253,
+	210, 427, 165, 204, 205, 428, 431, 296, 286, 297,
+	298, 287, 211, 345, 251, 281, 163, -169, 282, -189,
+	283, 284, 295, 296, 301, -181, 302, 300, 202, -281,
+	308, 163, 303, 153, 144, 292, 293, 286, 287, 211,
+	-277, -266, 451, 466, 307, 255, 288, 294, 309, 433,
+	298, 297, -198, 229, -203, 234, -193, -266, -192, 232,
+	-103, -61, -298, 204, 429, 157, 84, -205, -205, -74,
+	433, 435, -124, -87, -110, 110, -115, 30, 24, -114,
+	-111, -132, -129, -130, 144, 145, 147, 146, 148, 133,
+	134, 141, 111, 149, -119, -117, -118, -120, 88, 87,
+	96, 89, 90, 91, 92, 98, 99, 100, -193, -198,
+	-127, -284, 65, 66, 330, 331, 332, 333, 338, 334,
+	113, 54, 319, 328, 327, 326, 323, 324, 321, 322,
+	336, 337, 168, 320, 162, 139, 329, -266, -192, 41,
+	285, 285, -103, 287, -5, -4, -284, 6, 21, 22,
+	-145, 18, 17, -285, 83, -66, -79, 60, 61, -81,
+	22, 37, 64, 62, -56, -78, 135, -87, -198, -78,
+	-178, 167, -178, -178, -168, -208, 229, -172, 309, 308,
+	-194, -170, -193, -191, -169, 306, 158, 348, 109, 23,
+	25, 112, 144, 17, 113, 36, 160, 175, 143, 171,
+	330, 153, 69, 349, 321, 322, 319, 325, 332, 333,
+	320, 283, 30, 11, 351, 26, 185, 22, 37, 137,
+	155, 116, 117, 188, 24, 186, 100, 354, 20, 72,
+	180, 12, 173, 14, 355, 356, 15, 168, 167, 128,
+	164, 67, 9, 149, 27, 125, 63, 357, 29, 358,
+	359, 360, 361, 65, 126, 18, 323, 324, 32, 434,
+	362, 338, 197, 139, 70, 56, 435, 110, 363, 364,
+	98, 365, 101, 73, 440, 107, 16, 68, 39, 366,
+	198, 367, 170, 368, 312, 369, 127, 156, 329, 66,
+	370, 162, 284, 6, 335, 31, 184, 172, 64, 371,
+	163, 115, 336, 337, 166, 99, 5, 169, 33, 10,
+	71, 74, 326, 327, 328, 54, 342, 114, 13, 372,
+	313, 108, 307, 255, -242, 126, -229, -233, -193, 179,
+	-258, 175, -103, -251, -250, -193, -82, 163, -266, 164,
+	164, 164, -186, 168, 329, -36, -37, -169, 143, 196,
+	82, 82, -233, -232, -231, -271, 198, 179, -257, -249,
+	171, 180, -239, 172, 173, -234, 164, 29, -271, -234,
+	170, 180, 198, 198, 106, 198, 106, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 195, -240, 118, -240,
+	346, 346, -245, -271, -271, -271, 166, 34, 34, -190,
+	-234, 166, 23, -240, -240, -169, 143, -240, -240, -240,
+	-240, 206, 206, -240, -240, -240, -240, -240, -240, -240,
+	-240, -240, -240, -240, -240, -240, -240, -240, -103, -85,
+	213, 153, -65, 155, 158, 156, 76, 31, 73, 84,
+	118, -38, 208, -22, -103, 163, -266, -185, 168, -185,
+	-103, 150, -103, -183, 126, 13, -183, -180, 285, 289,
+	290, 291, -183, -183, -183, -183, 209, 299, -235, 164,
+	34, 176, 285, 209, 299, 209, 210, 209, 210, 209,
+	-179, 12, 128, 320, 304, 301, 202, 163, 203, 165,
+	305, -266, 436, 210, 285, 23, 204, -64, 295, 205,
+	84, -183, -206, -284, -194, -206, -206, 31, 166, -193,
+	-57, -193, 88, -7, -3, -11, -10, -12, -15, -16,
+	-17, -18, -103, 20, 118, 20, -80, 285, -68, 144,
+	451, 437, 438, 439, 436, 300, 444, 442, 440, 209,
+	441, 82, 109, 107, 108, 125, -87, -112, 128, 110,
+	126, 127, 112, 130, 129, 140, 133, 134, 135, 136,
+	137, 138, 139, 131, 132, 143, 118, 119, 120, 121,
+	122, 123, 124, -177, -284, -130, -284, 151, 152, -115,
+	-115, -115, -115, -115, -115, -115, -115, -115, -115, -284,
+	150, -2, -124, -4, -284, -284, -284, -284, -284, -284,
+	-284, -284, -137, -87, -284, -301, -121, -284, -301, -121,
+	-301, -121, -301, -284, -301, -121, -301, -121, -301, -301,
+	-121, -284, -284, -284, -284, -284, -284, -284, -205, -278,
+	-279, -107, -103, -284, -290, 84, -141, -3, -55, -160,
+	20, 32, -87, -142, -143, -87, -141, 56, -76, -78,
+	-81, 60, 61, 94, 12, -196, -195, 23, -193, 88,
+	150, 12, -104, 27, -103, -89, -90, -91, -92, -107,
+	-131, -284, 12, -96, -97, -103, -105, -198, 82, 229,
+	-172, -208, -174, -173, 310, 312, 118, -197, -193, 88,
+	30, 83, 82, -103, -210, -213, -215, -214, -216, -211,
+	-212, 252, 253, 144, 256, 258, 259, 260, 261, 262,
+	263, 264, 265, 266, 267, 31, 187, 248, 249, 250,
+	251, 268, 269, 270, 271, 272, 273, 274, 275, 235,
+	254, 340, 236, 237, 238, 239, 240, 241, 243, 244,
+	245, 246, 247, -269, -266, 81, 83, 82, -217, 81,
+	-85, -186, -256, -253, 74, -266, -266, -266, -266, 110,
+	-240, -240, 195, -29, -26, -261, 16, -25, -26, 158,
+	102, 103, 155, 81, -229, 81, -238, -269, -266, 81,
+	29, 170, 169, -237, -234, -237, -238, -266, -132, -193,
+	-198, -266, 29, 29, -165, -193, -165, -165, 21, -165,
+	21, -165, 21, 89, -193, -165, 21, -165, 21, -165,
+	21, -165, 21, -165, 21, 30, 75, 76, 30, 78,
+	79, 80, -132, -132, -229, -169, -103, -266, 89, 89,
+	-240, -240, 89, 88, 88, 88, -240, -240, 89, 88,
+	-266, 88, -272, 181, 223, 225, 89, 89, 89, 89,
+	30, 88, -273, 30, 458, 457, 459, 460, 461, 89,
+	30, 89, 30, 89, -193, 81, -84, 215, 118, 204,
+	204, -198, 88, 204, 163, -290, 163, -290, -293, 217,
+	84, 163, -288, 84, -289, 84, -103, 216, 218, 220,
+	41, 82, 166, -185, 73, -98, -103, 24, -266, -199,
+	-198, -191, 88, -87, -236, 12, 128, -179, -179, -183,
+	-103, -236, -179, -183, -103, -183, -183, -183, -183, -179,
+	-183, -198, -198, -103, -103, -103, -103, -103, -103, -103,
+	-206, -206, -206, -184, 126, 74, 215, -198, 73, -183,
+	-183, 73, -204, 232, 150, -127, -284, 13, -103, 266,
+	430, 431, 432, 82, 342, -96, 436, 436, 436, 436,
+	436, 436, -87, -87, -87, -87, -122, 98, 110, 99,
+	100, -115, -123, -127, -130, 93, 128, 126, 127, 112,
+	-115, -115, -115, -115, -115, -115, -115, -115, -115, -115,
+	-115, -115, -115, -115, -115, -207, -266, 88, 144, -266,
+	-114, -114, -193, -77, 22, 37, -76, -194, -199, -191,
+	-72, -285, -285, -141, -76, -76, -87, -87, -132, 88,
+	-76, -132, 88, -76, -76, -71, 22, 37, -135, -136,
+	114, -132, -285, -115, -193, -193, -76, -77, -77, -76,
+	-76, 82, -280, 312, 313, 434, -201, 198, -200, 23,
+	-198, 88, -125, -124, -198, -145, -285, -146, 27, 10,
+	128, 82, 19, 82, -144, 25, 26, -145, -116, -193,
+	89, 92, -88, 82, 12, -81, -103, -195, 135, -199,
+	-103, -164, 198, -103, 31, 82, -99, -101, -100, -102,
+	63, 67, 69, 64, 65, 66, 70, -202, 23, -89,
+	-3, -284, -103, -96, -286, 82, 12, 74, -286, 82,
+	150, -172, -174, 82, 311, 313, 314, 73, 101, -87,
+	-219, 143, -247, -246, -245, -229, -231, -232, -233, 83,
+	-147, -222, 280, -217, -217, -217, -217, -217, -218, -169,
+	-218, -218, -218, 81, 81, -217, -217, -217, -217, -220,
+	81, -220, -220, -221, 81, -221, -258, -87, -255, -254,
+	-252, -253, 174, 95, 342, -250, -144, 89, -84, -103,
+	73, -193, -256, -256, -256, 24, -266, 88, -266, 88,
+	82, 17, -230, -229, -133, 223, -260, 198, -257, -251,
+	81, 29, -237, -238, -238, 150, -266, 82, 27, 106,
+	106, 106, 106, 342, 155, 31, -229, -133, -207, 166,
+	-207, -207, 88, 88, -182, 466, -96, 165, 222, -86,
+	325, 88, 84, -103, -103, 31, -103, -103, -291, 84,
+	-103, -291, 163, -103, -103, -198, -240, 158, 155, -294,
+	104, 105, 31, 84, 206, -103, -103, -96, -103, 82,
+	-60, 183, 178, -103, -183, -183, -103, -183, -183, 88,
+	204, -297, 84, -103, -103, -193, -199, -87, 13, -68,
+	312, 342, 20, -69, 20, 98, 99, 100, -123, -115,
+	-115, -115, -75, 188, 109, -285, -285, -76, -76, -284,
+	150, -5, -145, -285, -285, 82, 74, 23, 12, 12,
+	-285, 12, 12, -285, -285, -76, -138, -136, 116, -87,
+	-285, -285, 82, 82, -285, -285, -285, -285, -285, -279,
+	433, 313, -108, 71, 167, 72, -284, -200, -285, -160,
+	39, 47, 58, -87, -87, -143, -160, -176, 20, 12,
+	54, 54, -109, 13, -78, -89, -81, 150, -109, -113,
+	31, 54, -3, -284, -284, -167, -171, -132, -90, -91,
+	-91, -90, -91, 63, 63, 63, 68, 63, 68, 63,
+	-100, -198, -285, -285, -3, -164, 74, -89, -103, -89,
+	-105, -198, 135, -173, -175, 315, 312, 318, -266, 88,
+	82, -245, -233, 98, 110, 30, 73, 277, 95, 170,
+	29, 169, -223, 281, -218, -218, -219, -266, 144, -219,
+	-219, -219, -228, 88, -228, 89, 89, 83, -32, -27,
+	-28, 32, 77, -252, -240, 88, 38, 83, 165, -103,
+	73, 73, 73, 16, -162, -193, 82, 83, -134, 224,
+	-132, 83, -193, 83, -162, -238, -194, -193, -284, 163,
+	30, 30, -133, -134, -219, -266, 468, 467, 83, -103,
+	-83, 213, 221, 81, 85, -268, 74, 23, 95, -103,
+	-103, -103, -264, 342, 166, 166, 88, 204, 205, 277,
+	204, 21, -193, 204, 204, -295, -296, 84, 204, 207,
+	166, -60, -32, -103, -179, -179, -103, -127, -87, 32,
+	312, 445, 443, -75, 109, -115, -115, -285, -285, -77,
+	-194, -141, -160, -209, 144, 252, 187, 250, 246, 266,
+	257, 279, 248, 280, -207, -209, -115, -115, -115, -115,
+	339, -141, 117, -87, 115, -115, -115, 164, 164, 164,
+	-165, 40, 88, 88, 59, -103, -139, 14, -87, 135,
+	-145, -166, 73, -167, -126, -128, -127, -284, -161, -285,
+	-193, -165, -109, 82, 118, -94, -93, 73, 74, -95,
+	73, -93, 63, 63, -285, -109, -89, -109, -109, 150,
+	312, 316, 317, -245, 98, -115, 10, 88, 29, 29,
+	-219, -219, 83, 82, 83, 82, 83, 82, -187, 379,
+	110, -28, -27, -240, -240, 89, -266, -103, -103, -103,
+	-103, 17, 82, -229, -132, 54, -255, 83, -259, -260,
+	-103, -114, -134, -163, 81, 83, -264, -267, -266, -103,
+	-240, -292, 84, -106, -287, 84, -263, -262, -194, -103,
+	-198, -193, 81, 81, -193, -193, 205, -227, 226, 224,
+	-193, -193, -103, 118, -103, -183, -183, 32, -266, -115,
+	-285, -145, -285, -217, -217, -217, -221, -217, 240, -217,
+	240, -285, -285, 20, 20, 20, 20, -284, -67, 335,
+	-87, 82, 82, -284, -284, -284, -285, 88, -218, -140,
+	15, 17, 28, -166, 82, -285, -285, 82, 54, 150,
+	-285, -141, -171, -87, -87, 81, -87, -141, -109, -118,
+	-218, 88, -218, 89, 89, 379, 30, 78, 79, 80,
+	30, 75, 76, -163, -162, -193, 200, 182, -285, 82,
+	-224, 342, 345, 23, -162, -264, 88, -103, 166, 118,
+	82, 118, 81, -162, -193, -265, -193, 74, -225, 178,
+	-225, -193, 73, -111, -160, -218, -266, -115, -115, -115,
+	-115, -115, -145, 88, -115, -115, -162, -285, -162, -162,
+	-201, -218, -149, -154, -180, -87, -124, 29, -128, 54,
+	-3, -193, -126, -193, -145, -162, -145, -219, -219, 83,
+	83, 23, 201, -103, -260, 346, 346, -3, 83, -103,
+	-262, -244, -194, 88, 89, -162, -193, 83, 23, 82,
+	83, 74, -103, -226, 72, 81, -285, -285, -285, -285,
+	-70, 128, 342, -285, -285, -285, -285, -285, -285, -108,
+	-152, 429, -155, 43, -156, 44, 10, -126, 150, 83,
+	-3, -284, 81, -58, 342, 83, 23, 74, -284, -193,
+	-262, -267, -162, -285, 340, 70, 343, -149, 48, 258,
+	-157, 52, -158, -153, 53, 17, -167, -193, -58, -115,
+	197, -162, -59, 212, 433, -268, -284, -267, -87, 74,
+	342, 83, 59, 341, 344, -150, 50, -148, 49, -148,
+	-156, 17, -159, 45, 46, 88, -285, -285, 83, 175,
+	-264, -87, -264, -285, -267, -262, 182, 59, -151, 51,
+	73, 101, 88, 17, 17, -275, -276, 73, 214, -285,
+	83, 342, 81, 342, 73, 101, 88, 88, -276, 73,
+	11, 10, 83, 74, -262, -162, 343, -274, 183, 178,
+	181, 31, -274, -268, -267, 83, 344, 177, 30, 98,
+	-264, -264,
 }
 
 var yyDef = [...]int{
-	33, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 811, 0, 549, 549, 549, 549, 549, 549,
-	549, 0, 0, -2, -2, -2, 835, 37, 0, 923,
-	0, 0, -2, 483, 484, 0, 486, -2, 0, 0,
-	495, 1348, 1348, 544, 0, 0, 0, 0, 0, 1346,
-	54, 55, 501, 502, 503, 1, 3, 0, 553, 819,
-	0, 0, -2, 551, 0, 0, 929, 929, 929, 0,
-	85, 86, 0, 0, 0, 835, 0, 0, 0, 0,
-	0, 927, 0, 924, 108, 109, 89, -2, 113, 114,
-	0, 118, 366, 327, 369, 325, 355, -2, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 330, 222, 222, 0, 0, -2, 318, 318, 318,
-	0, 0, 0, 352, 931, 272, 222, 222, 0, 222,
-	222, 222, 222, 0, 0, 222, 222, 222, 222, 222,
-	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
-	0, 107, 848, 0, 0, 117, 38, 34, 35, 36,
-	0, 0, 0, 925, 925, 0, 418, 633, 944, 945,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
-	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
-	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
-	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
-	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
-	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
-	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
-	1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223,
-	1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
-	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
-	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
-	1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263,
-	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
-	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
-	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293,
-	1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303,
-	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
-	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
-	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
-	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1345, 0, 474, 474, 0, 474, 474, 474, 474,
-	0, 0, 0, 430, 0, 0, 0, 0, 471, 0,
-	0, 449, 451, 0, 0, 458, 474, 1349, 1349, 1349,
-	914, 0, 468, 466, 480, 481, 463, 464, 482, 485,
-	0, 490, 493, 940, 941, 0, 508, 0, 1159, 500,
-	513, 514, 0, 545, 546, 39, 684, 643, 0, 649,
-	651, 0, 686, 687, 688, 689, 690, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 716, 717, 718,
-	719, 796, 797, 798, 799, 800, 801, 802, 803, 653,
-	654, 793, 0, 903, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 784, 0, 753, 753, 753, 753, 753,
-	753, 753, 753, 0, 0, 0, 0, 0, 0, 0,
-	-2, -2, 1348, 0, 523, 0, 811, 50, 0, 549,
-	554, 555, 854, 0, 0, 811, 1347, 0, 0, -2,
-	-2, 565, 571, 572, 573, 574, 550, 0, 577, 581,
-	0, 0, 0, 930, 0, 0, 71, 0, 1314, 907,
-	-2, -2, 0, 0, 942, 943, 916, -2, 948, 949,
-	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
-	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
-	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
-	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
-	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
-	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
-	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
-	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
-	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
-	1080, 1081, 1082, 1083, -2, 1103, 0, 0, 127, 128,
-	0, 37, 248, 0, 123, 0, 242, 196, 848, 927,
-	937, 0, 0, 0, 0, 0, 91, 115, 116, 222,
-	222, 0, 117, 117, 334, 335, 336, 0, 0, -2,
-	246, 0, 319, 0, 0, 236, 236, 240, 238, 239,
-	0, 0, 0, 0, 0, 0, 346, 0, 347, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 402, 0,
-	223, 0, 364, 365, 273, 0, 0, 0, 0, 344,
-	345, 0, 0, 932, 933, 0, 0, 222, 222, 0,
-	0, 0, 0, 222, 222, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 839, 0, 0, 0, 0, 0, 0, -2, 0,
-	410, 0, 925, 0, 0, 0, 0, 417, 0, 419,
-	420, 0, 0, 421, 0, 471, 471, 469, 470, 423,
-	424, 425, 426, 474, 0, 0, 231, 232, 233, 471,
-	474, 0, 474, 474, 474, 474, 471, 474, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1349, 1349, 1349,
-	477, 455, 456, 459, 460, 1350, 1351, 461, 462, 915,
-	491, 494, 511, 509, 510, 512, 504, 505, 506, 507,
-	0, 524, 525, 530, 0, 0, 0, 0, 536, 537,
-	538, 0, 0, 541, 542, 543, 0, 0, 0, 0,
-	0, 647, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 672, 673, 674, 675, 676, 677, 650, 0,
-	664, 0, 0, 0, 706, 707, 708, 709, 710, 711,
-	712, 713, 714, 0, 562, 0, 0, 0, 811, 0,
-	0, 0, 0, 0, 0, 0, 559, 0, 785, 0,
-	737, 745, 0, 738, 746, 739, 747, 740, 0, 741,
-	748, 742, 749, 743, 744, 750, 0, 0, 0, 562,
-	562, 0, 0, 40, 515, 516, 0, 616, 935, 819,
-	0, 564, 857, 0, 0, 820, 812, 813, 816, 819,
-	0, 586, 575, 566, 569, 570, 552, 0, 578, 582,
-	0, 584, 585, 0, 0, 69, 0, 632, 0, 588,
-	590, 591, 592, 614, 0, 0, 0, 0, 65, 67,
-	633, 0, 1314, 913, 0, 73, 74, 0, 0, 0,
-	210, 918, 919, 920, -2, 229, 0, 135, 203, 147,
-	148, 149, 196, 151, 196, 196, 196, 196, 207, 207,
-	207, 207, 179, 180, 181, 182, 183, 0, 0, 166,
-	196, 196, 196, 196, 186, 187, 188, 189, 190, 191,
-	192, 193, 152, 153, 154, 155, 156, 157, 158, 159,
-	160, 198, 198, 198, 200, 200, 0, 38, 0, 214,
-	0, 816, 0, 839, 0, 0, 938, 0, 937, 937,
-	937, 106, 0, 0, 0, 367, 328, 356, 368, 0,
-	331, 332, -2, 0, 0, 318, 0, 320, 0, 230,
-	0, -2, 0, 0, 0, 236, 240, 237, 240, 228,
-	241, 348, 793, 0, 349, 350, 0, 382, 602, 0,
-	0, 0, 0, 0, 388, 389, 390, 0, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 357, 358,
-	359, 360, 361, 362, 363, 0, 0, 320, 0, 353,
-	0, 274, 275, 0, 0, 278, 279, 280, 281, 0,
-	0, 284, 285, 286, 287, 288, 312, 313, 314, 289,
-	290, 291, 292, 293, 294, 295, 306, 307, 308, 309,
-	310, 311, 296, 297, 298, 299, 300, 303, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 836, 837,
-	838, 0, 0, 0, 0, 0, 261, 63, 926, 416,
-	634, 946, 947, 475, 476, 0, 234, 235, 474, 474,
-	427, 450, 0, 474, 431, 452, 432, 434, 433, 435,
-	474, 438, 472, 473, 439, 440, 441, 442, 443, 444,
-	445, 446, 447, 448, 454, 0, 0, 0, 492, 496,
-	497, 498, 499, 0, 0, 527, 532, 533, 534, 535,
-	547, 540, 685, 644, 645, 646, 648, 665, 0, 667,
-	669, 655, 656, 680, 681, 682, 0, 0, 0, 0,
-	678, 660, 0, 691, 692, 693, 694, 695, 696, 697,
-	698, 699, 700, 701, 702, 705, 768, 769, 770, 0,
-	703, 704, 715, 0, 0, 0, 563, 794, 0, -2,
-	0, 683, 902, 819, 0, 0, 0, 0, 688, 796,
-	0, 688, 796, 0, 0, 0, 560, 561, 791, 788,
-	0, 0, 754, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 518, 519, 521, 0, 636, 0, 617, 0,
-	619, 620, 0, 936, 854, 51, 41, 0, 855, 0,
-	0, 0, 0, 815, 817, 818, 854, 0, 804, 0,
-	0, 641, 0, 0, 567, 47, 583, 579, 0, 641,
-	0, 0, 631, 0, 0, 0, 0, 0, 0, 621,
-	0, 0, 624, 0, 0, 0, 0, 615, 0, 0,
-	0, -2, 0, 0, 0, 61, 62, 0, 0, 0,
-	908, 72, 0, 0, 77, 78, 909, 910, 911, 912,
-	0, 110, -2, 269, 129, 131, 132, 133, 124, 134,
-	205, 204, 150, 207, 207, 173, 174, 210, 0, 210,
-	210, 210, 0, 0, 167, 168, 169, 170, 161, 0,
-	162, 163, 164, 0, 165, 247, 0, 823, 215, 216,
-	218, 222, 0, 0, 243, 244, 0, 0, 100, 0,
-	939, 0, 0, 0, 928, 119, 120, 121, 122, 117,
-	0, 0, 125, 322, 0, 0, 0, 245, 0, 0,
-	224, 240, 225, 226, 0, 351, 0, 0, 384, 385,
-	386, 387, 0, 0, 0, 320, 322, 210, 0, 276,
-	277, 282, 283, 301, 0, 0, 0, 0, 849, 850,
-	0, 853, 92, 374, 376, 375, 379, 0, 0, 0,
-	0, 411, 261, 823, 0, 415, 262, 263, 471, 437,
-	453, 471, 429, 436, 478, 457, 488, 531, 0, 0,
-	0, 539, 0, 666, 668, 670, 657, 678, 661, 0,
-	658, 0, 0, 652, 720, 0, 0, 562, 0, 811,
-	854, 724, 725, 0, 0, 0, 0, 0, 761, 0,
-	0, 762, 0, 811, 0, 789, 0, 0, 736, 755,
-	0, 0, 756, 757, 758, 759, 760, 517, 520, 522,
-	596, 0, 0, 0, 0, 618, 934, 43, 0, 0,
-	0, 821, 822, 814, 42, 0, 921, 922, 805, 806,
-	807, 0, 576, 587, 568, 0, 819, 896, 0, 0,
-	888, 0, 0, 641, 904, 0, 589, 610, 612, 0,
-	607, 622, 623, 625, 0, 627, 0, 629, 630, 593,
-	594, 595, 0, 641, 0, 641, 66, 641, 68, 0,
-	635, 75, 76, 0, 0, 82, 211, 212, 117, 271,
-	130, 136, 0, 0, 0, 140, 0, 0, 143, 145,
-	146, 206, 210, 210, 175, 208, 209, 176, 177, 178,
-	0, 194, 0, 0, 0, 264, 87, 827, 826, 222,
-	222, 217, 0, 220, 0, 197, 0, 102, 0, 0,
-	0, 0, 326, 600, 0, 337, 338, 0, 321, 381,
-	0, 214, 0, 227, 794, 603, 0, 0, 339, 0,
-	322, 342, 343, 354, 304, 305, 302, 598, 840, 841,
-	842, 0, 852, 95, 0, 0, 0, 0, 372, 0,
-	413, 414, 64, 474, 474, 526, 0, 529, 0, 659,
-	0, 679, 662, 721, 722, 0, 795, 819, 45, 0,
-	196, 196, 774, 196, 200, 777, 196, 779, 196, 782,
-	0, 0, 0, 0, 0, 0, 0, 786, 735, 792,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 859,
-	856, 44, 809, 0, 642, 580, 48, 52, 0, 896,
-	887, 898, 900, 0, 0, 0, 892, 0, 811, 0,
-	0, 604, 611, 0, 0, 605, 0, 606, 626, 628,
-	-2, 811, 641, 59, 60, 0, 79, 80, 81, 270,
-	137, 138, 0, 141, 142, 144, 171, 172, 207, 0,
-	207, 0, 201, 0, 253, 265, 0, 824, 825, 0,
-	0, 219, 221, 598, 103, 104, 105, 0, 0, 126,
-	323, 0, 213, 0, 0, 406, 403, 340, 341, 0,
-	0, 851, 373, 0, 93, 94, 0, 0, 378, 412,
-	422, 428, 528, 548, 663, 723, 854, 726, 771, 207,
-	775, 776, 778, 780, 781, 783, 728, 727, 0, 0,
-	0, 0, 0, 819, 0, 790, 0, 0, 0, 0,
-	0, 616, 207, 879, 49, 0, 0, 0, 53, 0,
-	901, 0, 0, 0, 0, 70, 819, 905, 906, 608,
-	0, 613, 819, 58, 139, 210, 195, 210, 0, 0,
-	266, 828, 829, 830, 831, 832, 833, 834, 0, 329,
-	601, 0, 0, 383, 0, 391, 0, 0, 0, 0,
-	96, 97, 0, 0, 0, 46, 772, 773, 0, 0,
-	0, 0, 763, 0, 787, 0, 0, 0, 638, 0,
-	0, 636, 861, 860, 873, 877, 810, 808, 0, 899,
-	0, 891, 894, 890, 893, 56, 0, 57, 184, 185,
-	199, 202, 0, 0, 0, 407, 404, 405, 843, 599,
-	0, 0, 0, 380, 729, 731, 730, 732, 0, 0,
-	0, 734, 751, 752, 637, 639, 640, 597, 879, 0,
-	872, 875, -2, 0, 0, 889, 0, 609, 843, 0,
-	0, 370, 845, 98, 99, 315, 316, 317, 92, 733,
-	0, 0, 0, 866, 864, 864, 877, 0, 881, 0,
-	886, 0, 897, 895, 88, 0, 0, 0, 0, 846,
-	847, 95, 764, 0, 767, 869, 0, 862, 865, 863,
-	874, 0, 880, 0, 0, 878, 408, 409, 249, 0,
-	377, 765, 858, 0, 867, 868, 876, 0, 0, 250,
-	251, 0, 844, 0, 870, 871, 882, 884, 252, 0,
-	0, 0, 0, 254, 256, 257, 0, 0, 255, 766,
-	258, 259, 260,
+	31, 32, 33, 877, 0, 615, 615, 615, 615, 615,
+	615, 615, 0, 0, -2, -2, -2, 901, 38, 0,
+	989, 0, 0, -2, 521, 522, 0, 524, -2, 0,
+	0, 533, 1415, 1415, 610, 0, 0, 0, 0, 0,
+	0, 1413, 55, 56, 539, 540, 541, 578, 1, 3,
+	0, 619, 885, 0, 0, -2, 617, 0, 0, 995,
+	995, 995, 0, 86, 87, 0, 0, 0, 901, 0,
+	0, 0, 0, 0, 993, 0, 990, 120, 121, 90,
+	-2, 125, 126, 0, 130, 378, 339, 381, 337, 367,
+	-2, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 234, 234, 0, 0, -2,
+	330, 330, 330, 0, 0, 0, 364, 997, 284, 234,
+	234, 0, 234, 234, 234, 234, 0, 0, 234, 234,
+	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+	234, 234, 234, 0, 119, 914, 0, 0, 129, 39,
+	35, 36, 37, 0, 0, 0, 991, 991, 0, 449,
+	699, 1010, 1011, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
+	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
+	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
+	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
+	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
+	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
+	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
+	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376,
+	1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386,
+	1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396,
+	1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406,
+	1407, 1408, 1409, 1410, 1411, 1412, 0, 512, 512, 0,
+	512, 512, 512, 512, 0, 0, 0, 461, 0, 0,
+	0, 0, 509, 0, 0, 480, 482, 0, 0, 496,
+	512, 1416, 1416, 1416, 980, 0, 506, 504, 518, 519,
+	501, 502, 520, 523, 0, 528, 531, 1006, 1007, 0,
+	550, 0, 0, 1400, 1226, 538, 35, 579, 580, 0,
+	611, 612, 40, 750, 709, 0, 715, 717, 0, 752,
+	753, 754, 755, 756, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 782, 783, 784, 785, 862, 863,
+	864, 865, 866, 867, 868, 869, 719, 720, 859, 0,
+	969, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	850, 0, 819, 819, 819, 819, 819, 819, 819, 819,
+	0, 0, 0, 0, 0, 0, 0, -2, -2, 1415,
+	0, 589, 0, 576, 877, 51, 0, 615, 620, 621,
+	920, 0, 0, 877, 1414, 0, 0, -2, -2, 631,
+	637, 638, 639, 640, 616, 0, 643, 647, 0, 0,
+	0, 996, 0, 0, 72, 0, 1381, 973, -2, -2,
+	0, 0, 1008, 1009, 982, -2, 1014, 1015, 1016, 1017,
+	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027,
+	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037,
+	1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057,
+	1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
+	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087,
+	1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097,
+	1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107,
+	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
+	1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127,
+	1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
+	1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147,
+	1148, 1149, -2, 1170, 0, 0, 139, 140, 0, 38,
+	260, 0, 135, 0, 254, 208, 914, 993, 1003, 0,
+	0, 0, 0, 0, 92, 127, 128, 234, 234, 0,
+	129, 129, 346, 347, 348, 0, 0, -2, 258, 0,
+	331, 0, 0, 248, 248, 252, 250, 251, 0, 0,
+	0, 0, 0, 0, 358, 0, 359, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 433, 0, 235, 0,
+	376, 377, 285, 0, 0, 0, 0, 356, 357, 0,
+	0, 998, 999, 0, 0, 234, 234, 0, 0, 0,
+	0, 234, 234, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 905,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 562,
+	0, -2, 0, 441, 0, 991, 0, 0, 0, 0,
+	448, 0, 450, 451, 0, 0, 452, 0, 509, 509,
+	507, 508, 454, 455, 456, 457, 512, 0, 0, 243,
+	244, 245, 509, 512, 0, 512, 512, 512, 512, 509,
+	512, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1416, 1416, 1416, 515, 486, 0, 0, 491, 512, 512,
+	571, 497, 498, 1417, 1418, 499, 500, 981, 529, 532,
+	553, 551, 552, 555, 542, 543, 544, 545, 546, 547,
+	548, 549, 0, 0, 0, 560, 590, 591, 596, 0,
+	0, 0, 0, 602, 603, 604, 0, 0, 607, 608,
+	609, 0, 0, 0, 0, 0, 713, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 737, 738, 739, 740,
+	741, 742, 743, 716, 0, 730, 0, 0, 0, 772,
+	773, 774, 775, 776, 777, 778, 779, 780, 0, 628,
+	0, 0, 0, 877, 0, 0, 0, 0, 0, 0,
+	0, 625, 0, 851, 0, 803, 811, 0, 804, 812,
+	805, 813, 806, 0, 807, 814, 808, 815, 809, 810,
+	816, 0, 0, 0, 628, 628, 0, 0, 41, 581,
+	582, 0, 682, 1001, 0, 567, 885, 0, 630, 923,
+	0, 0, 886, 878, 879, 882, 885, 0, 652, 641,
+	632, 635, 636, 618, 0, 644, 648, 0, 650, 651,
+	0, 0, 70, 0, 698, 0, 654, 656, 657, 658,
+	680, 0, 0, 0, 0, 66, 68, 699, 0, 1381,
+	979, 0, 74, 75, 0, 0, 0, 222, 984, 985,
+	986, -2, 241, 0, 147, 215, 159, 160, 161, 208,
+	163, 208, 208, 208, 208, 219, 219, 219, 219, 191,
+	192, 193, 194, 195, 0, 0, 178, 208, 208, 208,
+	208, 198, 199, 200, 201, 202, 203, 204, 205, 164,
+	165, 166, 167, 168, 169, 170, 171, 172, 210, 210,
+	210, 212, 212, 0, 39, 0, 226, 0, 882, 0,
+	905, 0, 0, 1004, 0, 1003, 1003, 1003, 118, 0,
+	0, 0, 379, 340, 368, 380, 0, 343, 344, -2,
+	0, 0, 330, 0, 332, 0, 242, 0, -2, 0,
+	0, 0, 248, 252, 249, 252, 240, 253, 360, 859,
+	0, 361, 362, 0, 413, 668, 0, 0, 0, 0,
+	0, 419, 420, 421, 0, 423, 424, 425, 426, 427,
+	428, 429, 430, 431, 432, 369, 370, 371, 372, 373,
+	374, 375, 0, 0, 332, 0, 365, 0, 286, 287,
+	0, 0, 290, 291, 292, 293, 0, 0, 296, 297,
+	298, 299, 300, 324, 325, 326, 301, 302, 303, 304,
+	305, 306, 307, 318, 319, 320, 321, 322, 323, 308,
+	309, 310, 311, 312, 315, 0, 0, 0, 0, 0,
+	1400, 0, 393, 0, 0, 0, 0, 0, 0, 0,
+	-2, 0, 0, 561, 234, 563, 0, 902, 903, 904,
+	0, 0, 0, 0, 0, 273, 64, 992, 447, 700,
+	1012, 1013, 513, 514, 0, 246, 247, 512, 512, 458,
+	481, 0, 512, 462, 483, 463, 465, 464, 466, 512,
+	469, 510, 511, 470, 471, 472, 473, 474, 475, 476,
+	477, 478, 479, 485, 0, 0, 488, 490, 0, 493,
+	494, 0, 0, 530, 0, 556, 0, 0, 0, 534,
+	535, 536, 537, 0, 0, 593, 598, 599, 600, 601,
+	613, 606, 751, 710, 711, 712, 714, 731, 0, 733,
+	735, 721, 722, 746, 747, 748, 0, 0, 0, 0,
+	744, 726, 0, 757, 758, 759, 760, 761, 762, 763,
+	764, 765, 766, 767, 768, 771, 834, 835, 836, 0,
+	769, 770, 781, 0, 0, 0, 629, 860, 0, -2,
+	0, 749, 968, 885, 0, 0, 0, 0, 754, 862,
+	0, 754, 862, 0, 0, 0, 626, 627, 857, 854,
+	0, 0, 820, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 584, 585, 587, 0, 702, 0, 683, 0,
+	685, 686, 0, 1002, 577, 920, 52, 42, 0, 921,
+	0, 0, 0, 0, 881, 883, 884, 920, 0, 870,
+	0, 0, 707, 0, 0, 633, 48, 649, 645, 0,
+	707, 0, 0, 697, 0, 0, 0, 0, 0, 0,
+	687, 0, 0, 690, 0, 0, 0, 0, 681, 0,
+	0, 0, -2, 0, 0, 0, 62, 63, 0, 0,
+	0, 974, 73, 0, 0, 78, 79, 975, 976, 977,
+	978, 0, 122, -2, 281, 141, 143, 144, 145, 136,
+	146, 217, 216, 162, 219, 219, 185, 186, 222, 0,
+	222, 222, 222, 0, 0, 179, 180, 181, 182, 173,
+	0, 174, 175, 176, 0, 177, 259, 0, 889, 227,
+	228, 230, 234, 0, 0, 255, 256, 0, 0, 112,
+	0, 1005, 0, 0, 0, 994, 131, 132, 133, 134,
+	129, 0, 0, 137, 334, 0, 0, 0, 257, 0,
+	0, 236, 252, 237, 238, 0, 363, 0, 0, 415,
+	416, 417, 418, 0, 0, 0, 332, 334, 222, 0,
+	288, 289, 294, 295, 313, 0, 0, 0, 0, 915,
+	916, 0, 919, 93, 0, 0, 387, 389, 0, 564,
+	388, 0, 0, 96, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 442, 273, 889, 0,
+	446, 274, 275, 509, 468, 484, 509, 460, 467, 516,
+	0, 489, 572, 492, 495, 526, 554, 558, 0, 597,
+	0, 0, 0, 605, 0, 732, 734, 736, 723, 744,
+	727, 0, 724, 0, 0, 718, 786, 0, 0, 628,
+	0, 877, 920, 790, 791, 0, 0, 0, 0, 0,
+	827, 0, 0, 828, 0, 877, 0, 855, 0, 0,
+	802, 821, 0, 0, 822, 823, 824, 825, 826, 583,
+	586, 588, 662, 0, 0, 0, 0, 684, 1000, 44,
+	0, 0, 0, 887, 888, 880, 43, 0, 987, 988,
+	871, 872, 873, 0, 642, 653, 634, 0, 885, 962,
+	0, 0, 954, 0, 0, 707, 970, 0, 655, 676,
+	678, 0, 673, 688, 689, 691, 0, 693, 0, 695,
+	696, 659, 660, 661, 0, 707, 0, 707, 67, 707,
+	69, 0, 701, 76, 77, 0, 0, 83, 223, 224,
+	129, 283, 142, 148, 0, 0, 0, 152, 0, 0,
+	155, 157, 158, 218, 222, 222, 187, 220, 221, 188,
+	189, 190, 0, 206, 0, 0, 0, 276, 88, 893,
+	892, 234, 234, 229, 0, 232, 0, 209, 0, 114,
+	0, 0, 0, 0, 338, 666, 0, 349, 350, 0,
+	333, 412, 0, 226, 0, 239, 860, 669, 0, 0,
+	351, 0, 334, 354, 355, 366, 316, 317, 314, 664,
+	906, 907, 908, 0, 918, 96, 0, 0, 234, 396,
+	0, 110, 408, 0, 0, 0, 394, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, -2, 573, 384,
+	0, 444, 445, 65, 512, 512, 487, 557, 559, 592,
+	0, 595, 0, 725, 0, 745, 728, 787, 788, 0,
+	861, 885, 46, 0, 208, 208, 840, 208, 212, 843,
+	208, 845, 208, 848, 0, 0, 0, 0, 0, 0,
+	0, 852, 801, 858, 0, 0, 0, 0, 0, 0,
+	0, 0, 219, 925, 922, 45, 875, 0, 708, 646,
+	49, 53, 0, 962, 953, 964, 966, 0, 0, 0,
+	958, 0, 877, 0, 0, 670, 677, 0, 0, 671,
+	0, 672, 692, 694, -2, 877, 707, 60, 61, 0,
+	80, 81, 82, 282, 149, 150, 0, 153, 154, 156,
+	183, 184, 219, 0, 219, 0, 213, 0, 265, 277,
+	0, 890, 891, 0, 0, 231, 233, 664, 115, 116,
+	117, 0, 0, 138, 335, 0, 225, 0, 0, 437,
+	434, 352, 353, 0, 0, 917, 385, 94, 95, 96,
+	0, 0, 0, 397, 0, 569, 97, 98, 0, 390,
+	391, 0, 0, 0, 0, 106, 106, 0, 574, 575,
+	406, 407, 0, 0, 443, 453, 459, 594, 614, 729,
+	789, 920, 792, 837, 219, 841, 842, 844, 846, 847,
+	849, 794, 793, 0, 0, 0, 0, 0, 885, 0,
+	856, 0, 0, 0, 0, 0, 682, 219, 945, 50,
+	0, 0, 0, 54, 0, 967, 0, 0, 0, 0,
+	71, 885, 971, 972, 674, 0, 679, 885, 59, 151,
+	222, 207, 222, 0, 0, 278, 894, 895, 896, 897,
+	898, 899, 900, 0, 341, 667, 0, 0, 414, 0,
+	422, 0, 0, 0, 0, 386, 392, 395, 565, 0,
+	0, 0, 0, 0, 666, 0, 0, 0, 108, 107,
+	404, 405, 0, 411, 47, 838, 839, 0, 0, 0,
+	0, 829, 0, 853, 0, 0, 0, 704, 0, 0,
+	702, 927, 926, 939, 943, 876, 874, 0, 965, 0,
+	957, 960, 956, 959, 57, 0, 58, 196, 197, 211,
+	214, 0, 0, 0, 438, 435, 436, 909, 665, 111,
+	99, 100, 327, 328, 329, 0, 666, 0, 0, 0,
+	402, 0, 409, 403, 109, 0, 795, 797, 796, 798,
+	0, 0, 0, 800, 817, 818, 703, 705, 706, 663,
+	945, 0, 938, 941, -2, 0, 0, 955, 0, 675,
+	909, 0, 0, 382, 911, 93, 0, 0, 0, 1008,
+	105, 101, 0, 799, 0, 0, 0, 932, 930, 930,
+	943, 0, 947, 0, 952, 0, 963, 961, 89, 0,
+	0, 0, 0, 912, 913, 96, 0, 96, 0, 0,
+	0, 0, 830, 0, 833, 935, 0, 928, 931, 929,
+	940, 0, 946, 0, 0, 944, 439, 440, 261, 0,
+	398, 0, 399, 0, 103, 102, 0, 831, 924, 0,
+	933, 934, 942, 0, 0, 262, 263, 0, 910, 0,
+	0, 0, 0, 0, 936, 937, 948, 950, 264, 0,
+	0, 0, 93, 0, 104, 0, 0, 266, 268, 269,
+	0, 0, 267, 96, 96, 410, 832, 270, 271, 272,
+	400, 401,
 }
 
 var yyTok1 = [...]int{
@@ -4973,7 +5233,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
 	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 468,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 469,
 	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5051,7 +5311,7 @@ var yyTok3 = [...]int{
 	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
 	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
-	57790, 465, 57791, 466, 57792, 467, 0,
+	57790, 465, 57791, 466, 57792, 467, 57793, 468, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5393,77 +5653,77 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:409
+//line sql.y:438
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:414
+//line sql.y:443
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:415
+//line sql.y:444
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:419
+//line sql.y:448
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:451
+//line sql.y:481
 		{
 			setParseTree(yylex, nil)
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:457
+//line sql.y:487
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:461
+//line sql.y:491
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:465
+//line sql.y:495
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:470
+//line sql.y:500
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:474
+//line sql.y:504
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:480
+//line sql.y:510
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:486
+//line sql.y:516
 		{
 			yyVAL.statement = &Load{}
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:492
+//line sql.y:522
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5472,27 +5732,27 @@ yydefault:
 			sel.Into = yyDollar[5].selectInto
 			yyVAL.selStmt = sel
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:501
+//line sql.y:531
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].lock}
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:535
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:509
+//line sql.y:539
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:532
+//line sql.y:562
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5500,45 +5760,45 @@ yydefault:
 			sel.Lock = yyDollar[4].lock
 			yyVAL.selStmt = sel
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:540
+//line sql.y:570
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:546
+//line sql.y:576
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:552
+//line sql.y:582
 		{
 			yyVAL.statement = &VStream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:560
+//line sql.y:590
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:596
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:600
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:577
+//line sql.y:607
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -5550,9 +5810,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:589
+//line sql.y:619
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -5562,210 +5822,210 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].insertAction, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:601
+//line sql.y:631
 		{
 			yyVAL.insertAction = InsertAct
 		}
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:605
+//line sql.y:635
 		{
 			yyVAL.insertAction = ReplaceAct
 		}
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:611
+//line sql.y:641
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:617
+//line sql.y:647
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:621
+//line sql.y:651
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
 		}
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:625
+//line sql.y:655
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:629
+//line sql.y:659
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+//line sql.y:664
 		{
 		}
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:635
+//line sql.y:665
 		{
 		}
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:639
+//line sql.y:669
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:643
+//line sql.y:673
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
 		}
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:649
+//line sql.y:679
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:653
+//line sql.y:683
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:659
+//line sql.y:689
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:663
+//line sql.y:693
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:668
+//line sql.y:698
 		{
 			yyVAL.partitions = nil
 		}
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:672
+//line sql.y:702
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:678
+//line sql.y:708
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:684
+//line sql.y:714
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].scope, Characteristics: yyDollar[5].characteristics}
 		}
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:688
+//line sql.y:718
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics, Scope: ImplicitScope}
 		}
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:694
+//line sql.y:724
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:698
+//line sql.y:728
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:704
+//line sql.y:734
 		{
 			yyVAL.characteristic = yyDollar[3].isolationLevel
 		}
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:708
+//line sql.y:738
 		{
 			yyVAL.characteristic = ReadWrite
 		}
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:712
+//line sql.y:742
 		{
 			yyVAL.characteristic = ReadOnly
 		}
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:718
+//line sql.y:748
 		{
 			yyVAL.isolationLevel = RepeatableRead
 		}
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:722
+//line sql.y:752
 		{
 			yyVAL.isolationLevel = ReadCommitted
 		}
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:726
+//line sql.y:756
 		{
 			yyVAL.isolationLevel = ReadUncommitted
 		}
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:730
+//line sql.y:760
 		{
 			yyVAL.isolationLevel = Serializable
 		}
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:766
 		{
 			yyVAL.scope = SessionScope
 		}
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:740
+//line sql.y:770
 		{
 			yyVAL.scope = GlobalScope
 		}
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:746
+//line sql.y:776
 		{
 			yyDollar[1].createTable.TableSpec = yyDollar[2].TableSpec
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:752
+//line sql.y:782
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTable.OptLike = yyDollar[2].optLike
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:759
+//line sql.y:789
 		{
 			indexDef := yyDollar[1].alterTable.AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumns
@@ -5774,1695 +6034,1767 @@ yydefault:
 			yyDollar[1].alterTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:768
+//line sql.y:798
 		{
 			yyVAL.statement = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].boolean, Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columns, Select: yyDollar[10].selStmt, CheckOption: yyDollar[11].str}
 		}
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:772
+//line sql.y:802
 		{
 			yyDollar[1].createDatabase.FullyParsed = true
 			yyDollar[1].createDatabase.CreateOptions = yyDollar[2].collateAndCharsets
 			yyVAL.statement = yyDollar[1].createDatabase
 		}
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:779
+//line sql.y:809
 		{
 			yyVAL.boolean = false
 		}
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:813
 		{
 			yyVAL.boolean = true
 		}
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:788
+//line sql.y:818
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:792
+//line sql.y:822
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:828
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:803
+//line sql.y:833
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:838
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:814
+//line sql.y:844
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:819
+//line sql.y:849
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:855
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 100:
+	case 101:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:863
+		{
+			yyVAL.vindexBindings = []*VindexBinding{{Column: yyDollar[1].colIdent, Spec: &VindexSpec{Name: GenerateVindexName(yyDollar[3].colIdent, []ColIdent{yyDollar[1].colIdent}), Type: yyDollar[3].colIdent}}}
+		}
+	case 102:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:867
+		{
+			yyVAL.vindexBindings = []*VindexBinding{{Column: yyDollar[1].colIdent, Spec: &VindexSpec{Name: GenerateVindexName(yyDollar[3].colIdent, []ColIdent{yyDollar[1].colIdent}), Type: yyDollar[3].colIdent, Params: []VindexParam{yyDollar[5].vindexParam}}}}
+		}
+	case 103:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:871
+		{
+			yyVAL.vindexBindings = append(yyDollar[1].vindexBindings, &VindexBinding{Column: yyDollar[3].colIdent, Spec: &VindexSpec{Name: GenerateVindexName(yyDollar[5].colIdent, []ColIdent{yyDollar[3].colIdent}), Type: yyDollar[5].colIdent}})
+		}
+	case 104:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:875
+		{
+			yyVAL.vindexBindings = append(yyDollar[1].vindexBindings, &VindexBinding{Column: yyDollar[3].colIdent, Spec: &VindexSpec{Name: GenerateVindexName(yyDollar[5].colIdent, []ColIdent{yyDollar[3].colIdent}), Type: yyDollar[5].colIdent, Params: []VindexParam{yyDollar[7].vindexParam}}})
+		}
+	case 105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:879
+		{
+			last := yyDollar[1].vindexBindings[len(yyDollar[1].vindexBindings)-1]
+			if len(last.Spec.Params) == 0 {
+				yylex.Error("vindex params must follow WITH")
+				return 1
+			}
+			last.Spec.Params = append(last.Spec.Params, yyDollar[3].vindexParam)
+			yyVAL.vindexBindings = yyDollar[1].vindexBindings
+		}
+	case 106:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:890
+		{
+			yyVAL.boolean = false
+		}
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:894
+		{
+			yyVAL.boolean = true
+		}
+	case 108:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:899
+		{
+			yyVAL.boolean = false
+		}
+	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:903
+		{
+			yyVAL.boolean = true
+		}
+	case 110:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:908
+		{
+			yyVAL.tableName = TableName{}
+		}
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:912
+		{
+			yyVAL.tableName = yyDollar[3].tableName
+		}
+	case 112:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:831
+//line sql.y:918
 		{
 			yyVAL.createTable = &CreateTable{Table: yyDollar[5].tableName, IfNotExists: yyDollar[4].boolean, Temp: yyDollar[2].boolean}
 			setDDL(yylex, yyVAL.createTable)
 		}
-	case 101:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:838
+//line sql.y:925
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 102:
+	case 114:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:845
+//line sql.y:932
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[6].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[3].colIdent, Type: string(yyDollar[2].bytes)}, Options: yyDollar[4].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 103:
+	case 115:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:850
+//line sql.y:937
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Fulltext: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 104:
+	case 116:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:855
+//line sql.y:942
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Spatial: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 105:
+	case 117:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:860
+//line sql.y:947
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Unique: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 106:
+	case 118:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:867
+//line sql.y:954
 		{
 			yyVAL.createDatabase = &CreateDatabase{DBName: string(yyDollar[4].colIdent.String()), IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createDatabase)
 		}
-	case 107:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:961
 		{
 			yyVAL.alterDatabase = &AlterDatabase{}
 			setDDL(yylex, yyVAL.alterDatabase)
 		}
-	case 110:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:885
+//line sql.y:972
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
-	case 111:
+	case 123:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:891
+//line sql.y:978
 		{
 			yyVAL.collateAndCharsets = nil
 		}
-	case 112:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:895
+//line sql.y:982
 		{
 			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
 		}
-	case 113:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:988
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 114:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:992
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 115:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:909
+//line sql.y:996
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 116:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:913
+//line sql.y:1000
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 129:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:918
+//line sql.y:1005
 		{
 			yyVAL.boolean = false
 		}
-	case 118:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:922
+//line sql.y:1009
 		{
 			yyVAL.boolean = true
 		}
-	case 119:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:928
+//line sql.y:1015
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 120:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:932
+//line sql.y:1019
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 121:
+	case 133:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:938
+//line sql.y:1025
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 122:
+	case 134:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:942
+//line sql.y:1029
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 123:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:949
+//line sql.y:1036
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 124:
+	case 136:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:953
+//line sql.y:1040
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 125:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:1046
 		{
 			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
-	case 126:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:963
+//line sql.y:1050
 		{
 			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
-	case 127:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:969
+//line sql.y:1056
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 128:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:974
+//line sql.y:1061
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
 		}
-	case 129:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:979
+//line sql.y:1066
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 130:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:983
+//line sql.y:1070
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
 		}
-	case 131:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:988
+//line sql.y:1075
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 132:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:992
+//line sql.y:1079
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 133:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:996
+//line sql.y:1083
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 134:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1002
+//line sql.y:1089
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptions
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 135:
+	case 147:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1012
+//line sql.y:1099
 		{
 			yyVAL.columnTypeOptions = &ColumnTypeOptions{NotNull: false, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil}
 		}
-	case 136:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1016
+//line sql.y:1103
 		{
 			yyDollar[1].columnTypeOptions.NotNull = false
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 137:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1021
+//line sql.y:1108
 		{
 			yyDollar[1].columnTypeOptions.NotNull = true
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 138:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1026
+//line sql.y:1113
 		{
 			yyDollar[1].columnTypeOptions.Default = yyDollar[3].expr
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 139:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1031
+//line sql.y:1118
 		{
 			yyDollar[1].columnTypeOptions.OnUpdate = yyDollar[4].expr
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 140:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1036
+//line sql.y:1123
 		{
 			yyDollar[1].columnTypeOptions.Autoincrement = true
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 141:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1041
+//line sql.y:1128
 		{
 			yyDollar[1].columnTypeOptions.Comment = NewStrLiteral(yyDollar[3].bytes)
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 142:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1046
+//line sql.y:1133
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyPrimary
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 143:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1051
+//line sql.y:1138
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKey
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 144:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1056
+//line sql.y:1143
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyUniqueKey
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 145:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1061
+//line sql.y:1148
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyUnique
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 146:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1068
+//line sql.y:1155
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolean
 			yyVAL.columnType.Zerofill = yyDollar[3].boolean
 		}
-	case 150:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1079
+//line sql.y:1166
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
-	case 151:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1084
+//line sql.y:1171
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 152:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1090
+//line sql.y:1177
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 153:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1094
+//line sql.y:1181
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 154:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1098
+//line sql.y:1185
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 155:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1102
+//line sql.y:1189
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 156:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1106
+//line sql.y:1193
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 157:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1110
+//line sql.y:1197
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 158:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1114
+//line sql.y:1201
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 159:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1118
+//line sql.y:1205
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 160:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1122
+//line sql.y:1209
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 161:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1128
+//line sql.y:1215
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 162:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1134
+//line sql.y:1221
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 163:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1140
+//line sql.y:1227
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 164:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1146
+//line sql.y:1233
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 165:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1152
+//line sql.y:1239
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 166:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+//line sql.y:1247
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 167:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1164
+//line sql.y:1251
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 168:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1168
+//line sql.y:1255
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 169:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1172
+//line sql.y:1259
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 170:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1176
+//line sql.y:1263
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 171:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1182
+//line sql.y:1269
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 172:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1186
+//line sql.y:1273
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 173:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1190
+//line sql.y:1277
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 174:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1194
+//line sql.y:1281
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 175:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1198
+//line sql.y:1285
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 176:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1202
+//line sql.y:1289
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 177:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1206
+//line sql.y:1293
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 178:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1210
+//line sql.y:1297
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 179:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1301
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 180:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1305
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 181:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1222
+//line sql.y:1309
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 182:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1226
+//line sql.y:1313
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 183:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1230
+//line sql.y:1317
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 184:
+	case 196:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1234
+//line sql.y:1321
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 185:
+	case 197:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1239
+//line sql.y:1326
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 186:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1245
+//line sql.y:1332
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 187:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1336
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 188:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1253
+//line sql.y:1340
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 189:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1257
+//line sql.y:1344
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 190:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1261
+//line sql.y:1348
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 191:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1265
+//line sql.y:1352
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 192:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1269
+//line sql.y:1356
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 193:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1273
+//line sql.y:1360
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 194:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1366
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 195:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1284
+//line sql.y:1371
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 196:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1289
+//line sql.y:1376
 		{
 			yyVAL.literal = nil
 		}
-	case 197:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1293
+//line sql.y:1380
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
-	case 198:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1298
+//line sql.y:1385
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 199:
+	case 211:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1302
+//line sql.y:1389
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 200:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1310
+//line sql.y:1397
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 201:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1314
+//line sql.y:1401
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 			}
 		}
-	case 202:
+	case 214:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1320
+//line sql.y:1407
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 203:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1328
+//line sql.y:1415
 		{
 			yyVAL.boolean = false
 		}
-	case 204:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1332
+//line sql.y:1419
 		{
 			yyVAL.boolean = true
 		}
-	case 205:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1337
+//line sql.y:1424
 		{
 			yyVAL.boolean = false
 		}
-	case 206:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1428
 		{
 			yyVAL.boolean = true
 		}
-	case 207:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1346
+//line sql.y:1433
 		{
 			yyVAL.str = ""
 		}
-	case 208:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1350
+//line sql.y:1437
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 209:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1354
+//line sql.y:1441
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 210:
+	case 222:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1359
+//line sql.y:1446
 		{
 			yyVAL.str = ""
 		}
-	case 211:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1363
+//line sql.y:1450
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 212:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1367
+//line sql.y:1454
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 213:
+	case 225:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1374
+//line sql.y:1461
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 214:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1379
+//line sql.y:1466
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 215:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1383
+//line sql.y:1470
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 216:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1389
+//line sql.y:1476
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 217:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1393
+//line sql.y:1480
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 218:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1399
+//line sql.y:1486
 		{
 			yyVAL.indexOption = yyDollar[1].indexOption
 		}
-	case 219:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1403
+//line sql.y:1490
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 220:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1408
+//line sql.y:1495
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
-	case 221:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1412
+//line sql.y:1499
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 222:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1418
+//line sql.y:1505
 		{
 			yyVAL.str = ""
 		}
-	case 223:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1422
+//line sql.y:1509
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 224:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
+//line sql.y:1515
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 225:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1519
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 226:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1436
+//line sql.y:1523
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
-	case 227:
+	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1440
+//line sql.y:1527
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
-	case 228:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1444
+//line sql.y:1531
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 229:
+	case 241:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1449
+//line sql.y:1536
 		{
 			yyVAL.str = ""
 		}
-	case 230:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1453
+//line sql.y:1540
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 231:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+//line sql.y:1546
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 232:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1463
+//line sql.y:1550
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 233:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1467
+//line sql.y:1554
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 234:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1561
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 235:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1478
+//line sql.y:1565
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 236:
+	case 248:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1483
+//line sql.y:1570
 		{
 			yyVAL.str = "key"
 		}
-	case 237:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1487
+//line sql.y:1574
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 238:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1580
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 239:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1497
+//line sql.y:1584
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 240:
+	case 252:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1502
+//line sql.y:1589
 		{
 			yyVAL.str = ""
 		}
-	case 241:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1506
+//line sql.y:1593
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 242:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1512
+//line sql.y:1599
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 243:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1516
+//line sql.y:1603
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 244:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1522
+//line sql.y:1609
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
 		}
-	case 245:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1528
+//line sql.y:1615
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 246:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1532
+//line sql.y:1619
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 247:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+//line sql.y:1625
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 248:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1542
+//line sql.y:1629
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 249:
+	case 261:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1548
+//line sql.y:1635
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 250:
+	case 262:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1552
+//line sql.y:1639
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 251:
+	case 263:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1556
+//line sql.y:1643
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 252:
+	case 264:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1560
+//line sql.y:1647
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 253:
+	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1566
+//line sql.y:1653
 		{
 			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
 		}
-	case 254:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1572
+//line sql.y:1659
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 255:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1578
+//line sql.y:1665
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 256:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1584
+//line sql.y:1671
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 257:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1588
+//line sql.y:1675
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 258:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1679
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 259:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1596
+//line sql.y:1683
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 260:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1600
+//line sql.y:1687
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 261:
+	case 273:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1605
+//line sql.y:1692
 		{
 			yyVAL.str = ""
 		}
-	case 262:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1609
+//line sql.y:1696
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 263:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1613
+//line sql.y:1700
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 264:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1618
+//line sql.y:1705
 		{
 			yyVAL.boolean = true
 		}
-	case 265:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1622
+//line sql.y:1709
 		{
 			yyVAL.boolean = true
 		}
-	case 266:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1626
+//line sql.y:1713
 		{
 			yyVAL.boolean = false
 		}
-	case 267:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1631
+//line sql.y:1718
 		{
 			yyVAL.tableOptions = nil
 		}
-	case 268:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1635
+//line sql.y:1722
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
-	case 269:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1641
+//line sql.y:1728
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 270:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1645
+//line sql.y:1732
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
 		}
-	case 271:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1649
+//line sql.y:1736
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 272:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+//line sql.y:1742
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 273:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1659
+//line sql.y:1746
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 274:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1665
+//line sql.y:1752
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 275:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1669
+//line sql.y:1756
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1673
+//line sql.y:1760
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
 		}
-	case 277:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1677
+//line sql.y:1764
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
 		}
-	case 278:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1681
+//line sql.y:1768
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 279:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1685
+//line sql.y:1772
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1689
+//line sql.y:1776
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 281:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1693
+//line sql.y:1780
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1697
+//line sql.y:1784
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 283:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1701
+//line sql.y:1788
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 284:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1705
+//line sql.y:1792
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1709
+//line sql.y:1796
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 286:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1713
+//line sql.y:1800
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 287:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1717
+//line sql.y:1804
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 288:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1721
+//line sql.y:1808
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 289:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1725
+//line sql.y:1812
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 290:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1729
+//line sql.y:1816
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 291:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1733
+//line sql.y:1820
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 292:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1737
+//line sql.y:1824
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 293:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1741
+//line sql.y:1828
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 294:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1745
+//line sql.y:1832
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1749
+//line sql.y:1836
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 296:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1753
+//line sql.y:1840
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 297:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1757
+//line sql.y:1844
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 298:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1761
+//line sql.y:1848
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1765
+//line sql.y:1852
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1769
+//line sql.y:1856
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 301:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1773
+//line sql.y:1860
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
-	case 302:
+	case 314:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1777
+//line sql.y:1864
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
 		}
-	case 303:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1782
+//line sql.y:1869
 		{
 			yyVAL.str = ""
 		}
-	case 304:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1786
+//line sql.y:1873
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 305:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1790
+//line sql.y:1877
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 315:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:1896
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 316:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1900
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 317:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
+//line sql.y:1904
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 318:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1822
+//line sql.y:1909
 		{
 			yyVAL.bytes = []byte("")
 		}
-	case 320:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1828
+//line sql.y:1915
 		{
 			yyVAL.colName = nil
 		}
-	case 321:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1832
+//line sql.y:1919
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 322:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1837
+//line sql.y:1924
 		{
 			yyVAL.colName = nil
 		}
-	case 323:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:1928
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 324:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1846
+//line sql.y:1933
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 325:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:1937
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 326:
+	case 338:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1854
+//line sql.y:1941
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, &OrderByOption{Cols: yyDollar[5].columns})
 		}
-	case 327:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1858
+//line sql.y:1945
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 328:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1862
+//line sql.y:1949
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...)
 		}
-	case 329:
+	case 341:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1866
+//line sql.y:1953
 		{
 			yyVAL.alterOptions = append(append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...), &OrderByOption{Cols: yyDollar[7].columns})
 		}
-	case 330:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1872
+//line sql.y:1959
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 331:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1876
+//line sql.y:1963
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 332:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1880
+//line sql.y:1967
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 333:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1886
+//line sql.y:1973
 		{
 			yyVAL.alterOption = yyDollar[1].tableOptions
 		}
-	case 334:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1890
+//line sql.y:1977
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 335:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1894
+//line sql.y:1981
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 336:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1898
+//line sql.y:1985
 		{
 			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
 		}
-	case 337:
+	case 349:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1902
+//line sql.y:1989
 		{
 			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
 		}
-	case 338:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1906
+//line sql.y:1993
 		{
 			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 339:
+	case 351:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1910
+//line sql.y:1997
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
 		}
-	case 340:
+	case 352:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1914
+//line sql.y:2001
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
 		}
-	case 341:
+	case 353:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1918
+//line sql.y:2005
 		{
 			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
 		}
-	case 342:
+	case 354:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1922
+//line sql.y:2009
 		{
 			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 343:
+	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1926
+//line sql.y:2013
 		{
 			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 344:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1930
+//line sql.y:2017
 		{
 			yyVAL.alterOption = &KeyState{Enable: false}
 		}
-	case 345:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1934
+//line sql.y:2021
 		{
 			yyVAL.alterOption = &KeyState{Enable: true}
 		}
-	case 346:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1938
+//line sql.y:2025
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: false}
 		}
-	case 347:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1942
+//line sql.y:2029
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: true}
 		}
-	case 348:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2033
 		{
 			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
 		}
-	case 349:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1950
+//line sql.y:2037
 		{
 			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
 		}
-	case 350:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1954
+//line sql.y:2041
 		{
 			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
 		}
-	case 351:
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1958
+//line sql.y:2045
 		{
 			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
 		}
-	case 352:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1962
+//line sql.y:2049
 		{
 			yyVAL.alterOption = &Force{}
 		}
-	case 353:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1966
+//line sql.y:2053
 		{
 			yyVAL.alterOption = &RenameTableName{Table: yyDollar[3].tableName}
 		}
-	case 354:
+	case 366:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1970
+//line sql.y:2057
 		{
 			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
 		}
-	case 355:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1976
+//line sql.y:2063
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 356:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1980
+//line sql.y:2067
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 357:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1986
+//line sql.y:2073
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 358:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2077
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 359:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1994
+//line sql.y:2081
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 360:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1998
+//line sql.y:2085
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 361:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2002
+//line sql.y:2089
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 362:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2006
+//line sql.y:2093
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 363:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2010
+//line sql.y:2097
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 364:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2014
+//line sql.y:2101
 		{
 			yyVAL.alterOption = &Validation{With: true}
 		}
-	case 365:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2018
+//line sql.y:2105
 		{
 			yyVAL.alterOption = &Validation{With: false}
 		}
-	case 366:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2024
+//line sql.y:2111
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 367:
+	case 379:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2030
+//line sql.y:2117
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = &PartitionSpec{Action: RemoveAction}
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 368:
+	case 380:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2037
+//line sql.y:2124
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[4].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 369:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2044
+//line sql.y:2131
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[2].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 370:
+	case 382:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2050
+//line sql.y:2137
 		{
 			yyVAL.statement = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columns, Select: yyDollar[9].selStmt, CheckOption: yyDollar[10].str}
 		}
-	case 371:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2141
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.AlterOptions = yyDollar[3].collateAndCharsets
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 372:
+	case 384:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2061
+//line sql.y:2148
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.UpdateDataDirectory = true
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 373:
+	case 385:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2068
+//line sql.y:2155
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -7474,9 +7806,27 @@ yydefault:
 				},
 			}
 		}
-	case 374:
+	case 386:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2167
+		{
+			if string(yyDollar[3].bytes) != "clone" {
+				yylex.Error("expecting clone after vschema")
+				return 1
+			}
+			yyVAL.statement = &AlterVschema{
+				Action:  CloneVindexDDLAction,
+				Table:   yyDollar[5].tableName,
+				NewName: yyDollar[7].tableName,
+				VindexSpec: &VindexSpec{
+					Name:   NewColIdent(yyDollar[7].tableName.Name.String()),
+					Params: yyDollar[8].vindexParams,
+				},
+			}
+		}
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2080
+//line sql.y:2183
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -7486,21 +7836,77 @@ yydefault:
 				},
 			}
 		}
-	case 375:
+	case 388:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2090
+//line sql.y:2193
 		{
 			yyVAL.statement = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 376:
+	case 389:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2094
+//line sql.y:2197
 		{
 			yyVAL.statement = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 377:
+	case 390:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2201
+		{
+			yyVAL.statement = &AlterVschema{Action: RenameVschemaTableDDLAction, Table: yyDollar[5].tableName, NewName: yyDollar[7].tableName}
+		}
+	case 391:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2205
+		{
+			yyVAL.statement = &AlterVschema{Action: CopyKeyspaceDDLAction, Table: TableName{Qualifier: yyDollar[5].tableIdent}, NewName: TableName{Qualifier: yyDollar[7].tableIdent}}
+		}
+	case 392:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2209
+		{
+			if string(yyDollar[3].bytes) != "keyspace" {
+				yylex.Error("expecting keyspace after vschema")
+				return 1
+			}
+			yyVAL.statement = &AlterVschema{Action: SetKeyspaceCommentDDLAction, Table: TableName{Qualifier: yyDollar[4].tableIdent}, Comment: string(yyDollar[8].bytes)}
+		}
+	case 393:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2217
+		{
+			if string(yyDollar[3].bytes) != "apply" {
+				yylex.Error("expecting apply after vschema")
+				return 1
+			}
+			yyVAL.statement = &AlterVschema{Action: ApplyVSchemaScriptDDLAction, Script: string(yyDollar[4].bytes)}
+		}
+	case 394:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2225
+		{
+			yyVAL.statement = &AlterVschema{Action: SetVSchemaLabelDDLAction, Label: string(yyDollar[6].bytes)}
+		}
+	case 395:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2229
+		{
+			yyVAL.statement = &AlterVschema{Action: AddRoutingRuleDDLAction, Table: yyDollar[6].tableName, NewName: yyDollar[8].tableName}
+		}
+	case 396:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2233
+		{
+			yyVAL.statement = &AlterVschema{Action: DropRoutingRuleDDLAction, Table: yyDollar[6].tableName}
+		}
+	case 397:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2237
+		{
+			yyVAL.statement = &AlterVschema{Action: AddReferenceTableDDLAction, Table: yyDollar[6].tableName, ReferenceSource: yyDollar[7].tableName}
+		}
+	case 398:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2098
+//line sql.y:2241
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -7513,9 +7919,66 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 378:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2111
+	case 399:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2254
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: AddColVindexDDLAction,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name:   GenerateVindexName(yyDollar[11].colIdent, yyDollar[8].columns),
+					Type:   yyDollar[11].colIdent,
+					Params: yyDollar[12].vindexParams,
+				},
+				VindexCols: yyDollar[8].columns,
+			}
+		}
+	case 400:
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line sql.y:2267
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: AddColVindexDDLAction,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name:   yyDollar[7].colIdent,
+					Type:   yyDollar[15].colIdent,
+					Params: yyDollar[16].vindexParams,
+				},
+				VindexCols: []ColIdent{yyDollar[9].colIdent},
+				VindexExpr: yyDollar[12].expr,
+			}
+		}
+	case 401:
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line sql.y:2281
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: AddColVindexDDLAction,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name:   GenerateVindexName(yyDollar[15].colIdent, []ColIdent{yyDollar[8].colIdent}),
+					Type:   yyDollar[15].colIdent,
+					Params: yyDollar[16].vindexParams,
+				},
+				VindexCols: []ColIdent{yyDollar[8].colIdent},
+				VindexExpr: yyDollar[11].expr,
+			}
+		}
+	case 402:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2295
+		{
+			yyVAL.statement = &AlterVschema{
+				Action:         AddColVindexesDDLAction,
+				Table:          yyDollar[4].tableName,
+				VindexBindings: yyDollar[8].vindexBindings,
+			}
+		}
+	case 403:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2303
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -7523,17 +7986,63 @@ yydefault:
 				VindexSpec: &VindexSpec{
 					Name: yyDollar[7].colIdent,
 				},
+				Cascade: yyDollar[8].boolean,
+				Force:   yyDollar[9].boolean,
 			}
 		}
-	case 379:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2121
+	case 404:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2315
 		{
-			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
+			yyVAL.statement = &AlterVschema{Action: DropAllColVindexesDDLAction, Table: yyDollar[4].tableName, Cascade: yyDollar[8].boolean}
 		}
-	case 380:
+	case 405:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2319
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: ReorderColVindexDDLAction,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name: yyDollar[6].colIdent,
+				},
+				Anchor: yyDollar[8].colIdent,
+				After:  yyDollar[7].boolean,
+			}
+		}
+	case 406:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2331
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: EnableColVindexDDLAction,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name: yyDollar[7].colIdent,
+				},
+			}
+		}
+	case 407:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2341
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: DisableColVindexDDLAction,
+				Table:  yyDollar[4].tableName,
+				VindexSpec: &VindexSpec{
+					Name: yyDollar[7].colIdent,
+				},
+			}
+		}
+	case 408:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2351
+		{
+			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName, SequenceParams: yyDollar[6].vindexParams}
+		}
+	case 409:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2125
+//line sql.y:2355
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -7544,207 +8053,231 @@ yydefault:
 				},
 			}
 		}
-	case 381:
+	case 410:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:2366
+		{
+			yyVAL.statement = &AlterVschema{
+				Action: SetParentTableDDLAction,
+				Table:  yyDollar[4].tableName,
+				ParentSpec: &ParentSpec{
+					Parent:            yyDollar[7].tableName,
+					Columns:           yyDollar[10].columns,
+					ReferencedColumns: yyDollar[14].columns,
+				},
+			}
+		}
+	case 411:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2378
+		{
+			yyVAL.statement = &AlterVschema{
+				Action:  SetScatterTableDDLAction,
+				Table:   yyDollar[4].tableName,
+				Scatter: bool(yyDollar[8].boolVal),
+			}
+		}
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2138
+//line sql.y:2388
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
 		}
-	case 382:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2142
+//line sql.y:2392
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
 		}
-	case 383:
+	case 414:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2146
+//line sql.y:2396
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
 		}
-	case 384:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2150
+//line sql.y:2400
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
 		}
-	case 385:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2154
+//line sql.y:2404
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
-	case 386:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2158
+//line sql.y:2408
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
 		}
-	case 387:
+	case 418:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2162
+//line sql.y:2412
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
-	case 388:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:2416
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
 		}
-	case 389:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2420
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
-	case 390:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2174
+//line sql.y:2424
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 391:
+	case 422:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2178
+//line sql.y:2428
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
 		}
-	case 392:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2182
+//line sql.y:2432
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
 		}
-	case 393:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2186
+//line sql.y:2436
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
-	case 394:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2190
+//line sql.y:2440
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
 		}
-	case 395:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2194
+//line sql.y:2444
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
-	case 396:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2198
+//line sql.y:2448
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
 		}
-	case 397:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2202
+//line sql.y:2452
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
-	case 398:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2206
+//line sql.y:2456
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
 		}
-	case 399:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2210
+//line sql.y:2460
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
-	case 400:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2464
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
 		}
-	case 401:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2218
+//line sql.y:2468
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
-	case 402:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2222
+//line sql.y:2472
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
 		}
-	case 403:
+	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2227
+//line sql.y:2477
 		{
 			yyVAL.boolean = false
 		}
-	case 404:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2231
+//line sql.y:2481
 		{
 			yyVAL.boolean = false
 		}
-	case 405:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2235
+//line sql.y:2485
 		{
 			yyVAL.boolean = true
 		}
-	case 406:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2242
+//line sql.y:2492
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 407:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2246
+//line sql.y:2496
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 408:
+	case 439:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2252
+//line sql.y:2502
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 409:
+	case 440:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2256
+//line sql.y:2506
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 410:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2262
+//line sql.y:2512
 		{
 			yyVAL.statement = &RenameTable{TablePairs: yyDollar[3].renameTablePairs}
 		}
-	case 411:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2268
+//line sql.y:2518
 		{
 			yyVAL.renameTablePairs = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
-	case 412:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2272
+//line sql.y:2522
 		{
 			yyVAL.renameTablePairs = append(yyDollar[1].renameTablePairs, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 413:
+	case 444:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2278
+//line sql.y:2528
 		{
 			yyVAL.statement = &DropTable{FromTables: yyDollar[5].tableNames, IfExists: yyDollar[4].boolean, Temp: yyDollar[2].boolean}
 		}
-	case 414:
+	case 445:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2282
+//line sql.y:2532
 		{
 			// Change this to an alter statement
 			if yyDollar[3].colIdent.Lowered() == "primary" {
@@ -7753,1280 +8286,1564 @@ yydefault:
 				yyVAL.statement = &AlterTable{Table: yyDollar[5].tableName, AlterOptions: append([]AlterOption{&DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}}, yyDollar[6].alterOptions...)}
 			}
 		}
-	case 415:
+	case 446:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2291
+//line sql.y:2541
 		{
 			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 416:
+	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2295
+//line sql.y:2545
 		{
 			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
 		}
-	case 417:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2301
+//line sql.y:2551
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[3].tableName}
 		}
-	case 418:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2305
+//line sql.y:2555
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[2].tableName}
 		}
-	case 419:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2310
+//line sql.y:2560
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 420:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2316
+//line sql.y:2566
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
 		}
-	case 421:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2320
+//line sql.y:2570
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
 		}
-	case 422:
+	case 453:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2324
+//line sql.y:2574
 		{
 			yyVAL.statement = &Show{&ShowBasic{Full: yyDollar[2].boolean, Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 423:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2328
+//line sql.y:2578
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 424:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2332
+//line sql.y:2582
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 425:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2336
+//line sql.y:2586
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
-	case 426:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2340
+//line sql.y:2590
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
-	case 427:
+	case 458:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2344
+//line sql.y:2594
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
 		}
-	case 428:
+	case 459:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2348
+//line sql.y:2598
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 429:
+	case 460:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2352
+//line sql.y:2602
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 430:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2356
+//line sql.y:2606
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
 		}
-	case 431:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2360
+//line sql.y:2610
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
 		}
-	case 432:
+	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2364
+//line sql.y:2614
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 433:
+	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2368
+//line sql.y:2618
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 434:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2372
+//line sql.y:2622
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 435:
+	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2376
+//line sql.y:2626
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 436:
+	case 467:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2380
+//line sql.y:2630
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 437:
+	case 468:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2384
+//line sql.y:2634
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].boolean, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 438:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2388
+//line sql.y:2638
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].str, Filter: yyDollar[4].showFilter}}
 		}
-	case 439:
+	case 470:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2392
+//line sql.y:2642
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
-	case 440:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2396
+//line sql.y:2646
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
-	case 441:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2400
+//line sql.y:2650
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
-	case 442:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2404
+//line sql.y:2654
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
-	case 443:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2408
+//line sql.y:2658
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
-	case 444:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2412
+//line sql.y:2662
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
-	case 445:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2416
+//line sql.y:2666
 		{
 			yyVAL.statement = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
-	case 446:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2420
+//line sql.y:2670
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 447:
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2424
+//line sql.y:2674
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 448:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2428
+//line sql.y:2678
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 449:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2432
+//line sql.y:2682
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 450:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2436
+//line sql.y:2686
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 451:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2440
+//line sql.y:2690
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 452:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2444
+//line sql.y:2694
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 453:
+	case 484:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2448
+//line sql.y:2698
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 454:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2452
+//line sql.y:2702
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 455:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2707
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 456:
+	case 487:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2711
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[6].tableName, Scope: ImplicitScope}}
+		}
+	case 488:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2715
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Scope: ImplicitScope}}
+		}
+	case 489:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2719
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " params", Table: TableName{Name: yyDollar[4].tableIdent}, Scope: ImplicitScope}}
+		}
+	case 490:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2723
+		{
+			if NewColIdent(yyDollar[4].tableIdent.String()).Lowered() != "stats" {
+				yylex.Error("expecting stats after vschema vindex")
+				return 1
+			}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes) + " stats", Scope: ImplicitScope}}
+		}
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2731
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 457:
+	case 492:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2465
+//line sql.y:2735
 		{
+			if string(yyDollar[3].bytes) != "backfill" {
+				yylex.Error("expecting backfill before on")
+				return 1
+			}
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 458:
+	case 493:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2743
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ShowTablesOpt: &ShowTablesOpt{Filter: yyDollar[4].showFilter}, Scope: ImplicitScope}}
+		}
+	case 494:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2747
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ShowTablesOpt: &ShowTablesOpt{Filter: yyDollar[4].showFilter}, Scope: ImplicitScope}}
+		}
+	case 495:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2751
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
+		}
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2469
+//line sql.y:2755
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 459:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2474
+//line sql.y:2760
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 460:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2488
+//line sql.y:2774
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 461:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2492
+//line sql.y:2778
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 462:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2496
+//line sql.y:2782
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 463:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2502
+//line sql.y:2788
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 464:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2506
+//line sql.y:2792
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 465:
+	case 503:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2512
+//line sql.y:2798
 		{
 			yyVAL.str = ""
 		}
-	case 466:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2516
+//line sql.y:2802
 		{
 			yyVAL.str = "extended "
 		}
-	case 467:
+	case 505:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2522
+//line sql.y:2808
 		{
 			yyVAL.boolean = false
 		}
-	case 468:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2526
+//line sql.y:2812
 		{
 			yyVAL.boolean = true
 		}
-	case 469:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2532
+//line sql.y:2818
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 470:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2536
+//line sql.y:2822
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 471:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2542
+//line sql.y:2828
 		{
 			yyVAL.str = ""
 		}
-	case 472:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2546
+//line sql.y:2832
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 473:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2550
+//line sql.y:2836
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 474:
+	case 512:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2556
+//line sql.y:2842
 		{
 			yyVAL.showFilter = nil
 		}
-	case 475:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2560
+//line sql.y:2846
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 476:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2564
+//line sql.y:2850
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 477:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2570
+//line sql.y:2856
 		{
 			yyVAL.showFilter = nil
 		}
-	case 478:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2574
+//line sql.y:2860
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 479:
+	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2580
+//line sql.y:2866
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 480:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2584
+//line sql.y:2870
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 481:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2588
+//line sql.y:2874
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 482:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2880
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 483:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2598
+//line sql.y:2884
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 484:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2604
+//line sql.y:2890
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 485:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2894
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 486:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2614
+//line sql.y:2900
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 487:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2620
+//line sql.y:2906
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 488:
+	case 526:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2624
+//line sql.y:2910
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 489:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2629
+//line sql.y:2915
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2917
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 529:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2920
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2922
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 531:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2927
+		{
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+		}
+	case 532:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2933
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 533:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2938
+		{
+			yyVAL.explainType = EmptyType
+		}
+	case 534:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2942
+		{
+			yyVAL.explainType = JSONType
+		}
+	case 535:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2946
+		{
+			yyVAL.explainType = TreeType
+		}
+	case 536:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2950
+		{
+			yyVAL.explainType = VitessType
+		}
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2954
+		{
+			yyVAL.explainType = TraditionalType
+		}
+	case 538:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2958
+		{
+			yyVAL.explainType = AnalyzeType
+		}
+	case 539:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2964
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 540:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2968
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 541:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2972
+		{
+			yyVAL.bytes = yyDollar[1].bytes
+		}
+	case 542:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2978
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 543:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2982
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2986
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2990
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 546:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2994
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 547:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2998
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3002
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2631
+//line sql.y:3006
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 550:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3011
+		{
+			yyVAL.str = ""
+		}
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3015
+		{
+			yyVAL.str = yyDollar[1].colIdent.val
+		}
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3019
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 553:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3025
+		{
+			if isVSchemaDescription(yyDollar[2].tableName, yyDollar[3].str) {
+				yyVAL.statement = &ExplainVSchema{Table: TableName{Name: NewTableIdent(yyDollar[3].str)}}
+			} else {
+				yyVAL.statement = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
+			}
+		}
+	case 554:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3033
 		{
-			yyVAL.empty = struct{}{}
+			if !isVSchemaDescription(yyDollar[2].tableName, yyDollar[3].colIdent.String()) {
+				yylex.Error("expecting vschema before qualified table name")
+				return 1
+			}
+			yyVAL.statement = &ExplainVSchema{Table: TableName{Qualifier: NewTableIdent(yyDollar[3].colIdent.String()), Name: yyDollar[5].tableIdent}}
 		}
-	case 491:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2634
+	case 555:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3041
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.statement = &ExplainStmt{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
-	case 492:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2636
+	case 556:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3045
 		{
-			yyVAL.empty = struct{}{}
+			if !isExplainRouting(yyDollar[2].tableName) {
+				yylex.Error("expecting routing before table name")
+				return 1
+			}
+			yyVAL.statement = &ExplainRouting{Table: TableName{Name: NewTableIdent(yyDollar[3].colIdent.String())}, Values: yyDollar[4].valTuple}
 		}
-	case 493:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+	case 557:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3053
 		{
-			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+			if !isExplainRouting(yyDollar[2].tableName) {
+				yylex.Error("expecting routing before table name")
+				return 1
+			}
+			yyVAL.statement = &ExplainRouting{Table: TableName{Qualifier: NewTableIdent(yyDollar[3].colIdent.String()), Name: yyDollar[5].tableIdent}, Values: yyDollar[6].valTuple}
 		}
-	case 494:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2647
+	case 558:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3061
 		{
-			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+			yyVAL.statement = &ExplainShards{Table: yyDollar[3].tableName, Where: NewWhere(WhereClause, yyDollar[5].expr)}
 		}
-	case 495:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2652
+	case 559:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3065
 		{
-			yyVAL.explainType = EmptyType
+			yyVAL.statement = &ExplainVindex{Table: yyDollar[4].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr)}
 		}
-	case 496:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+	case 560:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3071
 		{
-			yyVAL.explainType = JSONType
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "shards" {
+				yylex.Error("expecting shards after explain")
+				return 1
+			}
 		}
-	case 497:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2660
+	case 561:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3080
 		{
-			yyVAL.explainType = TreeType
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "keyspace" {
+				yylex.Error("expecting keyspace after copy")
+				return 1
+			}
 		}
-	case 498:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2664
+	case 562:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3093
 		{
-			yyVAL.explainType = VitessType
+			verb := NewColIdent(string(yyDollar[1].bytes)).Lowered()
+			if msg := checkVSchemaVerb(verb); msg != "" {
+				yylex.Error(msg)
+				return 1
+			}
+			yyVAL.bytes = []byte(verb)
 		}
-	case 499:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2668
+	case 563:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3104
 		{
-			yyVAL.explainType = TraditionalType
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "label" {
+				yylex.Error("expecting label after set")
+				return 1
+			}
 		}
-	case 500:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2672
+//line sql.y:3113
 		{
-			yyVAL.explainType = AnalyzeType
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "rule" {
+				yylex.Error("expecting rule after routing")
+				return 1
+			}
 		}
-	case 501:
+	case 565:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3122
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "route" {
+				yylex.Error("expecting route to")
+				return 1
+			}
+		}
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2678
+//line sql.y:3131
 		{
-			yyVAL.bytes = yyDollar[1].bytes
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "parent" {
+				yylex.Error("expecting parent after set")
+				return 1
+			}
 		}
-	case 502:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2682
+//line sql.y:3143
 		{
-			yyVAL.bytes = yyDollar[1].bytes
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "routing" {
+				yylex.Error("expecting routing")
+				return 1
+			}
 		}
-	case 503:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:3152
 		{
-			yyVAL.bytes = yyDollar[1].bytes
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "reference" {
+				yylex.Error("expecting reference")
+				return 1
+			}
 		}
-	case 504:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2692
+//line sql.y:3161
 		{
-			yyVAL.statement = yyDollar[1].selStmt
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "source" {
+				yylex.Error("expecting source")
+				return 1
+			}
 		}
-	case 505:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2696
+//line sql.y:3170
 		{
-			yyVAL.statement = yyDollar[1].statement
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "scatter" {
+				yylex.Error("expecting scatter after set")
+				return 1
+			}
 		}
-	case 506:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2700
+//line sql.y:3179
 		{
-			yyVAL.statement = yyDollar[1].statement
+			switch word := NewColIdent(string(yyDollar[1].bytes)).Lowered(); word {
+			case "acl", "backfill", "version":
+				yyVAL.bytes = []byte(word)
+			default:
+				yylex.Error("expecting acl, backfill or version after vschema")
+				return 1
+			}
 		}
-	case 507:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2704
+//line sql.y:3191
 		{
-			yyVAL.statement = yyDollar[1].statement
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "params" {
+				yylex.Error("expecting params after vindex type")
+				return 1
+			}
 		}
-	case 508:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2709
+	case 573:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3200
 		{
-			yyVAL.str = ""
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "reorder" {
+				yylex.Error("expecting reorder vindex")
+				return 1
+			}
 		}
-	case 509:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2713
+//line sql.y:3209
 		{
-			yyVAL.str = yyDollar[1].colIdent.val
+			yyVAL.boolean = false
 		}
-	case 510:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2717
+//line sql.y:3213
 		{
-			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+			yyVAL.boolean = true
 		}
-	case 511:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2723
+	case 576:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3219
 		{
-			yyVAL.statement = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
+			yyVAL.statement = &Show{&ShowLegacy{Type: "validate vschema", Scope: ImplicitScope}}
 		}
-	case 512:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2727
+	case 577:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3223
 		{
-			yyVAL.statement = &ExplainStmt{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
+			yyVAL.statement = &Show{&ShowLegacy{Type: "validate vschema routing", Table: TableName{Qualifier: yyDollar[4].tableIdent}, Scope: ImplicitScope}}
 		}
-	case 513:
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3232
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "validate" {
+				yylex.Error("syntax error")
+				return 1
+			}
+		}
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2733
+//line sql.y:3241
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 514:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2737
+//line sql.y:3245
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 515:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2743
+//line sql.y:3251
 		{
 			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
 		}
-	case 516:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2749
+//line sql.y:3257
 		{
 			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
 		}
-	case 517:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2753
+//line sql.y:3261
 		{
 			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
 		}
-	case 518:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2759
+//line sql.y:3267
 		{
 			yyVAL.tableAndLockType = &TableAndLockType{Table: yyDollar[1].aliasedTableName, Lock: yyDollar[2].lockType}
 		}
-	case 519:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:3273
 		{
 			yyVAL.lockType = Read
 		}
-	case 520:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2769
+//line sql.y:3277
 		{
 			yyVAL.lockType = ReadLocal
 		}
-	case 521:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2773
+//line sql.y:3281
 		{
 			yyVAL.lockType = Write
 		}
-	case 522:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2777
+//line sql.y:3285
 		{
 			yyVAL.lockType = LowPriorityWrite
 		}
-	case 523:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2783
+//line sql.y:3291
 		{
 			yyVAL.statement = &UnlockTables{}
 		}
-	case 524:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2789
+//line sql.y:3297
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, FlushOptions: yyDollar[3].strs}
 		}
-	case 525:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2793
+//line sql.y:3301
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean}
 		}
-	case 526:
+	case 592:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2797
+//line sql.y:3305
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, WithLock: true}
 		}
-	case 527:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2801
+//line sql.y:3309
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames}
 		}
-	case 528:
+	case 594:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2805
+//line sql.y:3313
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, WithLock: true}
 		}
-	case 529:
+	case 595:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2809
+//line sql.y:3317
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, ForExport: true}
 		}
-	case 530:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2815
+//line sql.y:3323
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 531:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2819
+//line sql.y:3327
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 532:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2825
+//line sql.y:3333
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 533:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2829
+//line sql.y:3337
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 534:
+	case 600:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2833
+//line sql.y:3341
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 535:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2837
+//line sql.y:3345
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 536:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2841
+//line sql.y:3349
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 537:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2845
+//line sql.y:3353
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 538:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2849
+//line sql.y:3357
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 539:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2853
+//line sql.y:3361
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + yyDollar[3].str
 		}
-	case 540:
+	case 606:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2857
+//line sql.y:3365
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 541:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2861
+//line sql.y:3369
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 542:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2865
+//line sql.y:3373
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 543:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2869
+//line sql.y:3377
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 544:
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2874
+//line sql.y:3382
 		{
 			yyVAL.boolean = false
 		}
-	case 545:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2878
+//line sql.y:3386
 		{
 			yyVAL.boolean = true
 		}
-	case 546:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2882
+//line sql.y:3390
 		{
 			yyVAL.boolean = true
 		}
-	case 547:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2887
+//line sql.y:3395
 		{
 			yyVAL.str = ""
 		}
-	case 548:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2891
+//line sql.y:3399
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].colIdent.String()
 		}
-	case 549:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2896
+//line sql.y:3404
 		{
 			setAllowComments(yylex, true)
 		}
-	case 550:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2900
+//line sql.y:3408
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 551:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2906
+//line sql.y:3414
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 552:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2910
+//line sql.y:3418
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 553:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2916
+//line sql.y:3424
 		{
 			yyVAL.boolean = true
 		}
-	case 554:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2920
+//line sql.y:3428
 		{
 			yyVAL.boolean = false
 		}
-	case 555:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2924
+//line sql.y:3432
 		{
 			yyVAL.boolean = true
 		}
-	case 556:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2929
+//line sql.y:3437
 		{
 			yyVAL.str = ""
 		}
-	case 557:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:3441
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 558:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2937
+//line sql.y:3445
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 559:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2942
+//line sql.y:3450
 		{
 			yyVAL.boolean = false
 		}
-	case 560:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2946
+//line sql.y:3454
 		{
 			yyVAL.boolean = true
 		}
-	case 561:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:3458
 		{
 			yyVAL.boolean = true
 		}
-	case 562:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:3463
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 563:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2959
+//line sql.y:3467
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 564:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2964
+//line sql.y:3472
 		{
 			yyVAL.strs = nil
 		}
-	case 565:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2968
+//line sql.y:3476
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 566:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2972
+//line sql.y:3480
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 567:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2976
+//line sql.y:3484
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 568:
+	case 634:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2980
+//line sql.y:3488
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 569:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2986
+//line sql.y:3494
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 570:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2990
+//line sql.y:3498
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 571:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2994
+//line sql.y:3502
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 572:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2998
+//line sql.y:3506
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 573:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3002
+//line sql.y:3510
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 574:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3006
+//line sql.y:3514
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 575:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3012
+//line sql.y:3520
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 576:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3524
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 577:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3022
+//line sql.y:3530
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 578:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3026
+//line sql.y:3534
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 579:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3030
+//line sql.y:3538
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 580:
+	case 646:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3034
+//line sql.y:3542
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 581:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3039
+//line sql.y:3547
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 582:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3043
+//line sql.y:3551
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 583:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3047
+//line sql.y:3555
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 585:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3054
+//line sql.y:3562
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 586:
+	case 652:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3059
+//line sql.y:3567
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 587:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3063
+//line sql.y:3571
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 588:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3069
+//line sql.y:3577
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 589:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3073
+//line sql.y:3581
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 592:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3083
+//line sql.y:3591
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 593:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3087
+//line sql.y:3595
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].derivedTable, As: yyDollar[3].tableIdent}
 		}
-	case 594:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3091
+//line sql.y:3599
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 595:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3097
+//line sql.y:3605
 		{
 			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
 		}
-	case 596:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3103
+//line sql.y:3611
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 597:
+	case 663:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3107
+//line sql.y:3615
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 598:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3112
+//line sql.y:3620
 		{
 			yyVAL.columns = nil
 		}
-	case 599:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3116
+//line sql.y:3624
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 600:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3122
+//line sql.y:3630
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 601:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3126
+//line sql.y:3634
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 602:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3132
+//line sql.y:3640
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 603:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3644
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 604:
+	case 670:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3149
+//line sql.y:3657
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 605:
+	case 671:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3153
+//line sql.y:3661
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 606:
+	case 672:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3157
+//line sql.y:3665
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 607:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3161
+//line sql.y:3669
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 608:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3167
+//line sql.y:3675
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 609:
+	case 675:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3169
+//line sql.y:3677
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 610:
+	case 676:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3173
+//line sql.y:3681
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 611:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3175
+//line sql.y:3683
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 612:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3179
+//line sql.y:3687
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 613:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3181
+//line sql.y:3689
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 614:
+	case 680:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3184
+//line sql.y:3692
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 615:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3186
+//line sql.y:3694
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 616:
+	case 682:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3189
+//line sql.y:3697
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 617:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3193
+//line sql.y:3701
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 618:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3197
+//line sql.y:3705
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 620:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3204
+//line sql.y:3712
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 621:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3718
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 622:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3214
+//line sql.y:3722
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 623:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3218
+//line sql.y:3726
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 624:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3224
+//line sql.y:3732
 		{
 			yyVAL.joinType = StraightJoinType
 		}
-	case 625:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3230
+//line sql.y:3738
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 626:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3234
+//line sql.y:3742
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 627:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3238
+//line sql.y:3746
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 628:
+	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3242
+//line sql.y:3750
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 629:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3248
+//line sql.y:3756
 		{
 			yyVAL.joinType = NaturalJoinType
 		}
-	case 630:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3252
+//line sql.y:3760
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -9034,489 +9851,489 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 631:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3262
+//line sql.y:3770
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 632:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3266
+//line sql.y:3774
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 633:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3272
+//line sql.y:3780
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 634:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3276
+//line sql.y:3784
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 635:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3282
+//line sql.y:3790
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 636:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3287
+//line sql.y:3795
 		{
 			yyVAL.indexHints = nil
 		}
-	case 637:
+	case 703:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3291
+//line sql.y:3799
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 638:
+	case 704:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3295
+//line sql.y:3803
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 639:
+	case 705:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3299
+//line sql.y:3807
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 640:
+	case 706:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3303
+//line sql.y:3811
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 641:
+	case 707:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3308
+//line sql.y:3816
 		{
 			yyVAL.expr = nil
 		}
-	case 642:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3312
+//line sql.y:3820
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 643:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3318
+//line sql.y:3826
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 644:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3322
+//line sql.y:3830
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 645:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3326
+//line sql.y:3834
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 646:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3330
+//line sql.y:3838
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 647:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3334
+//line sql.y:3842
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 648:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3338
+//line sql.y:3846
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
-	case 649:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3342
+//line sql.y:3850
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 650:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3346
+//line sql.y:3854
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 651:
+	case 717:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3352
+//line sql.y:3860
 		{
 			yyVAL.str = ""
 		}
-	case 652:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3356
+//line sql.y:3864
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 653:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3362
+//line sql.y:3870
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 654:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3366
+//line sql.y:3874
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 655:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3372
+//line sql.y:3880
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
-	case 656:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3376
+//line sql.y:3884
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
-	case 657:
+	case 723:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3380
+//line sql.y:3888
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
-	case 658:
+	case 724:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3384
+//line sql.y:3892
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 659:
+	case 725:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3388
+//line sql.y:3896
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 660:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3392
+//line sql.y:3900
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
-	case 661:
+	case 727:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3396
+//line sql.y:3904
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
-	case 662:
+	case 728:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3400
+//line sql.y:3908
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 663:
+	case 729:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3404
+//line sql.y:3912
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 664:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3408
+//line sql.y:3916
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 665:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3414
+//line sql.y:3922
 		{
 			yyVAL.isExprOperator = IsNullOp
 		}
-	case 666:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3418
+//line sql.y:3926
 		{
 			yyVAL.isExprOperator = IsNotNullOp
 		}
-	case 667:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3422
+//line sql.y:3930
 		{
 			yyVAL.isExprOperator = IsTrueOp
 		}
-	case 668:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3426
+//line sql.y:3934
 		{
 			yyVAL.isExprOperator = IsNotTrueOp
 		}
-	case 669:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3430
+//line sql.y:3938
 		{
 			yyVAL.isExprOperator = IsFalseOp
 		}
-	case 670:
+	case 736:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3434
+//line sql.y:3942
 		{
 			yyVAL.isExprOperator = IsNotFalseOp
 		}
-	case 671:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3440
+//line sql.y:3948
 		{
 			yyVAL.comparisonExprOperator = EqualOp
 		}
-	case 672:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3444
+//line sql.y:3952
 		{
 			yyVAL.comparisonExprOperator = LessThanOp
 		}
-	case 673:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3448
+//line sql.y:3956
 		{
 			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
-	case 674:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3452
+//line sql.y:3960
 		{
 			yyVAL.comparisonExprOperator = LessEqualOp
 		}
-	case 675:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3456
+//line sql.y:3964
 		{
 			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
-	case 676:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3460
+//line sql.y:3968
 		{
 			yyVAL.comparisonExprOperator = NotEqualOp
 		}
-	case 677:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3464
+//line sql.y:3972
 		{
 			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
-	case 678:
+	case 744:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3469
+//line sql.y:3977
 		{
 			yyVAL.expr = nil
 		}
-	case 679:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3473
+//line sql.y:3981
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 680:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3479
+//line sql.y:3987
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 681:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3483
+//line sql.y:3991
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 682:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3487
+//line sql.y:3995
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 683:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3493
+//line sql.y:4001
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 684:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3499
+//line sql.y:4007
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 685:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3503
+//line sql.y:4011
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 686:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3509
+//line sql.y:4017
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 687:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3513
+//line sql.y:4021
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 688:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3517
+//line sql.y:4025
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 689:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3521
+//line sql.y:4029
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 690:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3525
+//line sql.y:4033
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 691:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3529
+//line sql.y:4037
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
-	case 692:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3533
+//line sql.y:4041
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
-	case 693:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3537
+//line sql.y:4045
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
-	case 694:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3541
+//line sql.y:4049
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
-	case 695:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3545
+//line sql.y:4053
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
-	case 696:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3549
+//line sql.y:4057
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
-	case 697:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3553
+//line sql.y:4061
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
-	case 698:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3557
+//line sql.y:4065
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
-	case 699:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3561
+//line sql.y:4069
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 700:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3565
+//line sql.y:4073
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 701:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3569
+//line sql.y:4077
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
-	case 702:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3573
+//line sql.y:4081
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
-	case 703:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3577
+//line sql.y:4085
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 704:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3581
+//line sql.y:4089
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 705:
+	case 771:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3585
+//line sql.y:4093
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 706:
+	case 772:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3589
+//line sql.y:4097
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 707:
+	case 773:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3593
+//line sql.y:4101
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 708:
+	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3597
+//line sql.y:4105
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
-	case 709:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3601
+//line sql.y:4109
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
 		}
-	case 710:
+	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3605
+//line sql.y:4113
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
 		}
-	case 711:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3609
+//line sql.y:4117
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -9524,9 +10341,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 712:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3617
+//line sql.y:4125
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -9540,21 +10357,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 713:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3631
+//line sql.y:4139
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 714:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3635
+//line sql.y:4143
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 715:
+	case 781:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3639
+//line sql.y:4147
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -9562,503 +10379,503 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 720:
+	case 786:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3657
+//line sql.y:4165
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 721:
+	case 787:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3661
+//line sql.y:4169
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 722:
+	case 788:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3665
+//line sql.y:4173
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 723:
+	case 789:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3669
+//line sql.y:4177
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 724:
+	case 790:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3679
+//line sql.y:4187
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 725:
+	case 791:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3683
+//line sql.y:4191
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 726:
+	case 792:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3687
+//line sql.y:4195
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 727:
+	case 793:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3691
+//line sql.y:4199
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 728:
+	case 794:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3695
+//line sql.y:4203
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 729:
+	case 795:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3699
+//line sql.y:4207
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 730:
+	case 796:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3703
+//line sql.y:4211
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 731:
+	case 797:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3707
+//line sql.y:4215
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 732:
+	case 798:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3711
+//line sql.y:4219
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 733:
+	case 799:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3715
+//line sql.y:4223
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
 		}
-	case 734:
+	case 800:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3719
+//line sql.y:4227
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 735:
+	case 801:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3723
+//line sql.y:4231
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 736:
+	case 802:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3727
+//line sql.y:4235
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 737:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3737
+//line sql.y:4245
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 738:
+	case 804:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3741
+//line sql.y:4249
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 739:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3745
+//line sql.y:4253
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 740:
+	case 806:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3750
+//line sql.y:4258
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 741:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3755
+//line sql.y:4263
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 742:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3760
+//line sql.y:4268
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 743:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3766
+//line sql.y:4274
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 744:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3771
+//line sql.y:4279
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 745:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3776
+//line sql.y:4284
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 746:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3780
+//line sql.y:4288
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 747:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3784
+//line sql.y:4292
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 748:
+	case 814:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3789
+//line sql.y:4297
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 749:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3794
+//line sql.y:4302
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 750:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3799
+//line sql.y:4307
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 751:
+	case 817:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3803
+//line sql.y:4311
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 752:
+	case 818:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3807
+//line sql.y:4315
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 755:
+	case 821:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3817
+//line sql.y:4325
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 756:
+	case 822:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3827
+//line sql.y:4335
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 757:
+	case 823:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3831
+//line sql.y:4339
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 758:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3835
+//line sql.y:4343
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 759:
+	case 825:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3839
+//line sql.y:4347
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 760:
+	case 826:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3843
+//line sql.y:4351
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 761:
+	case 827:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3847
+//line sql.y:4355
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 762:
+	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3851
+//line sql.y:4359
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 763:
+	case 829:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3857
+//line sql.y:4365
 		{
 			yyVAL.matchExprOption = NoOption
 		}
-	case 764:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3861
+//line sql.y:4369
 		{
 			yyVAL.matchExprOption = BooleanModeOpt
 		}
-	case 765:
+	case 831:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3865
+//line sql.y:4373
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
-	case 766:
+	case 832:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3869
+//line sql.y:4377
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
-	case 767:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3873
+//line sql.y:4381
 		{
 			yyVAL.matchExprOption = QueryExpansionOpt
 		}
-	case 768:
+	case 834:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3879
+//line sql.y:4387
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 769:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3883
+//line sql.y:4391
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 770:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3887
+//line sql.y:4395
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 771:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3893
+//line sql.y:4401
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 772:
+	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3897
+//line sql.y:4405
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
-	case 773:
+	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3901
+//line sql.y:4409
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 774:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3905
+//line sql.y:4413
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 775:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3909
+//line sql.y:4417
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 776:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3913
+//line sql.y:4421
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 777:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3919
+//line sql.y:4427
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 778:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3923
+//line sql.y:4431
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 779:
+	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3927
+//line sql.y:4435
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 780:
+	case 846:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3931
+//line sql.y:4439
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 781:
+	case 847:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3935
+//line sql.y:4443
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 782:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3939
+//line sql.y:4447
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 783:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3943
+//line sql.y:4451
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 784:
+	case 850:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3948
+//line sql.y:4456
 		{
 			yyVAL.expr = nil
 		}
-	case 785:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3952
+//line sql.y:4460
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 786:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3957
+//line sql.y:4465
 		{
 			yyVAL.str = string("")
 		}
-	case 787:
+	case 853:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3961
+//line sql.y:4469
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 788:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3967
+//line sql.y:4475
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 789:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3971
+//line sql.y:4479
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 790:
+	case 856:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3977
+//line sql.y:4485
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 791:
+	case 857:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3982
+//line sql.y:4490
 		{
 			yyVAL.expr = nil
 		}
-	case 792:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3986
+//line sql.y:4494
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 793:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3992
+//line sql.y:4500
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 794:
+	case 860:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3996
+//line sql.y:4504
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 795:
+	case 861:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4000
+//line sql.y:4508
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 796:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4006
+//line sql.y:4514
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 797:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4010
+//line sql.y:4518
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 798:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4014
+//line sql.y:4522
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 799:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4018
+//line sql.y:4526
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 800:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4022
+//line sql.y:4530
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 801:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4026
+//line sql.y:4534
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 802:
+	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4030
+//line sql.y:4538
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 803:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4034
+//line sql.y:4542
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 804:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4040
+//line sql.y:4548
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -10067,597 +10884,597 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 805:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4049
+//line sql.y:4557
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 806:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4053
+//line sql.y:4561
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 807:
+	case 873:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4058
+//line sql.y:4566
 		{
 			yyVAL.exprs = nil
 		}
-	case 808:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4062
+//line sql.y:4570
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 809:
+	case 875:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4067
+//line sql.y:4575
 		{
 			yyVAL.expr = nil
 		}
-	case 810:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4071
+//line sql.y:4579
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 811:
+	case 877:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4076
+//line sql.y:4584
 		{
 			yyVAL.orderBy = nil
 		}
-	case 812:
+	case 878:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4080
+//line sql.y:4588
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 813:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4086
+//line sql.y:4594
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 814:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4090
+//line sql.y:4598
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 815:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4096
+//line sql.y:4604
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 816:
+	case 882:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4101
+//line sql.y:4609
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 817:
+	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4105
+//line sql.y:4613
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 818:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4109
+//line sql.y:4617
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 819:
+	case 885:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4114
+//line sql.y:4622
 		{
 			yyVAL.limit = nil
 		}
-	case 820:
+	case 886:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4118
+//line sql.y:4626
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 821:
+	case 887:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4122
+//line sql.y:4630
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 822:
+	case 888:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4126
+//line sql.y:4634
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 823:
+	case 889:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4131
+//line sql.y:4639
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 824:
+	case 890:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4135
+//line sql.y:4643
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 825:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4139
+//line sql.y:4647
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 826:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4143
+//line sql.y:4651
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 827:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4147
+//line sql.y:4655
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 828:
+	case 894:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4154
+//line sql.y:4662
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 829:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4158
+//line sql.y:4666
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 830:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4162
+//line sql.y:4670
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 831:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4166
+//line sql.y:4674
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 832:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4172
+//line sql.y:4680
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 833:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4176
+//line sql.y:4684
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 834:
+	case 900:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4180
+//line sql.y:4688
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 835:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4185
+//line sql.y:4693
 		{
 			yyVAL.str = ""
 		}
-	case 836:
+	case 902:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4189
+//line sql.y:4697
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 837:
+	case 903:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4193
+//line sql.y:4701
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 838:
+	case 904:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4197
+//line sql.y:4705
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 839:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4202
+//line sql.y:4710
 		{
 			yyVAL.str = ""
 		}
-	case 840:
+	case 906:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4206
+//line sql.y:4714
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 841:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4212
+//line sql.y:4720
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 842:
+	case 908:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4216
+//line sql.y:4724
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 843:
+	case 909:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4221
+//line sql.y:4729
 		{
 			yyVAL.str = ""
 		}
-	case 844:
+	case 910:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4225
+//line sql.y:4733
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 845:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4230
+//line sql.y:4738
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 846:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4234
+//line sql.y:4742
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 847:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4238
+//line sql.y:4746
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 848:
+	case 914:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4243
+//line sql.y:4751
 		{
 			yyVAL.str = ""
 		}
-	case 849:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4247
+//line sql.y:4755
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 850:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4253
+//line sql.y:4761
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 851:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4257
+//line sql.y:4765
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 852:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4261
+//line sql.y:4769
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'@" + string(yyDollar[2].bytes)
 		}
-	case 853:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4265
+//line sql.y:4773
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 854:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4270
+//line sql.y:4778
 		{
 			yyVAL.lock = NoLock
 		}
-	case 855:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4274
+//line sql.y:4782
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 856:
+	case 922:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4278
+//line sql.y:4786
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 857:
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4283
+//line sql.y:4791
 		{
 			yyVAL.selectInto = nil
 		}
-	case 858:
+	case 924:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:4287
+//line sql.y:4795
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 859:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4291
+//line sql.y:4799
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 860:
+	case 926:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4295
+//line sql.y:4803
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 861:
+	case 927:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4300
+//line sql.y:4808
 		{
 			yyVAL.str = ""
 		}
-	case 862:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4304
+//line sql.y:4812
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 863:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4308
+//line sql.y:4816
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 864:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4313
+//line sql.y:4821
 		{
 			yyVAL.str = ""
 		}
-	case 865:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4317
+//line sql.y:4825
 		{
 			yyVAL.str = " header"
 		}
-	case 866:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4322
+//line sql.y:4830
 		{
 			yyVAL.str = ""
 		}
-	case 867:
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4326
+//line sql.y:4834
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 868:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4330
+//line sql.y:4838
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 869:
+	case 935:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4335
+//line sql.y:4843
 		{
 			yyVAL.str = ""
 		}
-	case 870:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4339
+//line sql.y:4847
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 871:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4343
+//line sql.y:4851
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 872:
+	case 938:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4349
+//line sql.y:4857
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 873:
+	case 939:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4354
+//line sql.y:4862
 		{
 			yyVAL.str = ""
 		}
-	case 874:
+	case 940:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4358
+//line sql.y:4866
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 875:
+	case 941:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4363
+//line sql.y:4871
 		{
 			yyVAL.str = ""
 		}
-	case 876:
+	case 942:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4367
+//line sql.y:4875
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 877:
+	case 943:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4372
+//line sql.y:4880
 		{
 			yyVAL.str = ""
 		}
-	case 878:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4376
+//line sql.y:4884
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 879:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4381
+//line sql.y:4889
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 946:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4385
+//line sql.y:4893
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 881:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4390
+//line sql.y:4898
 		{
 			yyVAL.str = ""
 		}
-	case 882:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4394
+//line sql.y:4902
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 883:
+	case 949:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4399
+//line sql.y:4907
 		{
 			yyVAL.str = ""
 		}
-	case 884:
+	case 950:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4403
+//line sql.y:4911
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 885:
+	case 951:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4408
+//line sql.y:4916
 		{
 			yyVAL.str = ""
 		}
-	case 886:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4412
+//line sql.y:4920
 		{
 			yyVAL.str = " optionally"
 		}
-	case 887:
+	case 953:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4425
+//line sql.y:4933
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 888:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4429
+//line sql.y:4937
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 889:
+	case 955:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4433
+//line sql.y:4941
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 890:
+	case 956:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4437
+//line sql.y:4945
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 891:
+	case 957:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4441
+//line sql.y:4949
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 892:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4447
+//line sql.y:4955
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 893:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4451
+//line sql.y:4959
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 894:
+	case 960:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4455
+//line sql.y:4963
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 895:
+	case 961:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4459
+//line sql.y:4967
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 896:
+	case 962:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4464
+//line sql.y:4972
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 897:
+	case 963:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4468
+//line sql.y:4976
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 898:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4474
+//line sql.y:4982
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 899:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4478
+//line sql.y:4986
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 900:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4484
+//line sql.y:4992
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 901:
+	case 967:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4488
+//line sql.y:4996
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 902:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4494
+//line sql.y:5002
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 903:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4500
+//line sql.y:5008
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -10665,265 +11482,265 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 904:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4510
+//line sql.y:5018
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 905:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4514
+//line sql.y:5022
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 906:
+	case 972:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4520
+//line sql.y:5028
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 907:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4526
+//line sql.y:5034
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 908:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4530
+//line sql.y:5038
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 909:
+	case 975:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4536
+//line sql.y:5044
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 910:
+	case 976:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4540
+//line sql.y:5048
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 911:
+	case 977:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4544
+//line sql.y:5052
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
-	case 912:
+	case 978:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4548
+//line sql.y:5056
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
 		}
-	case 913:
+	case 979:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4552
+//line sql.y:5060
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 915:
+	case 981:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4560
+//line sql.y:5068
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 918:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4570
+//line sql.y:5078
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 919:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4574
+//line sql.y:5082
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 920:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4578
+//line sql.y:5086
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 923:
+	case 989:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4587
+//line sql.y:5095
 		{
 			yyVAL.boolean = false
 		}
-	case 924:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4589
+//line sql.y:5097
 		{
 			yyVAL.boolean = true
 		}
-	case 925:
+	case 991:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4592
+//line sql.y:5100
 		{
 			yyVAL.boolean = false
 		}
-	case 926:
+	case 992:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4594
+//line sql.y:5102
 		{
 			yyVAL.boolean = true
 		}
-	case 927:
+	case 993:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4597
+//line sql.y:5105
 		{
 			yyVAL.boolean = false
 		}
-	case 928:
+	case 994:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4599
+//line sql.y:5107
 		{
 			yyVAL.boolean = true
 		}
-	case 929:
+	case 995:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4602
+//line sql.y:5110
 		{
 			yyVAL.ignore = false
 		}
-	case 930:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4604
+//line sql.y:5112
 		{
 			yyVAL.ignore = true
 		}
-	case 931:
+	case 997:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4607
+//line sql.y:5115
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 932:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4609
+//line sql.y:5117
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 933:
+	case 999:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4611
+//line sql.y:5119
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 934:
+	case 1000:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4615
+//line sql.y:5123
 		{
 			yyVAL.statement = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprs}
 		}
-	case 935:
+	case 1001:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4620
+//line sql.y:5128
 		{
 			yyVAL.exprs = nil
 		}
-	case 936:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4624
+//line sql.y:5132
 		{
 			yyVAL.exprs = yyDollar[1].exprs
 		}
-	case 937:
+	case 1003:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4629
+//line sql.y:5137
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 938:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4631
+//line sql.y:5139
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 939:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4635
+//line sql.y:5143
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[2].colIdent.String())}
 		}
-	case 940:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4641
+//line sql.y:5149
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 941:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4645
+//line sql.y:5153
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 943:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4652
+//line sql.y:5160
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 944:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4658
+//line sql.y:5166
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 945:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4662
+//line sql.y:5170
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 947:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4669
+//line sql.y:5177
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1346:
+	case 1413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5092
+//line sql.y:5601
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1347:
+	case 1414:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5101
+//line sql.y:5610
 		{
 			decNesting(yylex)
 		}
-	case 1348:
+	case 1415:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5106
+//line sql.y:5615
 		{
 			skipToEnd(yylex)
 		}
-	case 1349:
+	case 1416:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5111
+//line sql.y:5620
 		{
 			skipToEnd(yylex)
 		}
-	case 1350:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5115
+//line sql.y:5624
 		{
 			skipToEnd(yylex)
 		}
-	case 1351:
+	case 1418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5119
+//line sql.y:5628
 		{
 			skipToEnd(yylex)
 		}
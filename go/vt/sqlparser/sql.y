@@ -41,6 +41,33 @@ func decNesting(yylex interface{}) {
   yylex.(*Tokenizer).nesting--
 }
 
+// vschemaVerbs are the words that may follow ALTER VSCHEMA without being
+// keywords.
+var vschemaVerbs = []string{"keyspace", "apply", "clone"}
+
+// checkVSchemaVerb returns the error for a word that is not one of the
+// vschemaVerbs, or an empty string. The error names the verb the word was
+// likely meant to be, the one it shares the longest prefix with.
+func checkVSchemaVerb(verb string) string {
+  best, bestLen := "", 0
+  for _, want := range vschemaVerbs {
+    if verb == want {
+      return ""
+    }
+    n := 0
+    for n < len(verb) && n < len(want) && verb[n] == want[n] {
+      n++
+    }
+    if n > bestLen {
+      best, bestLen = want, n
+    }
+  }
+  if best == "" {
+    return "expecting keyspace, apply or clone after vschema"
+  }
+  return "expecting " + best + " after vschema"
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -118,6 +145,7 @@ func skipToEnd(yylex interface{}) {
   partSpecs     []*PartitionSpec
   vindexParam   VindexParam
   vindexParams  []VindexParam
+  vindexBindings []*VindexBinding
   showFilter    *ShowFilter
   optLike       *OptLike
   isolationLevel IsolationLevel
@@ -202,7 +230,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <bytes> VINDEX VINDEXES DIRECTORY NAME UPGRADE
 %token <bytes> STATUS VARIABLES WARNINGS CASCADED DEFINER OPTION SQL UNDEFINED
-%token <bytes> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
+%token <bytes> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST BEFORE
 
 // Transaction Tokens
 %token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE WORK
@@ -279,12 +307,12 @@ func skipToEnd(yylex interface{}) {
 %type <boolean> default_optional
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
-%type <statement> lock_statement unlock_statement call_statement
+%type <statement> lock_statement unlock_statement call_statement validate_statement
 %type <bytes2> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
 %type <insertAction> insert_or_replace
-%type <bytes> explain_synonyms
+%type <bytes> explain_synonyms vschema_word vschema_verb
 %type <str> cache_opt separator_opt flush_option for_channel_opt
 %type <matchExprOption> match_option
 %type <boolean> distinct_opt union_op replace_opt local_opt
@@ -300,7 +328,7 @@ func skipToEnd(yylex interface{}) {
 %type <joinCondition> join_condition join_condition_opt on_expression_opt
 %type <tableNames> table_name_list delete_table_list view_name_list
 %type <joinType> inner_join outer_join straight_join natural_join
-%type <tableName> table_name into_table_name delete_table_name
+%type <tableName> table_name into_table_name delete_table_name reference_source_opt
 %type <aliasedTableName> aliased_table_name
 %type <indexHints> index_hint_list
 %type <expr> where_expression_opt
@@ -365,7 +393,7 @@ func skipToEnd(yylex interface{}) {
 %type <literal> length_opt
 %type <str> charset_opt collate_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
-%type <boolean> unsigned_opt zero_fill_opt without_valid_opt
+%type <boolean> unsigned_opt zero_fill_opt without_valid_opt cascade_opt force_opt before_or_after
 %type <strs> enum_values
 %type <columnDefinition> column_definition
 %type <columnDefinitions> column_definition_list
@@ -390,6 +418,7 @@ func skipToEnd(yylex interface{}) {
 %type <partSpec> partition_operation
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
+%type <vindexBindings> vindex_binding_list
 %type <colIdent> id_or_var vindex_type vindex_type_opt id_or_var_opt
 %type <bytes> database_or_schema column_opt insert_method_options row_format_options
 %type <ReferenceAction> fk_reference_action fk_on_delete fk_on_update
@@ -447,6 +476,7 @@ command:
 | lock_statement
 | unlock_statement
 | call_statement
+| validate_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -826,6 +856,63 @@ vindex_param:
     $$ = VindexParam{Key: $1, Val: $3}
   }
 
+// The params of a binding are separated by commas, like the bindings,
+// so a binding is only known to end when the next one starts.
+vindex_binding_list:
+  sql_id USING vindex_type
+  {
+    $$ = []*VindexBinding{{Column: $1, Spec: &VindexSpec{Name: GenerateVindexName($3, []ColIdent{$1}), Type: $3}}}
+  }
+| sql_id USING vindex_type WITH vindex_param
+  {
+    $$ = []*VindexBinding{{Column: $1, Spec: &VindexSpec{Name: GenerateVindexName($3, []ColIdent{$1}), Type: $3, Params: []VindexParam{$5}}}}
+  }
+| vindex_binding_list ',' sql_id USING vindex_type
+  {
+    $$ = append($1, &VindexBinding{Column: $3, Spec: &VindexSpec{Name: GenerateVindexName($5, []ColIdent{$3}), Type: $5}})
+  }
+| vindex_binding_list ',' sql_id USING vindex_type WITH vindex_param
+  {
+    $$ = append($1, &VindexBinding{Column: $3, Spec: &VindexSpec{Name: GenerateVindexName($5, []ColIdent{$3}), Type: $5, Params: []VindexParam{$7}}})
+  }
+| vindex_binding_list ',' vindex_param
+  {
+    last := $1[len($1)-1]
+    if len(last.Spec.Params) == 0 {
+      yylex.Error("vindex params must follow WITH")
+      return 1
+    }
+    last.Spec.Params = append(last.Spec.Params, $3)
+    $$ = $1
+  }
+
+cascade_opt:
+  {
+    $$ = false
+  }
+| CASCADE
+  {
+    $$ = true
+  }
+
+force_opt:
+  {
+    $$ = false
+  }
+| FORCE
+  {
+    $$ = true
+  }
+
+reference_source_opt:
+  {
+    $$ = TableName{}
+  }
+| vschema_source '=' table_name
+  {
+    $$ = $3
+  }
+
 create_table_prefix:
   CREATE temp_opt TABLE not_exists_opt table_name
   {
@@ -2076,6 +2163,22 @@ alter_statement:
         },
       }
   }
+| ALTER VSCHEMA vschema_verb VINDEX table_name AS table_name vindex_params_opt
+  {
+    if string($3) != "clone" {
+      yylex.Error("expecting clone after vschema")
+      return 1
+    }
+    $$ = &AlterVschema{
+        Action: CloneVindexDDLAction,
+        Table: $5,
+        NewName: $7,
+        VindexSpec: &VindexSpec{
+          Name: NewColIdent($7.Name.String()),
+          Params: $8,
+        },
+      }
+  }
 | ALTER VSCHEMA DROP VINDEX table_name
   {
     $$ = &AlterVschema{
@@ -2094,6 +2197,46 @@ alter_statement:
   {
     $$ = &AlterVschema{Action: DropVschemaTableDDLAction, Table: $5}
   }
+| ALTER VSCHEMA RENAME TABLE table_name TO table_name
+  {
+    $$ = &AlterVschema{Action: RenameVschemaTableDDLAction, Table: $5, NewName: $7}
+  }
+| ALTER VSCHEMA COPY copy_keyspace table_id TO table_id
+  {
+    $$ = &AlterVschema{Action: CopyKeyspaceDDLAction, Table: TableName{Qualifier: $5}, NewName: TableName{Qualifier: $7}}
+  }
+| ALTER VSCHEMA vschema_verb table_id SET COMMENT_KEYWORD equal_opt STRING
+  {
+    if string($3) != "keyspace" {
+      yylex.Error("expecting keyspace after vschema")
+      return 1
+    }
+    $$ = &AlterVschema{Action: SetKeyspaceCommentDDLAction, Table: TableName{Qualifier: $4}, Comment: string($8)}
+  }
+| ALTER VSCHEMA vschema_verb STRING
+  {
+    if string($3) != "apply" {
+      yylex.Error("expecting apply after vschema")
+      return 1
+    }
+    $$ = &AlterVschema{Action: ApplyVSchemaScriptDDLAction, Script: string($4)}
+  }
+| ALTER VSCHEMA SET vschema_label equal_opt STRING
+  {
+    $$ = &AlterVschema{Action: SetVSchemaLabelDDLAction, Label: string($6)}
+  }
+| ALTER VSCHEMA ADD vschema_routing routing_rule table_name route_to table_name
+  {
+    $$ = &AlterVschema{Action: AddRoutingRuleDDLAction, Table: $6, NewName: $8}
+  }
+| ALTER VSCHEMA DROP vschema_routing routing_rule table_name
+  {
+    $$ = &AlterVschema{Action: DropRoutingRuleDDLAction, Table: $6}
+  }
+| ALTER VSCHEMA ADD vschema_reference TABLE table_name reference_source_opt
+  {
+    $$ = &AlterVschema{Action: AddReferenceTableDDLAction, Table: $6, ReferenceSource: $7}
+  }
 | ALTER VSCHEMA ON table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
   {
     $$ = &AlterVschema{
@@ -2107,7 +2250,56 @@ alter_statement:
         VindexCols: $9,
       }
   }
-| ALTER VSCHEMA ON table_name DROP VINDEX sql_id
+| ALTER VSCHEMA ON table_name ADD VINDEX '(' column_list ')' USING vindex_type vindex_params_opt
+  {
+    $$ = &AlterVschema{
+        Action: AddColVindexDDLAction,
+        Table: $4,
+        VindexSpec: &VindexSpec{
+            Name: GenerateVindexName($11, $8),
+            Type: $11,
+            Params: $12,
+        },
+        VindexCols: $8,
+      }
+  }
+| ALTER VSCHEMA ON table_name ADD VINDEX sql_id '(' sql_id AS openb expression closeb ')' vindex_type_opt vindex_params_opt
+  {
+    $$ = &AlterVschema{
+        Action: AddColVindexDDLAction,
+        Table: $4,
+        VindexSpec: &VindexSpec{
+            Name: $7,
+            Type: $15,
+            Params: $16,
+        },
+        VindexCols: []ColIdent{$9},
+        VindexExpr: $12,
+      }
+  }
+| ALTER VSCHEMA ON table_name ADD VINDEX '(' sql_id AS openb expression closeb ')' USING vindex_type vindex_params_opt
+  {
+    $$ = &AlterVschema{
+        Action: AddColVindexDDLAction,
+        Table: $4,
+        VindexSpec: &VindexSpec{
+            Name: GenerateVindexName($15, []ColIdent{$8}),
+            Type: $15,
+            Params: $16,
+        },
+        VindexCols: []ColIdent{$8},
+        VindexExpr: $11,
+      }
+  }
+| ALTER VSCHEMA ON table_name ADD VINDEXES '(' vindex_binding_list ')'
+  {
+    $$ = &AlterVschema{
+        Action: AddColVindexesDDLAction,
+        Table: $4,
+        VindexBindings: $8,
+      }
+  }
+| ALTER VSCHEMA ON table_name DROP VINDEX sql_id cascade_opt force_opt
   {
     $$ = &AlterVschema{
         Action: DropColVindexDDLAction,
@@ -2115,11 +2307,49 @@ alter_statement:
         VindexSpec: &VindexSpec{
             Name: $7,
         },
+        Cascade: $8,
+        Force: $9,
       }
   }
-| ALTER VSCHEMA ADD SEQUENCE table_name
+| ALTER VSCHEMA ON table_name DROP ALL VINDEXES cascade_opt
   {
-    $$ = &AlterVschema{Action: AddSequenceDDLAction, Table: $5}
+    $$ = &AlterVschema{Action: DropAllColVindexesDDLAction, Table: $4, Cascade: $8}
+  }
+| ALTER VSCHEMA ON table_name reorder_vindex sql_id before_or_after sql_id
+  {
+    $$ = &AlterVschema{
+        Action: ReorderColVindexDDLAction,
+        Table: $4,
+        VindexSpec: &VindexSpec{
+            Name: $6,
+        },
+        Anchor: $8,
+        After: $7,
+      }
+  }
+| ALTER VSCHEMA ON table_name ENABLE VINDEX sql_id
+  {
+    $$ = &AlterVschema{
+        Action: EnableColVindexDDLAction,
+        Table: $4,
+        VindexSpec: &VindexSpec{
+            Name: $7,
+        },
+      }
+  }
+| ALTER VSCHEMA ON table_name DISABLE VINDEX sql_id
+  {
+    $$ = &AlterVschema{
+        Action: DisableColVindexDDLAction,
+        Table: $4,
+        VindexSpec: &VindexSpec{
+            Name: $7,
+        },
+      }
+  }
+| ALTER VSCHEMA ADD SEQUENCE table_name vindex_params_opt
+  {
+    $$ = &AlterVschema{Action: AddSequenceDDLAction, Table: $5, SequenceParams: $6}
   }
 | ALTER VSCHEMA ON table_name ADD AUTO_INCREMENT sql_id USING table_name
   {
@@ -2132,6 +2362,26 @@ alter_statement:
         },
     }
   }
+| ALTER VSCHEMA ON table_name SET vschema_parent table_name ON '(' column_list ')' REFERENCES '(' column_list ')'
+  {
+    $$ = &AlterVschema{
+        Action: SetParentTableDDLAction,
+        Table: $4,
+        ParentSpec: &ParentSpec{
+            Parent: $7,
+            Columns: $10,
+            ReferencedColumns: $14,
+        },
+    }
+  }
+| ALTER VSCHEMA ON table_name SET vschema_scatter '=' boolean_value
+  {
+    $$ = &AlterVschema{
+        Action: SetScatterTableDDLAction,
+        Table: $4,
+        Scatter: bool($8),
+    }
+  }
 
 partition_operation:
   ADD PARTITION '(' partition_definition ')'
@@ -2457,10 +2707,46 @@ show_statement:
   {
     $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), Scope: ImplicitScope}}
   }
-| SHOW VSCHEMA VINDEXES
+| SHOW VSCHEMA TABLES USING VINDEX table_name
+  {
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), Table: $6, Scope: ImplicitScope}}
+  }
+| SHOW VSCHEMA AS SQL
+  {
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3) + " " + string($4), Scope: ImplicitScope}}
+  }
+| SHOW VSCHEMA VINDEX table_id vindex_params_word
+  {
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3) + " params", Table: TableName{Name: $4}, Scope: ImplicitScope}}
+  }
+| SHOW VSCHEMA VINDEX table_id
+  {
+    if NewColIdent($4.String()).Lowered() != "stats" {
+      yylex.Error("expecting stats after vschema vindex")
+      return 1
+    }
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3) + " stats", Scope: ImplicitScope}}
+  }
+| SHOW VSCHEMA vschema_word
   {
     $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), Scope: ImplicitScope}}
   }
+| SHOW VSCHEMA vschema_word ON table_name
+  {
+    if string($3) != "backfill" {
+      yylex.Error("expecting backfill before on")
+      return 1
+    }
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), OnTable: $5, Scope: ImplicitScope}}
+  }
+| SHOW VSCHEMA KEYSPACES like_or_where_opt
+  {
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), ShowTablesOpt: &ShowTablesOpt{Filter: $4}, Scope: ImplicitScope}}
+  }
+| SHOW VSCHEMA VINDEXES like_or_where_opt
+  {
+    $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), ShowTablesOpt: &ShowTablesOpt{Filter: $4}, Scope: ImplicitScope}}
+  }
 | SHOW VSCHEMA VINDEXES ON table_name
   {
     $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), OnTable: $5, Scope: ImplicitScope}}
@@ -2704,6 +2990,22 @@ explainable_statement:
   {
     $$ = $1
   }
+| create_statement
+  {
+    $$ = $1
+  }
+| alter_statement
+  {
+    $$ = $1
+  }
+| rename_statement
+  {
+    $$ = $1
+  }
+| drop_statement
+  {
+    $$ = $1
+  }
 
 wild_opt:
   {
@@ -2721,12 +3023,218 @@ wild_opt:
 explain_statement:
   explain_synonyms table_name wild_opt
   {
-    $$ = &ExplainTab{Table: $2, Wild: $3}
+    if isVSchemaDescription($2, $3) {
+      $$ = &ExplainVSchema{Table: TableName{Name: NewTableIdent($3)}}
+    } else {
+      $$ = &ExplainTab{Table: $2, Wild: $3}
+    }
+  }
+| explain_synonyms table_name sql_id '.' reserved_table_id
+  {
+    if !isVSchemaDescription($2, $3.String()) {
+      yylex.Error("expecting vschema before qualified table name")
+      return 1
+    }
+    $$ = &ExplainVSchema{Table: TableName{Qualifier: NewTableIdent($3.String()), Name: $5}}
   }
 | explain_synonyms explain_format_opt explainable_statement
   {
     $$ = &ExplainStmt{Type: $2, Statement: $3}
   }
+| explain_synonyms table_name sql_id row_tuple
+  {
+    if !isExplainRouting($2) {
+      yylex.Error("expecting routing before table name")
+      return 1
+    }
+    $$ = &ExplainRouting{Table: TableName{Name: NewTableIdent($3.String())}, Values: $4}
+  }
+| explain_synonyms table_name sql_id '.' reserved_table_id row_tuple
+  {
+    if !isExplainRouting($2) {
+      yylex.Error("expecting routing before table name")
+      return 1
+    }
+    $$ = &ExplainRouting{Table: TableName{Qualifier: NewTableIdent($3.String()), Name: $5}, Values: $6}
+  }
+| explain_synonyms explain_shards_for table_name WHERE expression
+  {
+    $$ = &ExplainShards{Table: $3, Where: NewWhere(WhereClause, $5)}
+  }
+| explain_synonyms VINDEX FOR table_name WHERE expression
+  {
+    $$ = &ExplainVindex{Table: $4, Where: NewWhere(WhereClause, $6)}
+  }
+
+explain_shards_for:
+  ID FOR
+  {
+    if NewColIdent(string($1)).Lowered() != "shards" {
+      yylex.Error("expecting shards after explain")
+      return 1
+    }
+  }
+
+copy_keyspace:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "keyspace" {
+      yylex.Error("expecting keyspace after copy")
+      return 1
+    }
+  }
+
+// vschema_verb is a word that follows ALTER VSCHEMA without being a
+// keyword. Several statements start with one, so the statement checks
+// that it is the expected word. An unknown word is reported right away
+// rather than at the end of the statement.
+vschema_verb:
+  ID
+  {
+    verb := NewColIdent(string($1)).Lowered()
+    if msg := checkVSchemaVerb(verb); msg != "" {
+      yylex.Error(msg)
+      return 1
+    }
+    $$ = []byte(verb)
+  }
+
+vschema_label:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "label" {
+      yylex.Error("expecting label after set")
+      return 1
+    }
+  }
+
+routing_rule:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "rule" {
+      yylex.Error("expecting rule after routing")
+      return 1
+    }
+  }
+
+route_to:
+  ID TO
+  {
+    if NewColIdent(string($1)).Lowered() != "route" {
+      yylex.Error("expecting route to")
+      return 1
+    }
+  }
+
+vschema_parent:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "parent" {
+      yylex.Error("expecting parent after set")
+      return 1
+    }
+  }
+
+// vschema_routing is the routing word of the statements about routing.
+// routing is not a keyword, so that it can still name a table, an index
+// or a column.
+vschema_routing:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "routing" {
+      yylex.Error("expecting routing")
+      return 1
+    }
+  }
+
+vschema_reference:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "reference" {
+      yylex.Error("expecting reference")
+      return 1
+    }
+  }
+
+vschema_source:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "source" {
+      yylex.Error("expecting source")
+      return 1
+    }
+  }
+
+vschema_scatter:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "scatter" {
+      yylex.Error("expecting scatter after set")
+      return 1
+    }
+  }
+
+vschema_word:
+  ID
+  {
+    switch word := NewColIdent(string($1)).Lowered(); word {
+    case "acl", "backfill", "version":
+      $$ = []byte(word)
+    default:
+      yylex.Error("expecting acl, backfill or version after vschema")
+      return 1
+    }
+  }
+
+vindex_params_word:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "params" {
+      yylex.Error("expecting params after vindex type")
+      return 1
+    }
+  }
+
+reorder_vindex:
+  ID VINDEX
+  {
+    if NewColIdent(string($1)).Lowered() != "reorder" {
+      yylex.Error("expecting reorder vindex")
+      return 1
+    }
+  }
+
+before_or_after:
+  BEFORE
+  {
+    $$ = false
+  }
+| AFTER
+  {
+    $$ = true
+  }
+
+validate_statement:
+  validate_word VSCHEMA
+  {
+    $$ = &Show{&ShowLegacy{Type: "validate vschema", Scope: ImplicitScope}}
+  }
+| validate_word VSCHEMA vschema_routing table_id
+  {
+    $$ = &Show{&ShowLegacy{Type: "validate vschema routing", Table: TableName{Qualifier: $4}, Scope: ImplicitScope}}
+  }
+
+// validate_word starts VALIDATE VSCHEMA. validate is not a keyword, so
+// that it can still name an index or a column. Any other word that starts
+// a statement is the syntax error it was before.
+validate_word:
+  ID
+  {
+    if NewColIdent(string($1)).Lowered() != "validate" {
+      yylex.Error("syntax error")
+      return 1
+    }
+  }
 
 other_statement:
   REPAIR skip_to_end
@@ -4832,6 +5340,7 @@ non_reserved_keyword:
 | ALGORITHM
 | AUTO_INCREMENT
 | AVG_ROW_LENGTH
+| BEFORE
 | BEGIN
 | BIGINT
 | BIT
@@ -99,7 +99,7 @@ var keywords = map[string]int{
 	"asensitive":          UNUSED,
 	"auto_increment":      AUTO_INCREMENT,
 	"avg_row_length":      AVG_ROW_LENGTH,
-	"before":              UNUSED,
+	"before":              BEFORE,
 	"begin":               BEGIN,
 	"between":             BETWEEN,
 	"bigint":              BIGINT,
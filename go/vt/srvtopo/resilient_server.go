@@ -124,6 +124,10 @@ type ResilientServer struct {
 	cacheRefresh time.Duration
 	counts       *stats.CountersWithSingleLabel
 
+	// watchSleepTime is how long WatchSrvVSchema waits before it
+	// watches again after an error.
+	watchSleepTime time.Duration
+
 	// mutex protects the cache map itself, not the individual
 	// values in the cache.
 	mutex                 sync.RWMutex
@@ -227,6 +231,8 @@ func NewResilientServer(base *topo.Server, counterPrefix string) *ResilientServe
 		cacheRefresh: *srvTopoCacheRefresh,
 		counts:       stats.NewCountersWithSingleLabel(metric, "Resilient srvtopo server operations", "type"),
 
+		watchSleepTime: watchSrvVSchemaSleepTime,
+
 		srvKeyspaceNamesCache: make(map[string]*srvKeyspaceNamesEntry),
 		srvKeyspaceCache:      make(map[string]*srvKeyspaceEntry),
 	}
@@ -575,7 +581,7 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 			}
 
 			// Sleep a bit before trying again.
-			time.Sleep(watchSrvVSchemaSleepTime)
+			time.Sleep(server.watchSleepTime)
 		}
 	}()
 
@@ -482,6 +482,98 @@ func TestWatchSrvVSchema(t *testing.T) {
 	}
 }
 
+// countingServer counts the updates the watch delivers, before they
+// reach the callback.
+type countingServer struct {
+	Server
+
+	mu      sync.Mutex
+	updates int
+}
+
+func (cs *countingServer) WatchSrvVSchema(ctx context.Context, cell string, callback func(*vschemapb.SrvVSchema, error)) {
+	cs.Server.WatchSrvVSchema(ctx, cell, func(v *vschemapb.SrvVSchema, e error) {
+		callback(v, e)
+		cs.mu.Lock()
+		cs.updates++
+		cs.mu.Unlock()
+	})
+}
+
+func (cs *countingServer) count() int {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.updates
+}
+
+func TestWatchSrvVSchemaLatestOnly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer("test_cell")
+	rs := NewResilientServer(ts, "TestWatchSrvVSchemaLatestOnly")
+	rs.watchSleepTime = 10 * time.Millisecond
+	cs := &countingServer{Server: rs}
+
+	// The subscriber is stuck on the first value until release is
+	// closed.
+	release := make(chan struct{})
+	mu := sync.Mutex{}
+	var seen []*vschemapb.SrvVSchema
+	WatchSrvVSchemaWithPolicy(ctx, cs, "test_cell", WatchLatestOnly, func(v *vschemapb.SrvVSchema, e error) {
+		if e != nil {
+			// This is the initial topo.ErrNoNode.
+			return
+		}
+		<-release
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, v)
+	})
+
+	// The watch keeps delivering updates while the subscriber is busy.
+	var last *vschemapb.SrvVSchema
+	for i := 0; i < 5; i++ {
+		last = &vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{
+				fmt.Sprintf("ks%d", i): {},
+			},
+		}
+		if err := ts.UpdateSrvVSchema(ctx, "test_cell", last); err != nil {
+			t.Fatalf("UpdateSrvVSchema failed: %v", err)
+		}
+		start := time.Now()
+		for cs.count() < i+2 {
+			if time.Since(start) > 5*time.Second {
+				t.Fatalf("watch blocked by the subscriber after %d updates", cs.count())
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// Once released, the subscriber gets the latest value, and skips
+	// the ones in between.
+	close(release)
+	start := time.Now()
+	for {
+		mu.Lock()
+		n := len(seen)
+		done := n > 0 && proto.Equal(last, seen[n-1])
+		mu.Unlock()
+		if done {
+			break
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("timed out waiting for the latest SrvVSchema")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) > 2 {
+		t.Errorf("subscriber got %d updates, want at most 2", len(seen))
+	}
+}
+
 func TestGetSrvKeyspaceNames(t *testing.T) {
 	ts, factory := memorytopo.NewServerAndFactory("test_cell")
 	*srvTopoCacheTTL = 100 * time.Millisecond
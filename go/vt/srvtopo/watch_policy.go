@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package srvtopo
+
+import (
+	"context"
+	"sync"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// WatchPolicy controls how the updates of a watched SrvVSchema are
+// delivered to a subscriber.
+type WatchPolicy int
+
+const (
+	// WatchBlocking delivers every update, in order, from the watch
+	// itself. A slow subscriber delays the updates that follow. This
+	// is the behavior of Server.WatchSrvVSchema.
+	WatchBlocking WatchPolicy = iota
+
+	// WatchLatestOnly delivers the updates from a separate goroutine,
+	// so that a slow subscriber never blocks the watch. The updates
+	// that arrive while the subscriber is busy are coalesced: only the
+	// newest one is delivered next, and the ones before it are
+	// dropped. The subscriber always ends up seeing the latest state.
+	WatchLatestOnly
+)
+
+// WatchSrvVSchemaWithPolicy starts watching the SrvVSchema object of
+// the cell like server.WatchSrvVSchema, and delivers the updates to the
+// callback according to the policy. Like WatchSrvVSchema, it returns
+// once the first value has been delivered. With WatchLatestOnly, the
+// delivery goroutine stops when ctx is done.
+func WatchSrvVSchemaWithPolicy(ctx context.Context, server Server, cell string, policy WatchPolicy, callback func(*vschemapb.SrvVSchema, error)) {
+	if policy == WatchLatestOnly {
+		callback = latestOnly(ctx, callback)
+	}
+	server.WatchSrvVSchema(ctx, cell, callback)
+}
+
+// latestOnly wraps a callback so that it never blocks its caller past
+// the first call, which is delivered inline. The next calls only record
+// their arguments, and a goroutine delivers the most recent ones.
+func latestOnly(ctx context.Context, callback func(*vschemapb.SrvVSchema, error)) func(*vschemapb.SrvVSchema, error) {
+	var (
+		mu      sync.Mutex
+		started bool
+		value   *vschemapb.SrvVSchema
+		err     error
+	)
+	// pending has room for a single signal: one is enough to deliver
+	// all the updates recorded until the goroutine picks them up.
+	pending := make(chan struct{}, 1)
+
+	deliver := func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-pending:
+			}
+			mu.Lock()
+			v, e := value, err
+			mu.Unlock()
+			callback(v, e)
+		}
+	}
+
+	return func(v *vschemapb.SrvVSchema, e error) {
+		mu.Lock()
+		if !started {
+			started = true
+			mu.Unlock()
+			callback(v, e)
+			go deliver()
+			return
+		}
+		value, err = v, e
+		mu.Unlock()
+		select {
+		case pending <- struct{}{}:
+		default:
+		}
+	}
+}
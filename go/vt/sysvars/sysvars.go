@@ -56,9 +56,14 @@ var (
 	SessionUUID                 = SystemVariable{Name: "session_uuid", IdentifierAsString: true}
 	SessionEnableSystemSettings = SystemVariable{Name: "enable_system_settings", IsBoolean: true, Default: on}
 	// Online DDL
-	DDLStrategy    = SystemVariable{Name: "ddl_strategy", IdentifierAsString: true}
-	Version        = SystemVariable{Name: "version"}
-	VersionComment = SystemVariable{Name: "version_comment"}
+	DDLStrategy            = SystemVariable{Name: "ddl_strategy", IdentifierAsString: true}
+	DDLFailFast            = SystemVariable{Name: "ddl_fail_fast", IsBoolean: true, Default: off}
+	DDLDropVSchemaTable    = SystemVariable{Name: "ddl_drop_vschema_table", IsBoolean: true, Default: off}
+	VSchemaDDLJSON         = SystemVariable{Name: "vschema_ddl_json", IsBoolean: true, Default: off}
+	CaptureVSchemaDDL      = SystemVariable{Name: "capture_vschema_ddl", IsBoolean: true, Default: off}
+	VSchemaDefaultKeyspace = SystemVariable{Name: "vschema_default_keyspace", IdentifierAsString: true}
+	Version                = SystemVariable{Name: "version"}
+	VersionComment         = SystemVariable{Name: "version_comment"}
 
 	// Read After Write settings
 	ReadAfterWriteGTID    = SystemVariable{Name: "read_after_write_gtid"}
@@ -74,6 +79,11 @@ var (
 		SQLSelectLimit,
 		TransactionMode,
 		DDLStrategy,
+		DDLFailFast,
+		DDLDropVSchemaTable,
+		VSchemaDDLJSON,
+		CaptureVSchemaDDL,
+		VSchemaDefaultKeyspace,
 		Workload,
 		Charset,
 		Names,
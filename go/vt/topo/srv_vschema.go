@@ -113,21 +113,28 @@ func (ts *Server) UpdateSrvVSchema(ctx context.Context, cell string, srvVSchema
 
 // GetSrvVSchema returns the SrvVSchema for a cell.
 func (ts *Server) GetSrvVSchema(ctx context.Context, cell string) (*vschemapb.SrvVSchema, error) {
+	srvVSchema, _, err := ts.GetSrvVSchemaWithVersion(ctx, cell)
+	return srvVSchema, err
+}
+
+// GetSrvVSchemaWithVersion returns the SrvVSchema for a cell, and the
+// version of its file, which changes on every update.
+func (ts *Server) GetSrvVSchemaWithVersion(ctx context.Context, cell string) (*vschemapb.SrvVSchema, Version, error) {
 	conn, err := ts.ConnForCell(ctx, cell)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	nodePath := SrvVSchemaFile
-	data, _, err := conn.Get(ctx, nodePath)
+	data, version, err := conn.Get(ctx, nodePath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	srvVSchema := &vschemapb.SrvVSchema{}
 	if err := proto.Unmarshal(data, srvVSchema); err != nil {
-		return nil, vterrors.Wrapf(err, "SrvVSchema unmarshal failed: %v", data)
+		return nil, nil, vterrors.Wrapf(err, "SrvVSchema unmarshal failed: %v", data)
 	}
-	return srvVSchema, nil
+	return srvVSchema, version, nil
 }
 
 // DeleteSrvVSchema deletes the SrvVSchema file for a cell.
@@ -198,6 +205,12 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 	}
 	srvVSchema.RoutingRules = rr
 
+	label, err := ts.GetVSchemaLabel(ctx)
+	if err != nil {
+		return fmt.Errorf("GetVSchemaLabel failed: %v", err)
+	}
+	srvVSchema.Label = label
+
 	// now save the SrvVSchema in all cells in parallel
 	for _, cell := range cells {
 		wg.Add(1)
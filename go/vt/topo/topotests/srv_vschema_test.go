@@ -185,4 +185,18 @@ func TestRebuildVSchema(t *testing.T) {
 			t.Errorf("unexpected GetSrvVSchema(%v) result: %v != %v (err = %v)", cell, v, wanted4, err)
 		}
 	}
+
+	// The version label is kept across rebuilds.
+	if err := ts.SaveVSchemaLabel(ctx, "2024-06-release-3"); err != nil {
+		t.Fatalf("SaveVSchemaLabel() failed: %v", err)
+	}
+	if err := ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		t.Errorf("RebuildVSchema failed: %v", err)
+	}
+	wanted4.Label = "2024-06-release-3"
+	for _, cell := range cells {
+		if v, err := ts.GetSrvVSchema(ctx, cell); err != nil || !proto.Equal(v, wanted4) {
+			t.Errorf("unexpected GetSrvVSchema(%v) result: %v != %v (err = %v)", cell, v, wanted4, err)
+		}
+	}
 }
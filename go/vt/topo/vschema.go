@@ -128,3 +128,29 @@ func (ts *Server) GetRoutingRules(ctx context.Context) (*vschemapb.RoutingRules,
 	}
 	return rr, nil
 }
+
+// SaveVSchemaLabel saves the version label of the vschema into the topo.
+// An empty label removes it.
+func (ts *Server) SaveVSchemaLabel(ctx context.Context, label string) error {
+	if label == "" {
+		if err := ts.globalCell.Delete(ctx, VSchemaLabelFile, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	_, err := ts.globalCell.Update(ctx, VSchemaLabelFile, []byte(label), nil)
+	return err
+}
+
+// GetVSchemaLabel fetches the version label of the vschema from the topo.
+func (ts *Server) GetVSchemaLabel(ctx context.Context) (string, error) {
+	data, _, err := ts.globalCell.Get(ctx, VSchemaLabelFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(data), nil
+}
@@ -19,9 +19,15 @@ package topotools
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -62,12 +68,66 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 			ks.Sharded = true
 		}
 
+		if err := checkVindexType(alterVschema.VindexSpec.Type.String()); err != nil {
+			return nil, err
+		}
+
 		owner, params := alterVschema.VindexSpec.ParseParams()
-		ks.Vindexes[name] = &vschemapb.Vindex{
+		if err := checkStrictParams(alterVschema.VindexSpec.Type.String(), params); err != nil {
+			return nil, err
+		}
+		vindex := &vschemapb.Vindex{
 			Type:   alterVschema.VindexSpec.Type.String(),
 			Params: params,
 			Owner:  owner,
 		}
+		if err := checkVindexDefinition(name, vindex); err != nil {
+			return nil, err
+		}
+		ks.Vindexes[name] = vindex
+
+		return ks, nil
+
+	case sqlparser.CloneVindexDDLAction:
+		// The new vindex has the type, params and owner of the source
+		// vindex. The params of the statement are added to them, or
+		// replace them.
+		srcName := alterVschema.Table.Name.String()
+		src, ok := ks.Vindexes[srcName]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s does not exists in keyspace %s", srcName, ksName)
+		}
+		if qualifier := alterVschema.NewName.Qualifier.String(); qualifier != "" && qualifier != ksName {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot clone vindex %s in keyspace %s to another keyspace %s", srcName, ksName, qualifier)
+		}
+		name := alterVschema.VindexSpec.Name.String()
+		if _, ok := ks.Vindexes[name]; ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s already exists in keyspace %s", name, ksName)
+		}
+
+		owner, overrides := alterVschema.VindexSpec.ParseParams()
+		if owner == "" {
+			owner = src.Owner
+		}
+		params := make(map[string]string, len(src.Params)+len(overrides))
+		for k, v := range src.Params {
+			params[k] = v
+		}
+		for k, v := range overrides {
+			params[k] = v
+		}
+		if err := checkStrictParams(src.Type, params); err != nil {
+			return nil, err
+		}
+		vindex := &vschemapb.Vindex{
+			Type:   src.Type,
+			Params: params,
+			Owner:  owner,
+		}
+		if err := checkVindexDefinition(name, vindex); err != nil {
+			return nil, err
+		}
+		ks.Vindexes[name] = vindex
 
 		return ks, nil
 
@@ -104,6 +164,27 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 
 		return ks, nil
 
+	case sqlparser.AddReferenceTableDDLAction:
+		// Unlike a plain vschema table, a reference table is allowed
+		// in a sharded keyspace.
+		name := alterVschema.Table.Name.String()
+		if _, ok := ks.Tables[name]; ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema already contains table %s in keyspace %s", name, ksName)
+		}
+
+		source := alterVschema.ReferenceSource
+		sourceFqn := source.Name.String()
+		if source.Qualifier.String() != "" {
+			sourceFqn = fmt.Sprintf("%s.%s", source.Qualifier.String(), sourceFqn)
+		}
+
+		ks.Tables[name] = &vschemapb.Table{
+			Type:   vindexes.TypeReference,
+			Source: sourceFqn,
+		}
+
+		return ks, nil
+
 	case sqlparser.DropVschemaTableDDLAction:
 		name := alterVschema.Table.Name.String()
 		if _, ok := ks.Tables[name]; !ok {
@@ -114,6 +195,54 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 
 		return ks, nil
 
+	case sqlparser.RenameVschemaTableDDLAction:
+		// The table keeps its definition under the new name. Vindexes
+		// owned by the table are updated to the new owner name, and so
+		// are the backfill sources of its lookup bindings. Tables of the
+		// keyspace that reference the table as their parent, or as the
+		// sequence of their auto increment, are updated as well. The
+		// caller has to check references from other keyspaces.
+		if table == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema does not contain table %s in keyspace %s", tableName, ksName)
+		}
+		if qualifier := alterVschema.NewName.Qualifier.String(); qualifier != "" && qualifier != ksName {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot rename table %s in keyspace %s to another keyspace %s", tableName, ksName, qualifier)
+		}
+		newName := alterVschema.NewName.Name.String()
+		if _, ok := ks.Tables[newName]; ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema already contains table %s in keyspace %s", newName, ksName)
+		}
+
+		for _, vindex := range ks.Vindexes {
+			if vindex.Owner == tableName {
+				vindex.Owner = newName
+			}
+		}
+		oldQualified, newQualified := fmt.Sprintf("%s.%s", ksName, tableName), fmt.Sprintf("%s.%s", ksName, newName)
+		for _, colVindex := range table.ColumnVindexes {
+			if source := colVindex.BackfillSource; source != nil && source.Table == oldQualified {
+				source.Table = newQualified
+			}
+		}
+		for _, other := range ks.Tables {
+			if other.Parent != nil && other.Parent.Table == oldQualified {
+				other.Parent.Table = newQualified
+			}
+			if table.Type != vindexes.TypeSequence || other.AutoIncrement == nil {
+				continue
+			}
+			switch other.AutoIncrement.Sequence {
+			case oldQualified:
+				other.AutoIncrement.Sequence = newQualified
+			case tableName:
+				other.AutoIncrement.Sequence = newName
+			}
+		}
+		delete(ks.Tables, tableName)
+		ks.Tables[newName] = table
+
+		return ks, nil
+
 	case sqlparser.AddColVindexDDLAction:
 		// Support two cases:
 		//
@@ -123,10 +252,50 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 		//
 		// 2. The vindex type is not specified. Make sure the vindex
 		//    already exists.
+		//
+		// The owner table of a newly created vindex is recorded as is,
+		// even if it isn't defined in the vschema yet. This lets the
+		// owner be added later on, and VALIDATE VSCHEMA reports any
+		// owner that is still missing.
+		//
+		// The backfill_required parameter describes the binding rather
+		// than the vindex, so it is pulled out of the vindex params.
+		// It defaults to true for every binding but the first one on
+		// the table.
 		spec := alterVschema.VindexSpec
 		name := spec.Name.String()
+		owner, params := spec.ParseParams()
+		backfillRequired := table != nil && len(table.ColumnVindexes) > 0
+		if val, ok := params[sqlparser.VindexBackfillRequiredStr]; ok {
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid value for %s: %s", sqlparser.VindexBackfillRequiredStr, val)
+			}
+			backfillRequired = b
+			delete(params, sqlparser.VindexBackfillRequiredStr)
+		}
+		// A vindex on a generated column records the expression of the
+		// column. Only a single column and a few functions are supported.
+		expression := ""
+		if alterVschema.VindexExpr != nil {
+			if len(alterVschema.VindexCols) != 1 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vindex %s: an expression requires a single column", name)
+			}
+			if err := vindexes.ValidateVindexExpression(alterVschema.VindexExpr); err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+			}
+			if err := vindexes.ValidateVindexExpressionColumns(alterVschema.VindexExpr, table.GetColumns()); err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+			}
+			expression = sqlparser.String(alterVschema.VindexExpr)
+		}
 		if !spec.Type.IsEmpty() {
-			owner, params := spec.ParseParams()
+			if err := checkVindexType(spec.Type.String()); err != nil {
+				return nil, err
+			}
+			if err := checkStrictParams(spec.Type.String(), params); err != nil {
+				return nil, err
+			}
 			if vindex, ok := ks.Vindexes[name]; ok {
 				if vindex.Type != spec.Type.String() {
 					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s defined with type %s not %s", name, vindex.Type, spec.Type.String())
@@ -134,20 +303,24 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 				if vindex.Owner != owner {
 					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s defined with owner %s not %s", name, vindex.Owner, owner)
 				}
-				if (len(vindex.Params) != 0 || len(params) != 0) && !reflect.DeepEqual(vindex.Params, params) {
-					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s defined with different parameters", name)
+				if (len(vindex.Params) != 0 || len(params) != 0) && !reflect.DeepEqual(vindex.Params, params) && !equivalentVindexes(name, vindex, params) {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s defined with different parameters: %s", name, diffVindexParams(vindex.Params, params))
 				}
 			} else {
 				// Make sure the keyspace has the sharded bit set to true
 				// if this is the first vindex defined in the keyspace.
-				if len(ks.Vindexes) == 0 {
-					ks.Sharded = true
-				}
-				ks.Vindexes[name] = &vschemapb.Vindex{
+				vindex := &vschemapb.Vindex{
 					Type:   spec.Type.String(),
 					Params: params,
 					Owner:  owner,
 				}
+				if err := checkVindexDefinition(name, vindex); err != nil {
+					return nil, err
+				}
+				if len(ks.Vindexes) == 0 {
+					ks.Sharded = true
+				}
+				ks.Vindexes[name] = vindex
 			}
 		} else {
 			if _, ok := ks.Vindexes[name]; !ok {
@@ -155,6 +328,14 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 			}
 		}
 
+		// The first vindex bound to a table is its primary vindex,
+		// which has to be unique.
+		if table == nil || len(table.ColumnVindexes) == 0 {
+			if err := checkPrimaryVindex(ksName, ks, tableName, name); err != nil {
+				return nil, err
+			}
+		}
+
 		// If this is the first vindex being defined on the table, create
 		// the empty table record
 		if table == nil {
@@ -175,15 +356,50 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 		for i, col := range alterVschema.VindexCols {
 			columns[i] = col.String()
 		}
-		table.ColumnVindexes = append(table.ColumnVindexes, &vschemapb.ColumnVindex{
-			Name:    name,
-			Columns: columns,
-		})
+
+		// Two vindexes bound to the same set of columns confuse the
+		// planner. Overlapping sets are fine.
+		for _, vindex := range table.ColumnVindexes {
+			if sameColumnSet(vindex, columns) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "columns (%s) of table %s are already bound to vindex %s", strings.Join(columns, ", "), tableName, vindex.Name)
+			}
+		}
+		colVindex := &vschemapb.ColumnVindex{
+			Name:             name,
+			Columns:          columns,
+			BackfillRequired: backfillRequired,
+			Expression:       expression,
+		}
+		if backfillRequired {
+			colVindex.BackfillSource = lookupBackfillSource(ksName, tableName, name, ks.Vindexes[name], columns)
+		}
+		table.ColumnVindexes = append(table.ColumnVindexes, colVindex)
 		ks.Tables[tableName] = table
 
 		return ks, nil
 
+	case sqlparser.AddColVindexesDDLAction:
+		// Apply the bindings to a copy, so that the keyspace is left
+		// unchanged if any of them fails.
+		result := proto.Clone(ks).(*vschemapb.Keyspace)
+		for _, binding := range alterVschema.VindexBindings {
+			var err error
+			result, err = ApplyVSchemaDDL(ksName, result, &sqlparser.AlterVschema{
+				Action:     sqlparser.AddColVindexDDLAction,
+				Table:      alterVschema.Table,
+				VindexSpec: binding.Spec,
+				VindexCols: []sqlparser.ColIdent{binding.Column},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+
 	case sqlparser.DropColVindexDDLAction:
+		// Dropping the last vindex of a table removes the table entry if
+		// CASCADE is given. Otherwise the entry is kept, if the table
+		// can be routed without a vindex.
 		spec := alterVschema.VindexSpec
 		name := spec.Name.String()
 		if table == nil {
@@ -192,15 +408,109 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 
 		for i, colVindex := range table.ColumnVindexes {
 			if colVindex.Name == name {
+				// The next vindex becomes the primary vindex, so it has
+				// to qualify as one, unless FORCE is given.
+				if i == 0 && len(table.ColumnVindexes) > 1 && !alterVschema.Force {
+					if err := checkPrimaryBinding(ksName, ks, tableName, table.ColumnVindexes[1]); err != nil {
+						return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot drop primary vindex %s of table %s.%s: %s", name, ksName, tableName, err.Error())
+					}
+				}
 				table.ColumnVindexes = append(table.ColumnVindexes[:i], table.ColumnVindexes[i+1:]...)
 				if len(table.ColumnVindexes) == 0 {
-					delete(ks.Tables, tableName)
+					if err := keepEmptyTable(ksName, ks, tableName, table, alterVschema.Cascade); err != nil {
+						return nil, err
+					}
+				}
+				return ks, nil
+			}
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s not defined in table %s.%s", name, ksName, tableName)
+
+	case sqlparser.EnableColVindexDDLAction, sqlparser.DisableColVindexDDLAction:
+		// A disabled vindex stays bound to the table, but the planner
+		// doesn't use it for routing. The primary vindex is needed to
+		// route inserts, so it can't be disabled.
+		name := alterVschema.VindexSpec.Name.String()
+		if table == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "table %s.%s not defined in vschema", ksName, tableName)
+		}
+		disable := alterVschema.Action == sqlparser.DisableColVindexDDLAction
+		for i, colVindex := range table.ColumnVindexes {
+			if colVindex.Name == name {
+				if disable && i == 0 {
+					return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vindex %s is the primary vindex of table %s.%s and cannot be disabled", name, ksName, tableName)
 				}
+				colVindex.Disabled = disable
 				return ks, nil
 			}
 		}
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s not defined in table %s.%s", name, ksName, tableName)
 
+	case sqlparser.ReorderColVindexDDLAction:
+		// Move one binding of the table next to another one. The
+		// binding that ends up first becomes the primary vindex, which
+		// has to be unique.
+		name := alterVschema.VindexSpec.Name.String()
+		anchor := alterVschema.Anchor.String()
+		if table == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "table %s.%s not defined in vschema", ksName, tableName)
+		}
+		if name == anchor {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot reorder vindex %s relative to itself", name)
+		}
+		var moved *vschemapb.ColumnVindex
+		anchored := false
+		for _, colVindex := range table.ColumnVindexes {
+			switch colVindex.Name {
+			case name:
+				moved = colVindex
+			case anchor:
+				anchored = true
+			}
+		}
+		if moved == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s not defined in table %s.%s", name, ksName, tableName)
+		}
+		if !anchored {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s not defined in table %s.%s", anchor, ksName, tableName)
+		}
+
+		reordered := make([]*vschemapb.ColumnVindex, 0, len(table.ColumnVindexes))
+		for _, colVindex := range table.ColumnVindexes {
+			if colVindex == moved {
+				continue
+			}
+			if colVindex.Name == anchor && !alterVschema.After {
+				reordered = append(reordered, moved)
+			}
+			reordered = append(reordered, colVindex)
+			if colVindex.Name == anchor && alterVschema.After {
+				reordered = append(reordered, moved)
+			}
+		}
+		if primary := reordered[0]; primary != table.ColumnVindexes[0] {
+			if err := checkPrimaryBinding(ksName, ks, tableName, primary); err != nil {
+				return nil, err
+			}
+		}
+		table.ColumnVindexes = reordered
+		return ks, nil
+
+	case sqlparser.DropAllColVindexesDDLAction:
+		// The same rules as for dropping the last vindex of a table
+		// apply. A table without vindexes is left untouched.
+		if table == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "table %s.%s not defined in vschema", ksName, tableName)
+		}
+		if len(table.ColumnVindexes) == 0 {
+			return ks, nil
+		}
+		table.ColumnVindexes = nil
+		if err := keepEmptyTable(ksName, ks, tableName, table, alterVschema.Cascade); err != nil {
+			return nil, err
+		}
+		return ks, nil
+
 	case sqlparser.AddSequenceDDLAction:
 		if ks.Sharded {
 			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "add sequence table: unsupported on sharded keyspace %s", ksName)
@@ -211,7 +521,16 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema already contains sequence %s in keyspace %s", name, ksName)
 		}
 
-		ks.Tables[name] = &vschemapb.Table{Type: "sequence"}
+		params, err := sequenceParams(alterVschema.SequenceParams)
+		if err != nil {
+			return nil, err
+		}
+		ks.Tables[name] = &vschemapb.Table{Type: "sequence", SequenceParams: params}
+
+		return ks, nil
+
+	case sqlparser.SetKeyspaceCommentDDLAction:
+		ks.Comment = alterVschema.Comment
 
 		return ks, nil
 
@@ -237,8 +556,662 @@ func ApplyVSchemaDDL(ksName string, ks *vschemapb.Keyspace, alterVschema *sqlpar
 			Sequence: sequenceFqn,
 		}
 
+		return ks, nil
+
+	case sqlparser.SetParentTableDDLAction:
+		// The compatibility of the primary vindexes is checked by
+		// CheckParentTable, which can see the keyspace of the parent.
+		if table == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema does not contain table %s in keyspace %s", tableName, ksName)
+		}
+		spec := alterVschema.ParentSpec
+		if len(spec.Columns) != len(spec.ReferencedColumns) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "set parent: %d columns of table %s reference %d columns", len(spec.Columns), tableName, len(spec.ReferencedColumns))
+		}
+
+		parentKsName := spec.Parent.Qualifier.String()
+		if parentKsName == "" {
+			parentKsName = ksName
+		}
+		parent := &vschemapb.ParentTable{Table: fmt.Sprintf("%s.%s", parentKsName, spec.Parent.Name.String())}
+		for i, col := range spec.Columns {
+			parent.Columns = append(parent.Columns, col.String())
+			parent.ReferencedColumns = append(parent.ReferencedColumns, spec.ReferencedColumns[i].String())
+		}
+		table.Parent = parent
+
+		return ks, nil
+
+	case sqlparser.SetScatterTableDDLAction:
+		// A scatter table needs no vindex, so marking a table that
+		// isn't in the vschema yet adds it.
+		if !ks.Sharded {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "set scatter: keyspace %s is not sharded", ksName)
+		}
+		if table == nil {
+			if !alterVschema.Scatter {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema does not contain table %s in keyspace %s", tableName, ksName)
+			}
+			table = &vschemapb.Table{}
+			ks.Tables[tableName] = table
+		}
+		if table.Type != "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "set scatter: table %s.%s is a %s table", ksName, tableName, table.Type)
+		}
+		if table.Pinned != "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "set scatter: table %s.%s is pinned", ksName, tableName)
+		}
+		if !alterVschema.Scatter && len(table.ColumnVindexes) == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %s.%s has no vindexes, it has to stay scatter-routed", ksName, tableName)
+		}
+		table.Scatter = alterVschema.Scatter
+
 		return ks, nil
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected vindex ddl operation %s", alterVschema.Action.ToString())
 }
+
+// sequenceParams validates the params of an ADD SEQUENCE statement.
+// It returns nil if no params were given.
+func sequenceParams(params []sqlparser.VindexParam) (*vschemapb.SequenceParams, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	result := &vschemapb.SequenceParams{}
+	for _, p := range params {
+		var target *int64
+		key := p.Key.Lowered()
+		switch key {
+		case sqlparser.SequenceCacheStr:
+			target = &result.Cache
+		case sqlparser.SequenceStartStr:
+			target = &result.Start
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown sequence param %s, supported params: %s, %s", key, sqlparser.SequenceCacheStr, sqlparser.SequenceStartStr)
+		}
+		val, err := strconv.ParseInt(p.Val, 10, 64)
+		if err != nil || val <= 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid value for sequence param %s: %s, must be a positive integer", key, p.Val)
+		}
+		*target = val
+	}
+	return result, nil
+}
+
+// ApplyRoutingRuleDDL applies the given routing rule DDL statement to
+// the routing rules and returns the modified rules. The table the
+// queries are routed to must be qualified with its keyspace.
+func ApplyRoutingRuleDDL(rules *vschemapb.RoutingRules, alterVschema *sqlparser.AlterVschema) (*vschemapb.RoutingRules, error) {
+	result := &vschemapb.RoutingRules{}
+	if rules != nil {
+		result = proto.Clone(rules).(*vschemapb.RoutingRules)
+	}
+
+	from := RoutingRuleTableName(alterVschema.Table)
+	index := -1
+	for i, rule := range result.Rules {
+		if rule.FromTable == from {
+			index = i
+			break
+		}
+	}
+
+	switch alterVschema.Action {
+	case sqlparser.AddRoutingRuleDDLAction:
+		if index != -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "routing rule for %s already exists", from)
+		}
+		if alterVschema.NewName.Qualifier.IsEmpty() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "routing rule for %s must route to a table qualified with its keyspace", from)
+		}
+		result.Rules = append(result.Rules, &vschemapb.RoutingRule{
+			FromTable: from,
+			ToTables:  []string{RoutingRuleTableName(alterVschema.NewName)},
+		})
+		return result, nil
+	case sqlparser.DropRoutingRuleDDLAction:
+		if index == -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "routing rule for %s does not exist", from)
+		}
+		result.Rules = append(result.Rules[:index], result.Rules[index+1:]...)
+		return result, nil
+	}
+
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected routing rule ddl operation %s", alterVschema.Action.ToString())
+}
+
+// RoutingRuleTableName returns the name of the table as written in the
+// routing rules, like keyspace.table.
+func RoutingRuleTableName(name sqlparser.TableName) string {
+	if name.Qualifier.IsEmpty() {
+		return name.Name.String()
+	}
+	return name.Qualifier.String() + "." + name.Name.String()
+}
+
+// ImportVindex installs the given vindex definition under name in the
+// keyspace vschema and returns the modified keyspace object. The
+// definition is validated by constructing the vindex with its
+// registered factory.
+func ImportVindex(ksName string, ks *vschemapb.Keyspace, name string, vindex *vschemapb.Vindex) (*vschemapb.Keyspace, error) {
+	if ks == nil {
+		ks = new(vschemapb.Keyspace)
+	}
+
+	if ks.Vindexes == nil {
+		ks.Vindexes = map[string]*vschemapb.Vindex{}
+	}
+
+	if _, ok := ks.Vindexes[name]; ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s already exists in keyspace %s", name, ksName)
+	}
+
+	if err := checkVindexType(vindex.Type); err != nil {
+		return nil, err
+	}
+	if _, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid definition for vindex %s: %v", name, err)
+	}
+
+	// Make sure the keyspace has the sharded bit set to true
+	// if this is the first vindex defined in the keyspace.
+	if len(ks.Vindexes) == 0 {
+		ks.Sharded = true
+	}
+	ks.Vindexes[name] = vindex
+
+	return ks, nil
+}
+
+// CopyVSchemaKeyspace returns a deep copy of the src keyspace vschema to
+// be installed as keyspace dst. References to tables of src, like the
+// sequence of an auto_increment or the table of a lookup vindex, are
+// changed to point to the same table in dst.
+func CopyVSchemaKeyspace(src string, srcKs *vschemapb.Keyspace, dst string) *vschemapb.Keyspace {
+	ks := proto.Clone(srcKs).(*vschemapb.Keyspace)
+	for _, vindex := range ks.Vindexes {
+		if table, ok := vindex.Params["table"]; ok {
+			vindex.Params["table"] = requalify(table, src, dst)
+		}
+	}
+	for _, table := range ks.Tables {
+		if table.AutoIncrement != nil {
+			table.AutoIncrement.Sequence = requalify(table.AutoIncrement.Sequence, src, dst)
+		}
+		table.Source = requalify(table.Source, src, dst)
+		if table.Parent != nil {
+			table.Parent.Table = requalify(table.Parent.Table, src, dst)
+		}
+		for _, colVindex := range table.ColumnVindexes {
+			if source := colVindex.BackfillSource; source != nil {
+				source.Table = requalify(source.Table, src, dst)
+				source.LookupTable = requalify(source.LookupTable, src, dst)
+			}
+		}
+	}
+	return ks
+}
+
+// CheckParentTable checks that the table of childKs can take the parent
+// table of a SET PARENT statement: both tables exist, and the columns
+// of the child table reference the columns of the primary vindex of the
+// parent with a primary vindex of the same type and params, so that a
+// child row is on the shard of the parent row it references. Tables of
+// the same unsharded keyspace are always compatible.
+func CheckParentTable(childKsName string, childKs *vschemapb.Keyspace, parentKsName string, parentKs *vschemapb.Keyspace, alterVschema *sqlparser.AlterVschema) error {
+	childName := alterVschema.Table.Name.String()
+	parentName := alterVschema.ParentSpec.Parent.Name.String()
+	var child, parent *vschemapb.Table
+	if childKs != nil {
+		child = childKs.Tables[childName]
+	}
+	if child == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vschema does not contain table %s in keyspace %s", childName, childKsName)
+	}
+	if parentKs != nil {
+		parent = parentKs.Tables[parentName]
+	}
+	if parent == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vschema does not contain parent table %s in keyspace %s", parentName, parentKsName)
+	}
+	spec := alterVschema.ParentSpec
+	if len(spec.Columns) != len(spec.ReferencedColumns) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "set parent: %d columns of table %s reference %d columns", len(spec.Columns), childName, len(spec.ReferencedColumns))
+	}
+
+	if !childKs.Sharded && !parentKs.Sharded && childKsName == parentKsName {
+		return nil
+	}
+	if len(child.ColumnVindexes) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s has no primary vindex to be co-located with parent table %s.%s", childName, parentKsName, parentName)
+	}
+	if len(parent.ColumnVindexes) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "parent table %s.%s has no primary vindex", parentKsName, parentName)
+	}
+	childPrimary, parentPrimary := child.ColumnVindexes[0], parent.ColumnVindexes[0]
+	childVindex, parentVindex := childKs.Vindexes[childPrimary.Name], parentKs.Vindexes[parentPrimary.Name]
+	if childVindex == nil || parentVindex == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "primary vindex of table %s or of parent table %s.%s is not defined", childName, parentKsName, parentName)
+	}
+	if childVindex.Type != parentVindex.Type || !(reflect.DeepEqual(childVindex.Params, parentVindex.Params) || equivalentVindexes(childPrimary.Name, childVindex, parentVindex.Params)) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "primary vindex %s of table %s is not compatible with primary vindex %s of parent table %s.%s", childPrimary.Name, childName, parentPrimary.Name, parentKsName, parentName)
+	}
+
+	// Each column of the child primary vindex has to reference the
+	// column of the parent primary vindex at the same position.
+	childCols, parentCols := bindingColumns(childPrimary), bindingColumns(parentPrimary)
+	if len(childCols) != len(parentCols) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "primary vindex %s of table %s is not compatible with primary vindex %s of parent table %s.%s", childPrimary.Name, childName, parentPrimary.Name, parentKsName, parentName)
+	}
+	for i, col := range childCols {
+		referenced := ""
+		for j, c := range spec.Columns {
+			if c.EqualString(col) {
+				referenced = spec.ReferencedColumns[j].String()
+				break
+			}
+		}
+		if !strings.EqualFold(referenced, parentCols[i]) {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column %s of the primary vindex of table %s does not reference column %s of the primary vindex of parent table %s.%s", col, childName, parentCols[i], parentKsName, parentName)
+		}
+	}
+	return nil
+}
+
+// VSchemaDDL renders the vschema as the ALTER VSCHEMA statements that
+// recreate it when they are applied to an empty vschema. The statements
+// are in dependency order: the vindexes of every keyspace come first,
+// then the tables and sequences, then the vindex bindings, then the
+// auto increments, whose sequence can be in another keyspace, then the
+// parent tables, which can be bound in another keyspace too, then the
+// keyspace comments, then the routing rules and last the label.
+// Keyspaces, vindexes and tables are sorted by name, and statements are
+// qualified with the keyspace. Routing rules keep their order. Table
+// properties that ALTER VSCHEMA cannot set, like column lists or pinned
+// keyspace ids, are left out, and so are routing rules to more than one
+// table.
+func VSchemaDDL(vschema *vschemapb.SrvVSchema) []string {
+	var vindexDDL, tableDDL, bindingDDL, autoIncDDL, parentDDL, commentDDL, ruleDDL []string
+	for _, ksName := range sortedKeys(vschema.Keyspaces) {
+		ks := vschema.Keyspaces[ksName]
+		if ks.Comment != "" {
+			commentDDL = append(commentDDL, sqlparser.String(&sqlparser.AlterVschema{
+				Action:  sqlparser.SetKeyspaceCommentDDLAction,
+				Table:   sqlparser.TableName{Qualifier: sqlparser.NewTableIdent(ksName)},
+				Comment: ks.Comment,
+			}))
+		}
+		for _, name := range sortedKeys(ks.Vindexes) {
+			vindex := ks.Vindexes[name]
+			vindexDDL = append(vindexDDL, sqlparser.String(&sqlparser.AlterVschema{
+				Action: sqlparser.CreateVindexDDLAction,
+				Table:  qualifiedTableName(ksName, name),
+				VindexSpec: &sqlparser.VindexSpec{
+					Type:   sqlparser.NewColIdent(vindex.Type),
+					Params: vindexDDLParams(vindex.Owner, vindex.Params),
+				},
+			}))
+		}
+
+		for _, tableName := range sortedKeys(ks.Tables) {
+			table := ks.Tables[tableName]
+			name := qualifiedTableName(ksName, tableName)
+			switch {
+			case table.Type == vindexes.TypeSequence:
+				ddl := &sqlparser.AlterVschema{Action: sqlparser.AddSequenceDDLAction, Table: name}
+				if params := table.SequenceParams; params != nil {
+					if params.Cache > 0 {
+						ddl.SequenceParams = append(ddl.SequenceParams, sqlparser.VindexParam{Key: sqlparser.NewColIdent(sqlparser.SequenceCacheStr), Val: strconv.FormatInt(params.Cache, 10)})
+					}
+					if params.Start > 0 {
+						ddl.SequenceParams = append(ddl.SequenceParams, sqlparser.VindexParam{Key: sqlparser.NewColIdent(sqlparser.SequenceStartStr), Val: strconv.FormatInt(params.Start, 10)})
+					}
+				}
+				tableDDL = append(tableDDL, sqlparser.String(ddl))
+			case table.Type == vindexes.TypeReference:
+				ddl := &sqlparser.AlterVschema{Action: sqlparser.AddReferenceTableDDLAction, Table: name}
+				if table.Source != "" {
+					ddl.ReferenceSource = parseQualifiedName(table.Source)
+				}
+				tableDDL = append(tableDDL, sqlparser.String(ddl))
+			case len(table.ColumnVindexes) == 0 && !ks.Sharded:
+				tableDDL = append(tableDDL, sqlparser.String(&sqlparser.AlterVschema{Action: sqlparser.AddVschemaTableDDLAction, Table: name}))
+			case table.Scatter:
+				tableDDL = append(tableDDL, sqlparser.String(&sqlparser.AlterVschema{Action: sqlparser.SetScatterTableDDLAction, Table: name, Scatter: true}))
+			}
+
+			for i, colVindex := range table.ColumnVindexes {
+				columns := colVindex.Columns
+				if colVindex.Column != "" {
+					columns = []string{colVindex.Column}
+				}
+				ddl := &sqlparser.AlterVschema{
+					Action:     sqlparser.AddColVindexDDLAction,
+					Table:      name,
+					VindexSpec: &sqlparser.VindexSpec{Name: sqlparser.NewColIdent(colVindex.Name)},
+				}
+				for _, col := range columns {
+					ddl.VindexCols = append(ddl.VindexCols, sqlparser.NewColIdent(col))
+				}
+				// An expression that doesn't parse is rejected when
+				// the vschema is built, so it is left out here.
+				if colVindex.Expression != "" {
+					if expr, err := vindexes.ParseVindexExpression(colVindex.Expression); err == nil {
+						ddl.VindexExpr = expr
+					}
+				}
+				// Backfill is required by default for every binding
+				// but the first one.
+				if colVindex.BackfillRequired != (i > 0) {
+					ddl.VindexSpec.Params = []sqlparser.VindexParam{{
+						Key: sqlparser.NewColIdent(sqlparser.VindexBackfillRequiredStr),
+						Val: strconv.FormatBool(colVindex.BackfillRequired),
+					}}
+				}
+				bindingDDL = append(bindingDDL, sqlparser.String(ddl))
+				if colVindex.Disabled {
+					bindingDDL = append(bindingDDL, sqlparser.String(&sqlparser.AlterVschema{
+						Action:     sqlparser.DisableColVindexDDLAction,
+						Table:      name,
+						VindexSpec: &sqlparser.VindexSpec{Name: sqlparser.NewColIdent(colVindex.Name)},
+					}))
+				}
+			}
+
+			if table.AutoIncrement != nil {
+				autoIncDDL = append(autoIncDDL, sqlparser.String(&sqlparser.AlterVschema{
+					Action: sqlparser.AddAutoIncDDLAction,
+					Table:  name,
+					AutoIncSpec: &sqlparser.AutoIncSpec{
+						Column:   sqlparser.NewColIdent(table.AutoIncrement.Column),
+						Sequence: parseQualifiedName(table.AutoIncrement.Sequence),
+					},
+				}))
+			}
+
+			if parent := table.Parent; parent != nil {
+				spec := &sqlparser.ParentSpec{Parent: parseQualifiedName(parent.Table)}
+				for i, col := range parent.Columns {
+					spec.Columns = append(spec.Columns, sqlparser.NewColIdent(col))
+					spec.ReferencedColumns = append(spec.ReferencedColumns, sqlparser.NewColIdent(parent.ReferencedColumns[i]))
+				}
+				parentDDL = append(parentDDL, sqlparser.String(&sqlparser.AlterVschema{
+					Action:     sqlparser.SetParentTableDDLAction,
+					Table:      name,
+					ParentSpec: spec,
+				}))
+			}
+		}
+	}
+
+	for _, rule := range vschema.GetRoutingRules().GetRules() {
+		if len(rule.ToTables) != 1 {
+			continue
+		}
+		ruleDDL = append(ruleDDL, sqlparser.String(&sqlparser.AlterVschema{
+			Action:  sqlparser.AddRoutingRuleDDLAction,
+			Table:   parseQualifiedName(rule.FromTable),
+			NewName: parseQualifiedName(rule.ToTables[0]),
+		}))
+	}
+
+	ddls := make([]string, 0, len(vindexDDL)+len(tableDDL)+len(bindingDDL)+len(autoIncDDL)+len(parentDDL)+len(commentDDL)+len(ruleDDL)+1)
+	ddls = append(ddls, vindexDDL...)
+	ddls = append(ddls, tableDDL...)
+	ddls = append(ddls, bindingDDL...)
+	ddls = append(ddls, autoIncDDL...)
+	ddls = append(ddls, parentDDL...)
+	ddls = append(ddls, commentDDL...)
+	ddls = append(ddls, ruleDDL...)
+	if vschema.Label != "" {
+		ddls = append(ddls, sqlparser.String(&sqlparser.AlterVschema{Action: sqlparser.SetVSchemaLabelDDLAction, Label: vschema.Label}))
+	}
+	return ddls
+}
+
+// vindexDDLParams returns the params of a CREATE VINDEX statement, sorted
+// by name. The values are quoted as identifiers when needed, because
+// they are printed as is.
+func vindexDDLParams(owner string, params map[string]string) []sqlparser.VindexParam {
+	all := make(map[string]string, len(params)+1)
+	for k, v := range params {
+		all[k] = v
+	}
+	if owner != "" {
+		all[sqlparser.VindexOwnerStr] = owner
+	}
+	result := make([]sqlparser.VindexParam, 0, len(all))
+	for _, k := range sortedKeys(all) {
+		result = append(result, sqlparser.VindexParam{
+			Key: sqlparser.NewColIdent(k),
+			Val: sqlparser.String(sqlparser.NewColIdent(all[k])),
+		})
+	}
+	return result
+}
+
+// qualifiedTableName returns the name qualified with the keyspace.
+func qualifiedTableName(ksName, name string) sqlparser.TableName {
+	return sqlparser.TableName{
+		Qualifier: sqlparser.NewTableIdent(ksName),
+		Name:      sqlparser.NewTableIdent(name),
+	}
+}
+
+// parseQualifiedName splits a name of the form keyspace.table, as stored
+// in the vschema for sequences and reference sources.
+func parseQualifiedName(fqn string) sqlparser.TableName {
+	if i := strings.LastIndex(fqn, "."); i != -1 {
+		return qualifiedTableName(fqn[:i], fqn[i+1:])
+	}
+	return sqlparser.TableName{Name: sqlparser.NewTableIdent(fqn)}
+}
+
+// sortedKeys returns the keys of a map with string keys, sorted.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		result[i] = k.String()
+	}
+	sort.Strings(result)
+	return result
+}
+
+// requalify changes the keyspace qualifier of name from src to dst.
+// Names without a qualifier or qualified with another keyspace are
+// returned as is.
+func requalify(name, src, dst string) string {
+	if strings.HasPrefix(name, src+".") {
+		return dst + name[len(src):]
+	}
+	return name
+}
+
+// equivalentVindexes returns true if the existing vindex and the one
+// defined by params, of the same type, are known to behave the same
+// way. Only vindexes that implement vindexes.Comparable can tell.
+func equivalentVindexes(name string, existing *vschemapb.Vindex, params map[string]string) bool {
+	a, err := vindexes.CreateVindex(existing.Type, name, existing.Params)
+	if err != nil {
+		return false
+	}
+	c, ok := a.(vindexes.Comparable)
+	if !ok {
+		return false
+	}
+	b, err := vindexes.CreateVindex(existing.Type, name, params)
+	if err != nil {
+		return false
+	}
+	return c.Equivalent(b)
+}
+
+// keepEmptyTable handles a table whose last vindex was dropped. With
+// cascade, the table entry is removed. Otherwise it is kept, unless a
+// sharded keyspace can't route the table without a vindex: only reference,
+// pinned and scatter tables can do without one. The table has to be set
+// as scatter explicitly beforehand, a drop never changes it.
+func keepEmptyTable(ksName string, ks *vschemapb.Keyspace, tableName string, table *vschemapb.Table, cascade bool) error {
+	if cascade {
+		delete(ks.Tables, tableName)
+		return nil
+	}
+	if ks.Sharded && table.Type != vindexes.TypeReference && table.Pinned == "" && !table.Scatter {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %s.%s would have no vindex left: use cascade to drop it from the vschema, or set scatter = true on it first", ksName, tableName)
+	}
+	return nil
+}
+
+// checkPrimaryBinding checks that the binding can become the primary
+// vindex of the table. On top of checkPrimaryVindex, it must not be
+// disabled.
+func checkPrimaryBinding(ksName string, ks *vschemapb.Keyspace, tableName string, colVindex *vschemapb.ColumnVindex) error {
+	if colVindex.Disabled {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vindex %s is disabled and cannot be the primary vindex of table %s", colVindex.Name, tableName)
+	}
+	return checkPrimaryVindex(ksName, ks, tableName, colVindex.Name)
+}
+
+// checkPrimaryVindex checks that the named vindex of the keyspace can be
+// the primary vindex of the table: it has to be unique, and not owned by
+// the table.
+func checkPrimaryVindex(ksName string, ks *vschemapb.Keyspace, tableName, name string) error {
+	vindex, ok := ks.Vindexes[name]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s does not exist in keyspace %s", name, ksName)
+	}
+	v, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid definition for vindex %s: %v", name, err)
+	}
+	if !v.IsUnique() {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vindex %s is not unique and cannot be the primary vindex of table %s", name, tableName)
+	}
+	if _, ok := v.(vindexes.Lookup); ok && vindex.Owner == tableName {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vindex %s is owned by table %s and cannot be its primary vindex", name, tableName)
+	}
+	return nil
+}
+
+// checkVindexType returns an error listing the known vindex types
+// if vindexType has not been registered.
+func checkVindexType(vindexType string) error {
+	known := vindexes.RegisteredVindexTypes()
+	for _, t := range known {
+		if t == vindexType {
+			return nil
+		}
+	}
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown vindex type %s; known types: %s", vindexType, strings.Join(known, ", "))
+}
+
+// sameColumnSet returns true if the column vindex is bound to exactly the
+// given columns, regardless of their order and case.
+func sameColumnSet(colVindex *vschemapb.ColumnVindex, columns []string) bool {
+	bound := bindingColumns(colVindex)
+	if len(bound) != len(columns) {
+		return false
+	}
+	set := make(map[string]bool, len(bound))
+	for _, col := range bound {
+		set[strings.ToLower(col)] = true
+	}
+	for _, col := range columns {
+		if !set[strings.ToLower(col)] {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupBackfillSource returns the backfill source of a binding of the
+// vindex to the table, or nil if the vindex is not a lookup owned by the
+// table. The rows of the owner populate its lookup table, the other
+// bindings only read it.
+func lookupBackfillSource(ksName, tableName, name string, vindex *vschemapb.Vindex, columns []string) *vschemapb.BackfillSource {
+	if vindex.Owner != tableName {
+		return nil
+	}
+	v, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params)
+	if err != nil {
+		return nil
+	}
+	if _, ok := v.(vindexes.Lookup); !ok {
+		return nil
+	}
+	source := &vschemapb.BackfillSource{
+		Table:       fmt.Sprintf("%s.%s", ksName, tableName),
+		Columns:     columns,
+		LookupTable: vindex.Params["table"],
+		To:          vindex.Params["to"],
+	}
+	for _, from := range strings.Split(vindex.Params["from"], ",") {
+		source.From = append(source.From, strings.TrimSpace(from))
+	}
+	return source
+}
+
+// bindingColumns returns the columns the column vindex is bound to,
+// whether it uses the legacy single column or the list of columns.
+func bindingColumns(colVindex *vschemapb.ColumnVindex) []string {
+	if colVindex.Column != "" {
+		return []string{colVindex.Column}
+	}
+	return colVindex.Columns
+}
+
+// checkVindexDefinition makes sure that the vindex can be created with
+// its params, like the vindexes of a vschema when it is loaded.
+func checkVindexDefinition(name string, vindex *vschemapb.Vindex) error {
+	if _, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params); err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid definition for vindex %s: %v", name, err)
+	}
+	return nil
+}
+
+// checkStrictParams rejects the params that the vindex type doesn't
+// declare, if vindex params are strict.
+func checkStrictParams(vindexType string, params map[string]string) error {
+	if !*vindexes.StrictParams {
+		return nil
+	}
+	if err := vindexes.CheckParams(vindexType, params); err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	return nil
+}
+
+// diffVindexParams describes every parameter whose value differs between
+// the existing vindex definition and the provided one, sorted by name.
+func diffVindexParams(existing, provided map[string]string) string {
+	keys := make([]string, 0, len(existing)+len(provided))
+	for k := range existing {
+		keys = append(keys, k)
+	}
+	for k := range provided {
+		if _, ok := existing[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	describe := func(params map[string]string, key string) string {
+		if v, ok := params[key]; ok {
+			return fmt.Sprintf("%q", v)
+		}
+		return "<unset>"
+	}
+
+	diffs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		oldVal, oldOk := existing[k]
+		newVal, newOk := provided[k]
+		if oldOk == newOk && oldVal == newVal {
+			continue
+		}
+		diffs = append(diffs, fmt.Sprintf("%s (existing %s, provided %s)", k, describe(existing, k), describe(provided, k)))
+	}
+	return strings.Join(diffs, ", ")
+}
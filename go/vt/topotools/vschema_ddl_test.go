@@ -0,0 +1,802 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// applyDDL parses the ALTER VSCHEMA statement and applies it to the vschema
+// of keyspace ks.
+func applyDDL(t *testing.T, ks *vschemapb.Keyspace, sql string) (*vschemapb.Keyspace, error) {
+	t.Helper()
+	stmt, err := sqlparser.Parse(sql)
+	require.NoError(t, err)
+	return ApplyVSchemaDDL("ks", ks, stmt.(*sqlparser.AlterVschema))
+}
+
+func TestImportVindex(t *testing.T) {
+	vindex := &vschemapb.Vindex{}
+	err := json2.Unmarshal([]byte(`{"type": "lookup_hash", "params": {"table": "t_lkp", "from": "id", "to": "keyspace_id"}, "owner": "t"}`), vindex)
+	require.NoError(t, err)
+
+	ks, err := ImportVindex("ks", nil, "t_lkp", vindex)
+	require.NoError(t, err)
+	assert.True(t, ks.Sharded)
+	assert.Equal(t, vindex, ks.Vindexes["t_lkp"])
+
+	_, err = ImportVindex("ks", ks, "t_lkp", vindex)
+	assert.EqualError(t, err, "vindex t_lkp already exists in keyspace ks")
+}
+
+func TestImportVindexInvalid(t *testing.T) {
+	vindex := &vschemapb.Vindex{}
+	err := json2.Unmarshal([]byte(`{"type": "lookup_hash", "params": {"table": "t_lkp", "from": "id", "to": "keyspace_id", "autocommit": "maybe"}}`), vindex)
+	require.NoError(t, err)
+
+	ks := &vschemapb.Keyspace{}
+	_, err = ImportVindex("ks", ks, "t_lkp", vindex)
+	assert.EqualError(t, err, "invalid definition for vindex t_lkp: autocommit value must be 'true' or 'false': 'maybe'")
+	assert.Empty(t, ks.Vindexes)
+
+	_, err = ImportVindex("ks", ks, "v", &vschemapb.Vindex{Type: "no_such_type"})
+	assert.Contains(t, err.Error(), "unknown vindex type no_such_type")
+}
+
+func TestDropColVindexCascade(t *testing.T) {
+	newKeyspace := func() *vschemapb.Keyspace {
+		return &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash": {Type: "hash"},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"t": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}},
+				},
+				"pinned": {
+					Pinned:         "80",
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}},
+				},
+			},
+		}
+	}
+
+	// Without cascade, a sharded table can't lose its last vindex, since
+	// it can't be routed without one.
+	_, err := applyDDL(t, newKeyspace(), "alter vschema on t drop vindex hash")
+	assert.EqualError(t, err, "table ks.t would have no vindex left: use cascade to drop it from the vschema, or set scatter = true on it first")
+
+	// Unless it is set as scatter first, then its entry is kept.
+	ks, err := applyDDL(t, newKeyspace(), "alter vschema on t set scatter = true")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t drop vindex hash")
+	require.NoError(t, err)
+	require.Contains(t, ks.Tables, "t")
+	assert.Empty(t, ks.Tables["t"].ColumnVindexes)
+	assert.True(t, ks.Tables["t"].Scatter)
+
+	// A pinned table doesn't need a vindex, so its entry is kept.
+	ks, err = applyDDL(t, newKeyspace(), "alter vschema on pinned drop vindex hash")
+	require.NoError(t, err)
+	require.Contains(t, ks.Tables, "pinned")
+	assert.Empty(t, ks.Tables["pinned"].ColumnVindexes)
+	assert.False(t, ks.Tables["pinned"].Scatter)
+
+	// With cascade, the table entry is removed along with its last vindex.
+	ks, err = applyDDL(t, newKeyspace(), "alter vschema on t drop vindex hash cascade")
+	require.NoError(t, err)
+	assert.NotContains(t, ks.Tables, "t")
+	assert.Contains(t, ks.Tables, "pinned")
+}
+
+func TestDropPrimaryColVindex(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex hash (id) using hash")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp (c1) using lookup with table=t_lkp, from=c1, to=keyspace_id")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp_unique (c2) using lookup_unique with table=t_lkp_unique, from=c2, to=keyspace_id, owner=t")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex xxhash (c3) using xxhash")
+	require.NoError(t, err)
+
+	// The primary vindex can't be dropped if the next one can't replace it.
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t drop vindex hash")
+	assert.EqualError(t, err, "cannot drop primary vindex hash of table ks.t: vindex t_lkp is not unique and cannot be the primary vindex of table t")
+
+	// FORCE skips the check.
+	forced, err := applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t drop vindex hash force")
+	require.NoError(t, err)
+	assert.Equal(t, "t_lkp", forced.Tables["t"].ColumnVindexes[0].Name)
+
+	ks, err = applyDDL(t, ks, "alter vschema on t drop vindex t_lkp")
+	require.NoError(t, err)
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t drop vindex hash")
+	assert.EqualError(t, err, "cannot drop primary vindex hash of table ks.t: vindex t_lkp_unique is owned by table t and cannot be its primary vindex")
+
+	ks, err = applyDDL(t, ks, "alter vschema on t drop vindex t_lkp_unique")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t drop vindex hash")
+	require.NoError(t, err)
+	require.Len(t, ks.Tables["t"].ColumnVindexes, 1)
+	assert.Equal(t, "xxhash", ks.Tables["t"].ColumnVindexes[0].Name)
+
+	// The last vindex can only be dropped with cascade.
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t drop vindex xxhash")
+	assert.EqualError(t, err, "table ks.t would have no vindex left: use cascade to drop it from the vschema, or set scatter = true on it first")
+	ks, err = applyDDL(t, ks, "alter vschema on t drop vindex xxhash cascade")
+	require.NoError(t, err)
+	assert.NotContains(t, ks.Tables, "t")
+}
+
+func TestAddColVindexPrimaryUnique(t *testing.T) {
+	_, err := applyDDL(t, nil, "alter vschema on t add vindex t_lkp (c) using lookup with table=t_lkp, from=c, to=keyspace_id")
+	assert.EqualError(t, err, "vindex t_lkp is not unique and cannot be the primary vindex of table t")
+
+	// A non-unique vindex is still allowed as a secondary vindex.
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex hash (id) using hash")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp (c) using lookup with table=t_lkp, from=c, to=keyspace_id")
+	require.NoError(t, err)
+	assert.Len(t, ks.Tables["t"].ColumnVindexes, 2)
+}
+
+func TestAddColVindexEquivalentParams(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex t_lkp_unique (c) using lookup_unique with table=t_lkp, from=c, to=keyspace_id")
+	require.NoError(t, err)
+
+	// The existing definition is kept when the params are equivalent.
+	ks, err = applyDDL(t, ks, "alter vschema on t2 add vindex t_lkp_unique (c) using lookup_unique with table=t_lkp, from=c, to=keyspace_id, autocommit=false")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"table": "t_lkp", "from": "c", "to": "keyspace_id"}, ks.Vindexes["t_lkp_unique"].Params)
+	assert.Len(t, ks.Tables["t2"].ColumnVindexes, 1)
+
+	_, err = applyDDL(t, ks, "alter vschema on t3 add vindex t_lkp_unique (c) using lookup_unique with table=t_lkp, from=c, to=keyspace_id, autocommit=true")
+	assert.EqualError(t, err, `vindex t_lkp_unique defined with different parameters: autocommit (existing <unset>, provided "true")`)
+}
+
+func TestAddColVindexDuplicateColumns(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex hash (id) using hash")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp (c1, c2) using lookup with table=t_lkp, from=`c1,c2`, to=keyspace_id")
+	require.NoError(t, err)
+
+	_, err = applyDDL(t, ks, "alter vschema on t add vindex xxhash (ID) using xxhash")
+	assert.EqualError(t, err, "columns (ID) of table t are already bound to vindex hash")
+	_, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp2 (c2, c1) using lookup with table=t_lkp2, from=`c2,c1`, to=keyspace_id")
+	assert.EqualError(t, err, "columns (c2, c1) of table t are already bound to vindex t_lkp")
+
+	// Overlapping but different column sets are allowed.
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp3 (c1, c2, c3) using lookup with table=t_lkp3, from=`c1,c2,c3`, to=keyspace_id")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp4 (c1) using lookup with table=t_lkp4, from=c1, to=keyspace_id")
+	require.NoError(t, err)
+	assert.Len(t, ks.Tables["t"].ColumnVindexes, 4)
+}
+
+func TestAddColVindexExpression(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex hash (id) using hash")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex email_md5 (email_lower as (LOWER(email))) using unicode_loose_md5")
+	require.NoError(t, err)
+	assert.Equal(t, &vschemapb.ColumnVindex{
+		Name:             "email_md5",
+		Columns:          []string{"email_lower"},
+		BackfillRequired: true,
+		Expression:       "LOWER(email)",
+	}, ks.Tables["t"].ColumnVindexes[1])
+
+	_, err = applyDDL(t, ks, "alter vschema on t add vindex name_md5 (name_hash as (md5(name))) using unicode_loose_md5")
+	assert.EqualError(t, err, "vindex expression md5(`name`): function md5 is not supported")
+	_, err = applyDDL(t, ks, "alter vschema on t add vindex name_md5 (name_x as (name in (1, 2))) using unicode_loose_md5")
+	assert.EqualError(t, err, "vindex expression `name` in (1, 2): `name` in (1, 2) is not supported")
+
+	// MySQL doesn't change the case of a binary string.
+	ks.Tables["t"].Columns = []*vschemapb.Column{{Name: "token", Type: querypb.Type_VARBINARY}}
+	_, err = applyDDL(t, ks, "alter vschema on t add vindex token_md5 (token_lower as (lower(token))) using unicode_loose_md5")
+	assert.EqualError(t, err, "vindex expression lower(token): column token is binary")
+}
+
+func TestReorderColVindex(t *testing.T) {
+	order := func(ks *vschemapb.Keyspace) []string {
+		var names []string
+		for _, colVindex := range ks.Tables["t"].ColumnVindexes {
+			names = append(names, colVindex.Name)
+		}
+		return names
+	}
+
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex hash (id) using hash")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex t_lkp (c1) using lookup with table=t_lkp, from=c1, to=keyspace_id")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex xxhash (c2) using xxhash")
+	require.NoError(t, err)
+	require.Equal(t, []string{"hash", "t_lkp", "xxhash"}, order(ks))
+
+	ks, err = applyDDL(t, ks, "alter vschema on t reorder vindex xxhash before t_lkp")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hash", "xxhash", "t_lkp"}, order(ks))
+
+	ks, err = applyDDL(t, ks, "alter vschema on t reorder vindex hash after t_lkp")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"xxhash", "t_lkp", "hash"}, order(ks))
+
+	// The bindings are left as they are on errors.
+	_, err = applyDDL(t, ks, "alter vschema on t reorder vindex t_lkp before xxhash")
+	assert.EqualError(t, err, "vindex t_lkp is not unique and cannot be the primary vindex of table t")
+	_, err = applyDDL(t, ks, "alter vschema on t reorder vindex nope before xxhash")
+	assert.EqualError(t, err, "vindex nope not defined in table ks.t")
+	_, err = applyDDL(t, ks, "alter vschema on t reorder vindex hash after nope")
+	assert.EqualError(t, err, "vindex nope not defined in table ks.t")
+	_, err = applyDDL(t, ks, "alter vschema on t reorder vindex hash after hash")
+	assert.EqualError(t, err, "cannot reorder vindex hash relative to itself")
+	_, err = applyDDL(t, ks, "alter vschema on t2 reorder vindex hash after xxhash")
+	assert.EqualError(t, err, "table ks.t2 not defined in vschema")
+	assert.Equal(t, []string{"xxhash", "t_lkp", "hash"}, order(ks))
+}
+
+func TestDisableColVindex(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindex hash (id) using hash")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t add vindex xxhash (c1) using xxhash")
+	require.NoError(t, err)
+
+	ks, err = applyDDL(t, ks, "alter vschema on t disable vindex xxhash")
+	require.NoError(t, err)
+	assert.True(t, ks.Tables["t"].ColumnVindexes[1].Disabled)
+	assert.Contains(t, ks.Vindexes, "xxhash")
+
+	// A disabled vindex can't become the primary vindex.
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t reorder vindex xxhash before hash")
+	assert.EqualError(t, err, "vindex xxhash is disabled and cannot be the primary vindex of table t")
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t drop vindex hash")
+	assert.EqualError(t, err, "cannot drop primary vindex hash of table ks.t: vindex xxhash is disabled and cannot be the primary vindex of table t")
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t disable vindex hash")
+	assert.EqualError(t, err, "vindex hash is the primary vindex of table ks.t and cannot be disabled")
+	_, err = applyDDL(t, proto.Clone(ks).(*vschemapb.Keyspace), "alter vschema on t disable vindex nope")
+	assert.EqualError(t, err, "vindex nope not defined in table ks.t")
+
+	ks, err = applyDDL(t, ks, "alter vschema on t enable vindex xxhash")
+	require.NoError(t, err)
+	assert.False(t, ks.Tables["t"].ColumnVindexes[1].Disabled)
+	ks, err = applyDDL(t, ks, "alter vschema on t reorder vindex xxhash before hash")
+	require.NoError(t, err)
+	assert.Equal(t, "xxhash", ks.Tables["t"].ColumnVindexes[0].Name)
+}
+
+func TestAddColVindexes(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema on t add vindexes (id using hash, name using unicode_loose_md5)")
+	require.NoError(t, err)
+	// The bindings are added as if by consecutive ADD VINDEX statements.
+	want := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash_id":                {Type: "hash"},
+			"unicode_loose_md5_name": {Type: "unicode_loose_md5"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "hash_id", Columns: []string{"id"}},
+					{Name: "unicode_loose_md5_name", Columns: []string{"name"}, BackfillRequired: true},
+				},
+			},
+		},
+	}
+	assert.True(t, proto.Equal(want, ks), "got %v, want %v", ks, want)
+
+	// A failing binding leaves the keyspace unchanged.
+	_, err = applyDDL(t, ks, "alter vschema on t add vindexes (c1 using xxhash, c2 using nope)")
+	require.Error(t, err)
+	assert.True(t, proto.Equal(want, ks), "keyspace was modified")
+}
+
+func TestStrictVindexParams(t *testing.T) {
+	const createMisspelled = "alter vschema create vindex t_lkp using lookup_unique with table=t_lkp, from=c, to=keyspace_id, write_onyl=true"
+	const addMisspelled = "alter vschema on t add vindex t_lkp (c) using lookup_unique with table=t_lkp, from=c, to=keyspace_id, write_onyl=true"
+
+	// Unknown params are ignored by default.
+	ks, err := applyDDL(t, nil, createMisspelled)
+	require.NoError(t, err)
+	assert.Equal(t, "true", ks.Vindexes["t_lkp"].Params["write_onyl"])
+	_, err = applyDDL(t, nil, addMisspelled)
+	require.NoError(t, err)
+
+	*vindexes.StrictParams = true
+	defer func() {
+		*vindexes.StrictParams = false
+	}()
+
+	_, err = applyDDL(t, nil, createMisspelled)
+	assert.EqualError(t, err, `unknown params for vindexType "lookup_unique": write_onyl`)
+	_, err = applyDDL(t, nil, addMisspelled)
+	assert.EqualError(t, err, `unknown params for vindexType "lookup_unique": write_onyl`)
+
+	ks, err = applyDDL(t, nil, "alter vschema on t add vindex t_lkp (c) using lookup_unique with table=t_lkp, from=c, to=keyspace_id, write_only=true, tags=pii")
+	require.NoError(t, err)
+	assert.Equal(t, "true", ks.Vindexes["t_lkp"].Params["write_only"])
+
+	// A vindex type that declares no params accepts none.
+	_, err = applyDDL(t, nil, "alter vschema create vindex h using hash with salt=1")
+	assert.EqualError(t, err, `unknown params for vindexType "hash": salt`)
+	_, err = applyDDL(t, nil, "alter vschema create vindex h using hash")
+	assert.NoError(t, err)
+}
+
+func TestCreateVindexFromKeyMode(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema create vindex t_lkp using lookup with table=t_lkp, from=c, to=keyspace_id, from_key_mode=hash")
+	require.NoError(t, err)
+	assert.Equal(t, "hash", ks.Vindexes["t_lkp"].Params["from_key_mode"])
+
+	_, err = applyDDL(t, nil, "alter vschema create vindex t_lkp using lookup with table=t_lkp, from=c, to=keyspace_id, from_key_mode=bogus")
+	assert.EqualError(t, err, `invalid definition for vindex t_lkp: lookup: invalid from_key_mode "bogus", must be one of columns, concat, hash`)
+	_, err = applyDDL(t, nil, "alter vschema on t add vindex t_lkp (a, b) using lookup with table=t_lkp, from=c, to=keyspace_id, from_key_mode=bogus")
+	assert.EqualError(t, err, `invalid definition for vindex t_lkp: lookup: invalid from_key_mode "bogus", must be one of columns, concat, hash`)
+}
+
+func TestRenameVschemaTable(t *testing.T) {
+	newKeyspace := func() *vschemapb.Keyspace {
+		return &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash":  {Type: "hash"},
+				"t_lkp": {Type: "lookup", Owner: "t"},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"t": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Name: "hash", Columns: []string{"id"}},
+						{Name: "t_lkp", Columns: []string{"c"}, BackfillSource: &vschemapb.BackfillSource{Table: "ks.t", Columns: []string{"c"}, LookupTable: "t_lkp_idx"}},
+					},
+					AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "seq"},
+				},
+				"other": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}},
+				},
+			},
+		}
+	}
+
+	want := newKeyspace().Tables["t"]
+	want.ColumnVindexes[1].BackfillSource.Table = "ks.t2"
+	ks, err := applyDDL(t, newKeyspace(), "alter vschema rename table t to t2")
+	require.NoError(t, err)
+	assert.NotContains(t, ks.Tables, "t")
+	assert.Equal(t, want, ks.Tables["t2"])
+	assert.Equal(t, "t2", ks.Vindexes["t_lkp"].Owner)
+
+	_, err = applyDDL(t, newKeyspace(), "alter vschema rename table t to other")
+	assert.EqualError(t, err, "vschema already contains table other in keyspace ks")
+
+	_, err = applyDDL(t, newKeyspace(), "alter vschema rename table nonexistent to t2")
+	assert.EqualError(t, err, "vschema does not contain table nonexistent in keyspace ks")
+
+	_, err = applyDDL(t, newKeyspace(), "alter vschema rename table t to ks2.t2")
+	assert.EqualError(t, err, "cannot rename table t in keyspace ks to another keyspace ks2")
+
+	// References from the other tables of the keyspace follow the rename.
+	refs := newKeyspace()
+	refs.Tables["seq"] = &vschemapb.Table{Type: vindexes.TypeSequence}
+	refs.Tables["other"].AutoIncrement = &vschemapb.AutoIncrement{Column: "id", Sequence: "ks.seq"}
+	refs.Tables["other"].Parent = &vschemapb.ParentTable{Table: "ks.t", Columns: []string{"id"}, ReferencedColumns: []string{"id"}}
+	ks, err = applyDDL(t, refs, "alter vschema rename table t to t2")
+	require.NoError(t, err)
+	assert.Equal(t, "ks.t2", ks.Tables["other"].Parent.Table)
+	ks, err = applyDDL(t, ks, "alter vschema rename table seq to seq2")
+	require.NoError(t, err)
+	assert.Equal(t, "seq2", ks.Tables["t2"].AutoIncrement.Sequence)
+	assert.Equal(t, "ks.seq2", ks.Tables["other"].AutoIncrement.Sequence)
+}
+
+func TestAddSequenceParams(t *testing.T) {
+	ks, err := applyDDL(t, nil, "alter vschema add sequence seq")
+	require.NoError(t, err)
+	assert.Equal(t, "sequence", ks.Tables["seq"].Type)
+	assert.Nil(t, ks.Tables["seq"].SequenceParams)
+
+	ks, err = applyDDL(t, nil, "alter vschema add sequence seq with cache=1000, start=5000")
+	require.NoError(t, err)
+	assert.Equal(t, "sequence", ks.Tables["seq"].Type)
+	assert.EqualValues(t, 1000, ks.Tables["seq"].SequenceParams.Cache)
+	assert.EqualValues(t, 5000, ks.Tables["seq"].SequenceParams.Start)
+
+	_, err = applyDDL(t, nil, "alter vschema add sequence seq with cache=0")
+	assert.EqualError(t, err, "invalid value for sequence param cache: 0, must be a positive integer")
+
+	_, err = applyDDL(t, nil, "alter vschema add sequence seq with cache='-5'")
+	assert.EqualError(t, err, "invalid value for sequence param cache: '-5', must be a positive integer")
+
+	_, err = applyDDL(t, nil, "alter vschema add sequence seq with start=abc")
+	assert.EqualError(t, err, "invalid value for sequence param start: abc, must be a positive integer")
+
+	_, err = applyDDL(t, nil, "alter vschema add sequence seq with increment=2")
+	assert.EqualError(t, err, "unknown sequence param increment, supported params: cache, start")
+}
+
+func TestDropAllColVindexes(t *testing.T) {
+	newKeyspace := func() *vschemapb.Keyspace {
+		return &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash":  {Type: "hash"},
+				"t_lkp": {Type: "lookup", Owner: "t"},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"t": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}, {Name: "t_lkp", Columns: []string{"c"}}},
+				},
+				"pinned": {
+					Pinned:         "80",
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}},
+				},
+				"empty": {
+					Type: vindexes.TypeReference,
+				},
+			},
+		}
+	}
+
+	_, err := applyDDL(t, newKeyspace(), "alter vschema on t drop all vindexes")
+	assert.EqualError(t, err, "table ks.t would have no vindex left: use cascade to drop it from the vschema, or set scatter = true on it first")
+
+	ks, err := applyDDL(t, newKeyspace(), "alter vschema on t set scatter = true")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on t drop all vindexes")
+	require.NoError(t, err)
+	require.Contains(t, ks.Tables, "t")
+	assert.Empty(t, ks.Tables["t"].ColumnVindexes)
+	assert.True(t, ks.Tables["t"].Scatter)
+
+	ks, err = applyDDL(t, newKeyspace(), "alter vschema on t drop all vindexes cascade")
+	require.NoError(t, err)
+	assert.NotContains(t, ks.Tables, "t")
+	assert.Contains(t, ks.Vindexes, "t_lkp")
+
+	ks, err = applyDDL(t, newKeyspace(), "alter vschema on pinned drop all vindexes")
+	require.NoError(t, err)
+	require.Contains(t, ks.Tables, "pinned")
+	assert.Empty(t, ks.Tables["pinned"].ColumnVindexes)
+	assert.False(t, ks.Tables["pinned"].Scatter)
+
+	// A table without vindexes is left as is, even with cascade.
+	want := newKeyspace()
+	ks, err = applyDDL(t, newKeyspace(), "alter vschema on empty drop all vindexes cascade")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(want, ks))
+
+	_, err = applyDDL(t, newKeyspace(), "alter vschema on nonexistent drop all vindexes")
+	assert.EqualError(t, err, "table ks.nonexistent not defined in vschema")
+}
+
+func TestCopyVSchemaKeyspace(t *testing.T) {
+	src := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":  {Type: "hash"},
+			"t_lkp": {Type: "lookup", Owner: "t", Params: map[string]string{"table": "ks.t_lkp_idx", "from": "c", "to": "keyspace_id"}},
+			"u_lkp": {Type: "lookup", Params: map[string]string{"table": "other.u_lkp_idx", "from": "c", "to": "keyspace_id"}},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "hash", Columns: []string{"id"}},
+					{Name: "t_lkp", Columns: []string{"c"}, BackfillSource: &vschemapb.BackfillSource{Table: "ks.t", Columns: []string{"c"}, LookupTable: "ks.t_lkp_idx"}},
+				},
+				AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "ks.t_seq"},
+				Parent:        &vschemapb.ParentTable{Table: "ks.p", Columns: []string{"id"}, ReferencedColumns: []string{"id"}},
+			},
+			"ref": {Type: "reference", Source: "ks.ref_src"},
+		},
+	}
+	orig := proto.Clone(src).(*vschemapb.Keyspace)
+
+	dst := CopyVSchemaKeyspace("ks", src, "ks_staging")
+	assert.True(t, proto.Equal(orig, src), "source keyspace was modified")
+
+	want := proto.Clone(src).(*vschemapb.Keyspace)
+	want.Vindexes["t_lkp"].Params["table"] = "ks_staging.t_lkp_idx"
+	want.Tables["t"].AutoIncrement.Sequence = "ks_staging.t_seq"
+	want.Tables["t"].Parent.Table = "ks_staging.p"
+	want.Tables["t"].ColumnVindexes[1].BackfillSource.Table = "ks_staging.t"
+	want.Tables["t"].ColumnVindexes[1].BackfillSource.LookupTable = "ks_staging.t_lkp_idx"
+	want.Tables["ref"].Source = "ks_staging.ref_src"
+	assert.True(t, proto.Equal(want, dst), "got %v, want %v", dst, want)
+}
+
+func TestApplyRoutingRuleDDL(t *testing.T) {
+	apply := func(rules *vschemapb.RoutingRules, sql string) (*vschemapb.RoutingRules, error) {
+		stmt, err := sqlparser.Parse(sql)
+		require.NoError(t, err)
+		return ApplyRoutingRuleDDL(rules, stmt.(*sqlparser.AlterVschema))
+	}
+
+	rules, err := apply(nil, "alter vschema add routing rule t route to ks2.t")
+	require.NoError(t, err)
+	rules, err = apply(rules, "alter vschema add routing rule ks1.t route to ks2.t2")
+	require.NoError(t, err)
+	want := &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{
+		{FromTable: "t", ToTables: []string{"ks2.t"}},
+		{FromTable: "ks1.t", ToTables: []string{"ks2.t2"}},
+	}}
+	assert.True(t, proto.Equal(want, rules), "got %v, want %v", rules, want)
+
+	_, err = apply(rules, "alter vschema add routing rule ks1.t route to ks3.t")
+	assert.EqualError(t, err, "routing rule for ks1.t already exists")
+	_, err = apply(rules, "alter vschema add routing rule u route to u2")
+	assert.EqualError(t, err, "routing rule for u must route to a table qualified with its keyspace")
+
+	dropped, err := apply(rules, "alter vschema drop routing rule t")
+	require.NoError(t, err)
+	want = &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{
+		{FromTable: "ks1.t", ToTables: []string{"ks2.t2"}},
+	}}
+	assert.True(t, proto.Equal(want, dropped), "got %v, want %v", dropped, want)
+	// The rules passed in are left as is.
+	assert.Len(t, rules.Rules, 2)
+
+	_, err = apply(dropped, "alter vschema drop routing rule t")
+	assert.EqualError(t, err, "routing rule for t does not exist")
+}
+
+func TestSetParentTable(t *testing.T) {
+	vschema := &vschemapb.SrvVSchema{Keyspaces: map[string]*vschemapb.Keyspace{
+		"ks": {
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash":   {Type: "hash"},
+				"xxhash": {Type: "xxhash"},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"orders": {ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}}},
+				"order_item": {ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "hash", Columns: []string{"order_id"}},
+				}},
+				"order_note": {ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "xxhash", Columns: []string{"order_id"}},
+				}},
+			},
+		},
+		"other": {
+			Sharded:  true,
+			Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+			Tables: map[string]*vschemapb.Table{
+				"customer": {ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}}},
+			},
+		},
+	}}
+	check := func(sql string) (*vschemapb.Keyspace, error) {
+		stmt, err := sqlparser.Parse(sql)
+		require.NoError(t, err)
+		alterVschema := stmt.(*sqlparser.AlterVschema)
+		parentKsName := alterVschema.ParentSpec.Parent.Qualifier.String()
+		if parentKsName == "" {
+			parentKsName = "ks"
+		}
+		if err := CheckParentTable("ks", vschema.Keyspaces["ks"], parentKsName, vschema.Keyspaces[parentKsName], alterVschema); err != nil {
+			return nil, err
+		}
+		return ApplyVSchemaDDL("ks", proto.Clone(vschema.Keyspaces["ks"]).(*vschemapb.Keyspace), alterVschema)
+	}
+
+	ks, err := check("alter vschema on order_item set parent orders on (order_id) references (id)")
+	require.NoError(t, err)
+	want := &vschemapb.ParentTable{Table: "ks.orders", Columns: []string{"order_id"}, ReferencedColumns: []string{"id"}}
+	assert.True(t, proto.Equal(want, ks.Tables["order_item"].Parent), "got %v, want %v", ks.Tables["order_item"].Parent, want)
+
+	// The parent can be in another keyspace.
+	ks, err = check("alter vschema on order_item set parent other.customer on (order_id) references (id)")
+	require.NoError(t, err)
+	assert.Equal(t, "other.customer", ks.Tables["order_item"].Parent.Table)
+
+	_, err = check("alter vschema on order_note set parent orders on (order_id) references (id)")
+	assert.EqualError(t, err, "primary vindex xxhash of table order_note is not compatible with primary vindex hash of parent table ks.orders")
+
+	_, err = check("alter vschema on order_item set parent orders on (id) references (id)")
+	assert.EqualError(t, err, "column order_id of the primary vindex of table order_item does not reference column id of the primary vindex of parent table ks.orders")
+
+	_, err = check("alter vschema on order_item set parent orders on (order_id, id) references (id)")
+	assert.EqualError(t, err, "set parent: 2 columns of table order_item reference 1 columns")
+
+	_, err = check("alter vschema on order_item set parent invoices on (order_id) references (id)")
+	assert.EqualError(t, err, "vschema does not contain parent table invoices in keyspace ks")
+}
+
+func TestSetScatterTable(t *testing.T) {
+	ks := &vschemapb.Keyspace{
+		Sharded:  true,
+		Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+		Tables: map[string]*vschemapb.Table{
+			"user": {ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"id"}}}},
+			"ref":  {Type: "reference"},
+		},
+	}
+
+	// Marking a table that isn't in the vschema adds it without vindexes.
+	ks, err := applyDDL(t, ks, "alter vschema on page_views set scatter = true")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&vschemapb.Table{Scatter: true}, ks.Tables["page_views"]), "got %v", ks.Tables["page_views"])
+
+	_, err = applyDDL(t, ks, "alter vschema on page_views set scatter = false")
+	assert.EqualError(t, err, "table ks.page_views has no vindexes, it has to stay scatter-routed")
+
+	// A scatter table can lose its last vindex without cascade.
+	ks, err = applyDDL(t, ks, "alter vschema on user set scatter = true")
+	require.NoError(t, err)
+	ks, err = applyDDL(t, ks, "alter vschema on user drop vindex hash")
+	require.NoError(t, err)
+	assert.Empty(t, ks.Tables["user"].ColumnVindexes)
+	assert.True(t, ks.Tables["user"].Scatter)
+
+	_, err = applyDDL(t, ks, "alter vschema on ref set scatter = true")
+	assert.EqualError(t, err, "set scatter: table ks.ref is a reference table")
+
+	_, err = applyDDL(t, ks, "alter vschema on orders set scatter = false")
+	assert.EqualError(t, err, "vschema does not contain table orders in keyspace ks")
+
+	_, err = ApplyVSchemaDDL("uks", &vschemapb.Keyspace{}, &sqlparser.AlterVschema{
+		Action:  sqlparser.SetScatterTableDDLAction,
+		Table:   sqlparser.TableName{Name: sqlparser.NewTableIdent("t")},
+		Scatter: true,
+	})
+	assert.EqualError(t, err, "set scatter: keyspace uks is not sharded")
+}
+
+func TestCloneVindex(t *testing.T) {
+	ks := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"name_lookup": {
+				Type:   "lookup_hash",
+				Params: map[string]string{"table": "name_idx", "from": "name", "to": "user_id"},
+				Owner:  "user",
+			},
+		},
+	}
+
+	ks, err := applyDDL(t, ks, "alter vschema clone vindex name_lookup as name_lookup_v2 with table=name_idx_v2, write_only=true")
+	require.NoError(t, err)
+	want := &vschemapb.Vindex{
+		Type:   "lookup_hash",
+		Params: map[string]string{"table": "name_idx_v2", "from": "name", "to": "user_id", "write_only": "true"},
+		Owner:  "user",
+	}
+	assert.True(t, proto.Equal(want, ks.Vindexes["name_lookup_v2"]), "got %v", ks.Vindexes["name_lookup_v2"])
+	assert.Equal(t, map[string]string{"table": "name_idx", "from": "name", "to": "user_id"}, ks.Vindexes["name_lookup"].Params)
+
+	_, err = applyDDL(t, ks, "alter vschema clone vindex hash as name_lookup_v2")
+	assert.EqualError(t, err, "vindex name_lookup_v2 already exists in keyspace ks")
+
+	_, err = applyDDL(t, ks, "alter vschema clone vindex missing as missing2")
+	assert.EqualError(t, err, "vindex missing does not exists in keyspace ks")
+
+	_, err = applyDDL(t, ks, "alter vschema clone vindex hash as other.hash2")
+	assert.EqualError(t, err, "cannot clone vindex hash in keyspace ks to another keyspace other")
+}
+
+func TestVSchemaDDLRoundTrip(t *testing.T) {
+	vschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"user": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash":        {Type: "hash"},
+					"email_md5":   {Type: "unicode_loose_md5"},
+					"name_lookup": {Type: "lookup", Owner: "user", Params: map[string]string{"table": "uks.name_idx", "from": "name", "to": "keyspace_id", "autocommit": "true"}},
+					"multi":       {Type: "lookup", Params: map[string]string{"table": "multi_idx", "from": "a,b", "to": "keyspace_id", "tags": "pii"}},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"user": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{
+							{Name: "hash", Columns: []string{"id"}},
+							{Name: "name_lookup", Columns: []string{"name"}, BackfillRequired: true, BackfillSource: &vschemapb.BackfillSource{
+								Table:       "user.user",
+								Columns:     []string{"name"},
+								LookupTable: "uks.name_idx",
+								From:        []string{"name"},
+								To:          "keyspace_id",
+							}},
+							{Name: "multi", Columns: []string{"a", "b"}, Disabled: true},
+							{Name: "email_md5", Columns: []string{"email_lower"}, BackfillRequired: true, Expression: "lower(email)"},
+						},
+						AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "uks.user_seq"},
+					},
+					"user_extra": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Columns: []string{"user_id"}}},
+						Parent:         &vschemapb.ParentTable{Table: "user.user", Columns: []string{"user_id"}, ReferencedColumns: []string{"id"}},
+					},
+					"ref":        {Type: "reference", Source: "uks.ref_src"},
+					"page_views": {Scatter: true},
+				},
+			},
+			"uks": {
+				Comment: "unsharded tables of the user keyspace",
+				Tables: map[string]*vschemapb.Table{
+					"user_seq": {Type: "sequence", SequenceParams: &vschemapb.SequenceParams{Cache: 100}},
+					"ref_src":  {},
+					"name_idx": {},
+				},
+			},
+		},
+		RoutingRules: &vschemapb.RoutingRules{Rules: []*vschemapb.RoutingRule{
+			{FromTable: "name_idx", ToTables: []string{"uks.name_idx"}},
+			{FromTable: "user.ref_src", ToTables: []string{"uks.ref_src"}},
+		}},
+		Label: "2024-06-release-3",
+	}
+
+	ddls := VSchemaDDL(vschema)
+	assert.Equal(t, []string{
+		"alter vschema create vindex `user`.email_md5 using unicode_loose_md5",
+		"alter vschema create vindex `user`.hash using hash",
+		"alter vschema create vindex `user`.multi using lookup with from=`a,b`, table=multi_idx, tags=pii, to=keyspace_id",
+		"alter vschema create vindex `user`.name_lookup using lookup with autocommit=`true`, from=`name`, owner=`user`, table=`uks.name_idx`, to=keyspace_id",
+		"alter vschema add table uks.name_idx",
+		"alter vschema add table uks.ref_src",
+		"alter vschema add sequence uks.user_seq with cache=100",
+		"alter vschema on `user`.page_views set scatter = true",
+		"alter vschema add reference table `user`.ref source = uks.ref_src",
+		"alter vschema on `user`.`user` add vindex hash (id)",
+		"alter vschema on `user`.`user` add vindex name_lookup (`name`)",
+		"alter vschema on `user`.`user` add vindex multi (a, b) with backfill_required=false",
+		"alter vschema on `user`.`user` disable vindex multi",
+		"alter vschema on `user`.`user` add vindex email_md5 (email_lower as (lower(email)))",
+		"alter vschema on `user`.user_extra add vindex hash (user_id)",
+		"alter vschema on `user`.`user` add auto_increment id using uks.user_seq",
+		"alter vschema on `user`.user_extra set parent `user`.`user` on (user_id) references (id)",
+		"alter vschema keyspace uks set comment 'unsharded tables of the user keyspace'",
+		"alter vschema add routing rule name_idx route to uks.name_idx",
+		"alter vschema add routing rule `user`.ref_src route to uks.ref_src",
+		"alter vschema set label '2024-06-release-3'",
+	}, ddls)
+
+	got := &vschemapb.SrvVSchema{Keyspaces: map[string]*vschemapb.Keyspace{
+		"user": {},
+		"uks":  {},
+	}}
+	for _, ddl := range ddls {
+		stmt, err := sqlparser.Parse(ddl)
+		require.NoError(t, err, ddl)
+		alterVschema := stmt.(*sqlparser.AlterVschema)
+		switch alterVschema.Action {
+		case sqlparser.AddRoutingRuleDDLAction:
+			got.RoutingRules, err = ApplyRoutingRuleDDL(got.RoutingRules, alterVschema)
+			require.NoError(t, err, ddl)
+			continue
+		case sqlparser.SetVSchemaLabelDDLAction:
+			got.Label = alterVschema.Label
+			continue
+		}
+		ksName := alterVschema.Table.Qualifier.String()
+		ks, err := ApplyVSchemaDDL(ksName, got.Keyspaces[ksName], alterVschema)
+		require.NoError(t, err, ddl)
+		got.Keyspaces[ksName] = ks
+	}
+	assert.True(t, proto.Equal(vschema, got), "got %v, want %v", got, vschema)
+}
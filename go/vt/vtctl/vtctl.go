@@ -437,6 +437,9 @@ var commands = []commandGroup{
 			{"ApplyVSchema", commandApplyVSchema,
 				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
 				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
+			{"ImportVindex", commandImportVindex,
+				"[-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace> <vindex name> <vindex json>",
+				"Adds the vindex defined by the JSON object to the VTGate routing schema of the provided keyspace, after checking that the vindex can be created with its params."},
 			{"GetRoutingRules", commandGetRoutingRules,
 				"",
 				"Displays the VSchema routing rules."},
@@ -3190,6 +3193,67 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
 }
 
+func commandImportVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace>, <vindex name> and <vindex json> arguments are required for the ImportVindex command")
+	}
+	keyspace := subFlags.Arg(0)
+	name := subFlags.Arg(1)
+
+	vindex := &vschemapb.Vindex{}
+	if err := json2.Unmarshal([]byte(subFlags.Arg(2)), vindex); err != nil {
+		return err
+	}
+
+	vs, err := wr.TopoServer().GetVSchema(ctx, keyspace)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			vs = &vschemapb.Keyspace{}
+		} else {
+			return err
+		}
+	}
+
+	vs, err = topotools.ImportVindex(keyspace, vs, name, vindex)
+	if err != nil {
+		return err
+	}
+
+	b, err := json2.MarshalIndentPB(vs, "  ")
+	if err != nil {
+		wr.Logger().Errorf2(err, "Failed to marshal VSchema for display")
+	} else {
+		wr.Logger().Printf("New VSchema object:\n%s\n", b)
+	}
+
+	if *dryRun {
+		wr.Logger().Printf("Dry run: Skipping update of VSchema\n")
+		return nil
+	}
+
+	if _, err := wr.TopoServer().GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+
+	if err := wr.TopoServer().SaveVSchema(ctx, keyspace, vs); err != nil {
+		return err
+	}
+
+	if *skipRebuild {
+		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return nil
+	}
+	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
+}
+
 func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	routingRules := subFlags.String("rules", "", "Specify rules as a string")
 	routingRulesFile := subFlags.String("rules_file", "", "Specify rules in a file")
@@ -17,11 +17,17 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -101,7 +107,58 @@ func (ddl *DDL) Execute(vcursor VCursor, bindVars map[string]*query.BindVariable
 		return ddl.OnlineDDL.Execute(vcursor, bindVars, wantfields)
 	}
 
-	return ddl.NormalDDL.Execute(vcursor, bindVars, wantfields)
+	// The vschema tables of a DROP TABLE are only removed once the
+	// tables are dropped, but the caller must be allowed to remove them
+	// before anything is dropped.
+	dropTables := ddl.dropVSchemaTableNames(vcursor)
+	if len(dropTables) != 0 {
+		if err := vcursor.CheckDropVSchemaTables(ddl.Keyspace.Name, dropTables); err != nil {
+			return nil, err
+		}
+	}
+
+	var shardRows []string
+	var perShard func(*srvtopo.ResolvedShard, *sqltypes.Result, time.Duration)
+	rowsInfo, timingInfo := vcursor.DDLShardRowsInfo(), vcursor.DDLTimingInfo()
+	if rowsInfo || timingInfo {
+		perShard = func(rs *srvtopo.ResolvedShard, qr *sqltypes.Result, elapsed time.Duration) {
+			if rowsInfo {
+				shardRows = append(shardRows, fmt.Sprintf("%s: %d", rs.Target.Shard, qr.RowsAffected))
+			}
+			if timingInfo {
+				vcursor.RecordShardTime(rs.Target.Shard, elapsed)
+			}
+		}
+	}
+	result, err = ddl.NormalDDL.execute(vcursor, bindVars, vcursor.DDLMaxConcurrency(), vcursor.Session().GetDDLFailFast(), perShard)
+	if err != nil {
+		return nil, err
+	}
+	if rowsInfo {
+		sort.Strings(shardRows)
+		vcursor.Session().RecordWarning(&query.QueryWarning{
+			Message: "ddl rows affected: " + strings.Join(shardRows, ", "),
+		})
+	}
+	if len(dropTables) != 0 {
+		if err := vcursor.DropVSchemaTables(ddl.Keyspace.Name, dropTables); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// dropVSchemaTableNames returns the tables of a DROP TABLE to remove
+// from the vschema, which are none unless the session asks for it.
+func (ddl *DDL) dropVSchemaTableNames(vcursor VCursor) []string {
+	if _, ok := ddl.DDL.(*sqlparser.DropTable); !ok || !vcursor.Session().GetDDLDropVSchemaTable() {
+		return nil
+	}
+	tables := make([]string, 0, len(ddl.DDL.GetFromTables()))
+	for _, table := range ddl.DDL.GetFromTables() {
+		tables = append(tables, table.Name.String())
+	}
+	return tables
 }
 
 // StreamExecute implements the Primitive interface
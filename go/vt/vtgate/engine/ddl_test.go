@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func newTestDDL(t *testing.T, query string) *DDL {
+	t.Helper()
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	return &DDL{
+		Keyspace: ks,
+		SQL:      query,
+		DDL:      stmt.(sqlparser.DDLStatement),
+		NormalDDL: &Send{
+			Keyspace:          ks,
+			TargetDestination: key.DestinationAllShards{},
+			Query:             query,
+		},
+		OnlineDDL: &OnlineDDL{
+			Keyspace: ks,
+			SQL:      query,
+		},
+	}
+}
+
+func TestDDLMaxConcurrency(t *testing.T) {
+	ddl := newTestDDL(t, "create table t1(id bigint primary key)")
+	shards := []string{"-20", "20-40", "40-60", "60-80", "80-"}
+
+	// Unbounded by default: all shards are dispatched at once.
+	vc := &loggingVCursor{shards: shards}
+	_, err := ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: create table t1(id bigint primary key) {} ks.20-40: create table t1(id bigint primary key) {} ks.40-60: create table t1(id bigint primary key) {} ks.60-80: create table t1(id bigint primary key) {} ks.80-: create table t1(id bigint primary key) {} false false`,
+	})
+
+	// With a limit, every shard is still reached, but never more than
+	// two at a time.
+	vc = &loggingVCursor{shards: shards, ddlMaxConcurrency: 2}
+	_, err = ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: create table t1(id bigint primary key) {} ks.20-40: create table t1(id bigint primary key) {} false false`,
+		`ExecuteMultiShard ks.40-60: create table t1(id bigint primary key) {} ks.60-80: create table t1(id bigint primary key) {} false false`,
+		`ExecuteMultiShard ks.80-: create table t1(id bigint primary key) {} false false`,
+	})
+
+	// Errors from every batch are aggregated, and a failing batch does
+	// not prevent the remaining ones from being sent.
+	vc = &loggingVCursor{shards: shards, ddlMaxConcurrency: 2, multiShardErrs: []error{errors.New("shard error")}}
+	_, err = ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "shard error\nshard error\nshard error")
+	require.Len(t, vc.log, 4)
+}
+
+// failFastVCursor fails the DDL on the shards of failShards, and holds
+// it on the other shards until the context is cancelled if block is set.
+// The shards it is sent to are recorded in sent.
+type failFastVCursor struct {
+	*loggingVCursor
+	ctx        context.Context
+	failShards map[string]bool
+	block      bool
+
+	mu   sync.Mutex
+	sent []string
+}
+
+func (vc *failFastVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+func (vc *failFastVCursor) ErrorGroupCancellableContext() (*errgroup.Group, func()) {
+	origCtx := vc.ctx
+	g, ctx := errgroup.WithContext(vc.ctx)
+	vc.ctx = ctx
+	return g, func() {
+		vc.ctx = origCtx
+	}
+}
+
+func (vc *failFastVCursor) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool) (*sqltypes.Result, []error) {
+	ctx := vc.ctx
+	var errs []error
+	for _, rs := range rss {
+		vc.mu.Lock()
+		vc.sent = append(vc.sent, rs.Target.Shard)
+		vc.mu.Unlock()
+		if vc.failShards[rs.Target.Shard] {
+			errs = append(errs, fmt.Errorf("shard error on %s", rs.Target.Shard))
+			continue
+		}
+		if vc.block {
+			<-ctx.Done()
+			errs = append(errs, ctx.Err())
+		}
+	}
+	return &sqltypes.Result{RowsAffected: uint64(len(rss))}, errs
+}
+
+func TestDDLFailFast(t *testing.T) {
+	ddl := newTestDDL(t, "create table t1(id bigint primary key)")
+	shards := []string{"-20", "20-40", "40-60"}
+	newVCursor := func(maxConcurrency int, block bool, failShards ...string) *failFastVCursor {
+		vc := &failFastVCursor{
+			loggingVCursor: &loggingVCursor{shards: shards, ddlFailFast: true, ddlMaxConcurrency: maxConcurrency},
+			ctx:            context.Background(),
+			failShards:     map[string]bool{},
+			block:          block,
+		}
+		for _, shard := range failShards {
+			vc.failShards[shard] = true
+		}
+		return vc
+	}
+
+	// Without a limit, the DDL is still sent to every shard concurrently.
+	// The first error cancels the shards that are still running.
+	vc := newVCursor(0, true, "-20")
+	_, err := ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "shard error on -20")
+	assert.ElementsMatch(t, shards, vc.sent)
+	assert.NoError(t, vc.ctx.Err())
+
+	vc = newVCursor(0, false)
+	qr, err := ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, qr.RowsAffected)
+
+	// With a limit, the batches after the failed one are not dispatched.
+	vc = newVCursor(2, false, "20-40")
+	_, err = ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "shard error on 20-40")
+	assert.ElementsMatch(t, []string{"-20", "20-40"}, vc.sent)
+
+	vc = newVCursor(2, false)
+	qr, err = ddl.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, qr.RowsAffected)
+	assert.ElementsMatch(t, shards, vc.sent)
+}
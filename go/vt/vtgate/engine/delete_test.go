@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestDeleteUnsharded(t *testing.T) {
@@ -87,6 +88,31 @@ func TestDeleteEqual(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteEqual: missing bind var aa")
 }
 
+func TestDeleteEqualShardAware(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	del := &Delete{
+		DML: DML{
+			Opcode: Equal,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: &shardAwareHash{SingleColumn: vindex.(vindexes.SingleColumn), fallback: "20-"},
+			Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.unavailableShards = []*topodatapb.ShardReference{{Name: "-20", KeyRange: &topodatapb.KeyRange{End: []byte{0x20}}}}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationShard(20-)`,
+		`ExecuteMultiShard ks.DestinationShard(20-): dummy_delete {} true true`,
+	})
+}
+
 func TestDeleteEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
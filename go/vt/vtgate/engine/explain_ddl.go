@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*ExplainDDL)(nil)
+
+// ExplainDDL reports how a DDL statement is planned: the keyspace and
+// shards it is sent to and the query they receive. Every shard of the
+// keyspace is listed, with whether the target applies the statement to
+// it. It never sends queries to tablets.
+type ExplainDDL struct {
+	Keyspace          *vindexes.Keyspace
+	TargetDestination key.Destination
+	StatementType     string
+	Query             string
+
+	noInputs
+	noTxNeeded
+}
+
+var explainDDLFields = []*querypb.Field{
+	{Name: "statement_type", Type: sqltypes.VarChar},
+	{Name: "keyspace", Type: sqltypes.VarChar},
+	{Name: "shard", Type: sqltypes.VarChar},
+	{Name: "query", Type: sqltypes.VarChar},
+	{Name: "applied", Type: sqltypes.VarChar},
+}
+
+// RouteType implements the Primitive interface
+func (e *ExplainDDL) RouteType() string {
+	return "ExplainDDL"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (e *ExplainDDL) GetKeyspaceName() string {
+	return e.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface
+func (e *ExplainDDL) GetTableName() string {
+	return ""
+}
+
+// Execute implements the Primitive interface
+func (e *ExplainDDL) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(e.Keyspace.Name, nil, []key.Destination{e.TargetDestination})
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[string]bool, len(rss))
+	for _, rs := range rss {
+		applied[rs.Target.Shard] = true
+	}
+	allShards, _, err := vcursor.ResolveDestinations(e.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{
+		Fields: explainDDLFields,
+		Rows:   make([][]sqltypes.Value, 0, len(allShards)),
+	}
+	for _, rs := range allShards {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(e.StatementType),
+			sqltypes.NewVarChar(e.Keyspace.Name),
+			sqltypes.NewVarChar(rs.Target.Shard),
+			sqltypes.NewVarChar(e.Query),
+			sqltypes.NewVarChar(strconv.FormatBool(applied[rs.Target.Shard])),
+		})
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (e *ExplainDDL) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := e.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (e *ExplainDDL) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: explainDDLFields}, nil
+}
+
+func (e *ExplainDDL) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType:      "ExplainDDL",
+		Keyspace:          e.Keyspace,
+		TargetDestination: e.TargetDestination,
+		Other: map[string]interface{}{
+			"StatementType": e.StatementType,
+			"Query":         e.Query,
+		},
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*ExplainRouting)(nil)
+
+// ExplainRouting maps a list of ids through the primary vindex of a
+// table and reports, for every id, the vindex output, the keyspace id
+// and the shard it resolves to. It never sends queries to tablets.
+type ExplainRouting struct {
+	Keyspace *vindexes.Keyspace
+	Table    string
+	Vindex   vindexes.SingleColumn
+	Values   sqltypes.PlanValue
+
+	// ShardsOnly makes the primitive return the distinct set of
+	// shards the ids resolve to instead of one row per id.
+	ShardsOnly bool
+
+	noInputs
+	noTxNeeded
+}
+
+var explainRoutingFields = []*querypb.Field{
+	{Name: "id", Type: sqltypes.VarBinary},
+	{Name: "vindex", Type: sqltypes.VarChar},
+	{Name: "destination", Type: sqltypes.VarChar},
+	{Name: "keyspace_id", Type: sqltypes.VarBinary},
+	{Name: "shard", Type: sqltypes.VarChar},
+}
+
+var explainShardsFields = []*querypb.Field{
+	{Name: "shard", Type: sqltypes.VarChar},
+}
+
+// RouteType implements the Primitive interface
+func (e *ExplainRouting) RouteType() string {
+	return "ExplainRouting"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (e *ExplainRouting) GetKeyspaceName() string {
+	return e.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface
+func (e *ExplainRouting) GetTableName() string {
+	return e.Table
+}
+
+// Execute implements the Primitive interface
+func (e *ExplainRouting) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	ids, err := e.Values.ResolveList(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := e.Vindex.Map(vcursor, ids)
+	if err != nil {
+		return nil, err
+	}
+	if e.ShardsOnly {
+		return e.shards(vcursor, destinations)
+	}
+	result := &sqltypes.Result{
+		Fields: explainRoutingFields,
+		Rows:   make([][]sqltypes.Value, 0, len(ids)),
+	}
+	for i, dest := range destinations {
+		ksid := sqltypes.NULL
+		if d, ok := dest.(key.DestinationKeyspaceID); ok {
+			ksid = sqltypes.NewVarBinary(fmt.Sprintf("%x", []byte(d)))
+		}
+		shard := sqltypes.NULL
+		rss, _, err := vcursor.ResolveDestinations(e.Keyspace.Name, nil, []key.Destination{dest})
+		if err != nil {
+			return nil, err
+		}
+		if len(rss) == 1 {
+			shard = sqltypes.NewVarChar(rss[0].Target.Shard)
+		}
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarBinary(ids[i].ToString()),
+			sqltypes.NewVarChar(e.Vindex.String()),
+			sqltypes.NewVarChar(dest.String()),
+			ksid,
+			shard,
+		})
+	}
+	return result, nil
+}
+
+func (e *ExplainRouting) shards(vcursor VCursor, destinations []key.Destination) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(e.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, err
+	}
+	shards := make([]string, 0, len(rss))
+	for _, rs := range rss {
+		shards = append(shards, rs.Target.Shard)
+	}
+	sort.Strings(shards)
+	result := &sqltypes.Result{
+		Fields: explainShardsFields,
+		Rows:   make([][]sqltypes.Value, 0, len(shards)),
+	}
+	for _, shard := range shards {
+		result.Rows = append(result.Rows, []sqltypes.Value{sqltypes.NewVarChar(shard)})
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (e *ExplainRouting) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := e.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (e *ExplainRouting) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if e.ShardsOnly {
+		return &sqltypes.Result{Fields: explainShardsFields}, nil
+	}
+	return &sqltypes.Result{Fields: explainRoutingFields}, nil
+}
+
+func (e *ExplainRouting) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Table":  e.Table,
+		"Vindex": e.Vindex.String(),
+		"Values": e.Values,
+	}
+	if e.ShardsOnly {
+		other["ShardsOnly"] = true
+	}
+	return PrimitiveDescription{
+		OperatorType: "ExplainRouting",
+		Keyspace:     e.Keyspace,
+		Other:        other,
+	}
+}
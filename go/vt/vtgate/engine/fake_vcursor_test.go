@@ -65,6 +65,46 @@ func (t noopVCursor) GetDDLStrategy() string {
 	panic("implement me")
 }
 
+func (t noopVCursor) SetDDLFailFast(failFast bool) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) GetDDLFailFast() bool {
+	panic("implement me")
+}
+
+func (t noopVCursor) SetDDLDropVSchemaTable(drop bool) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) GetDDLDropVSchemaTable() bool {
+	panic("implement me")
+}
+
+func (t noopVCursor) SetVSchemaDDLJSON(enable bool) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) GetVSchemaDDLJSON() bool {
+	panic("implement me")
+}
+
+func (t noopVCursor) SetCaptureVSchemaDDL(enable bool) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) GetCaptureVSchemaDDL() bool {
+	panic("implement me")
+}
+
+func (t noopVCursor) SetVSchemaDefaultKeyspace(keyspace string) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) GetVSchemaDefaultKeyspace() string {
+	panic("implement me")
+}
+
 func (t noopVCursor) GetSessionUUID() string {
 	panic("implement me")
 }
@@ -132,7 +172,15 @@ func (t noopVCursor) ShardSession() []*srvtopo.ResolvedShard {
 	panic("implement me")
 }
 
-func (t noopVCursor) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) error {
+func (t noopVCursor) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	panic("implement me")
+}
+
+func (t noopVCursor) CheckDropVSchemaTables(keyspace string, tables []string) error {
+	panic("implement me")
+}
+
+func (t noopVCursor) DropVSchemaTables(keyspace string, tables []string) error {
 	panic("implement me")
 }
 
@@ -182,6 +230,25 @@ func (t noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t noopVCursor) DDLMaxConcurrency() int {
+	return 0
+}
+
+func (t noopVCursor) DDLShardRowsInfo() bool {
+	return false
+}
+
+func (t noopVCursor) DDLTimingInfo() bool {
+	return false
+}
+
+func (t noopVCursor) RecordShardTime(shard string, elapsed time.Duration) {
+}
+
+func (t noopVCursor) RecentQueries() ([]RecentQuery, error) {
+	return nil, nil
+}
+
 func (t noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
@@ -231,6 +298,10 @@ func (t noopVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value,
 	panic("unimplemented")
 }
 
+func (t noopVCursor) UnavailableShards(keyspace string) ([]*topodatapb.ShardReference, error) {
+	return nil, nil
+}
+
 func (t noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
@@ -263,12 +334,29 @@ type loggingVCursor struct {
 	resolvedTargetTabletType topodatapb.TabletType
 
 	tableRoutes tableRoutes
+
+	ddlMaxConcurrency int
+	ddlFailFast       bool
+
+	unavailableShards []*topodatapb.ShardReference
 }
 
 type tableRoutes struct {
 	tbl *vindexes.Table
 }
 
+func (f *loggingVCursor) GetDDLStrategy() string {
+	return ""
+}
+
+func (f *loggingVCursor) GetDDLFailFast() bool {
+	return f.ddlFailFast
+}
+
+func (f *loggingVCursor) DDLMaxConcurrency() int {
+	return f.ddlMaxConcurrency
+}
+
 func (f *loggingVCursor) SetFoundRows(u uint64) {
 	panic("implement me")
 }
@@ -301,7 +389,7 @@ func (f *loggingVCursor) ShardSession() []*srvtopo.ResolvedShard {
 	return nil
 }
 
-func (f *loggingVCursor) ExecuteVSchema(string, *sqlparser.AlterVschema) error {
+func (f *loggingVCursor) ExecuteVSchema(string, *sqlparser.AlterVschema) (*sqltypes.Result, error) {
 	panic("implement me")
 }
 
@@ -383,6 +471,10 @@ func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.Resolve
 	return callback(r)
 }
 
+func (f *loggingVCursor) UnavailableShards(keyspace string) ([]*topodatapb.ShardReference, error) {
+	return f.unavailableShards, nil
+}
+
 func (f *loggingVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	f.log = append(f.log, fmt.Sprintf("ResolveDestinations %v %v %v", keyspace, ids, key.DestinationsString(destinations)))
 	if f.shardErr != nil {
@@ -362,8 +362,12 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValues))
 	rowCount := 0
 	for vIdx, vColValues := range ins.VindexValues {
-		if len(vColValues.Values) != len(ins.Table.ColumnVindexes[vIdx].Columns) {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column values don't match vschema: %v %v", vColValues, ins.Table.ColumnVindexes[vIdx].Columns)
+		cols := ins.Table.ColumnVindexes[vIdx].Columns
+		if expr := ins.Table.ColumnVindexes[vIdx].Expression; expr != nil {
+			cols = vindexes.VindexExpressionColumns(expr)
+		}
+		if len(vColValues.Values) != len(cols) {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column values don't match vschema: %v %v", vColValues, cols)
 		}
 		for colIdx, colValues := range vColValues.Values {
 			rowsResolvedValues, err := colValues.ResolveList(bindVars)
@@ -390,11 +394,31 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
+	// A vindex on an expression got the values of the columns the
+	// expression reads, which give the value of the vindex column.
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		if colVindex.Expression == nil {
+			continue
+		}
+		cols := vindexes.VindexExpressionColumns(colVindex.Expression)
+		for rowNum, rowValues := range vindexRowsValues[vIdx] {
+			values := make(map[string]sqltypes.Value, len(cols))
+			for colIdx, col := range cols {
+				values[col.Lowered()] = rowValues[colIdx]
+			}
+			value, err := vindexes.EvalVindexExpression(colVindex.Expression, values)
+			if err != nil {
+				return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+			}
+			vindexRowsValues[vIdx][rowNum] = []sqltypes.Value{value}
+		}
+	}
+
 	// The output from the following 'process' functions is a list of
 	// keyspace ids. For regular inserts, a failure to find a route
 	// results in an error. For 'ignore' type inserts, the keyspace
 	// id is returned as nil, which is used later to drop the corresponding rows.
-	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	keyspaceIDs, routes, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
@@ -414,7 +438,11 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 
 	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
 	// we're executing an insert ignore.
+	// The column of a vindex on an expression is not in the query.
 	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		if colVindex.Expression != nil {
+			continue
+		}
 		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
 			if keyspaceIDs[rowNum] == nil {
 				// InsertShardedIgnore: skip the row.
@@ -438,7 +466,7 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 			indexes = append(indexes, &querypb.Value{
 				Value: strconv.AppendInt(nil, int64(i), 10),
 			})
-			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+			destinations = append(destinations, routes[i])
 		}
 	}
 	if len(destinations) == 0 {
@@ -472,10 +500,12 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 }
 
 // processPrimary maps the primary vindex values to the keyspace ids.
-func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex) ([][]byte, error) {
+// It also returns the destination each row is sent to, which is its
+// keyspace id unless a ShardAware vindex avoids the shard.
+func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex) ([][]byte, []key.Destination, error) {
 	destinations, err := vindexes.Map(colVindex.Vindex, vcursor, vindexColumnsKeys)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	keyspaceIDs := make([][]byte, len(destinations))
@@ -487,14 +517,24 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 		case key.DestinationNone:
 			// No valid keyspace id, we may return an error.
 			if ins.Opcode != InsertShardedIgnore {
-				return nil, fmt.Errorf("could not map %v to a keyspace id", vindexColumnsKeys[i])
+				return nil, nil, fmt.Errorf("could not map %v to a keyspace id", vindexColumnsKeys[i])
 			}
 		default:
-			return nil, fmt.Errorf("could not map %v to a unique keyspace id: %v", vindexColumnsKeys[i], destination)
+			return nil, nil, fmt.Errorf("could not map %v to a unique keyspace id: %v", vindexColumnsKeys[i], destination)
 		}
 	}
 
-	return keyspaceIDs, nil
+	if _, ok := colVindex.Vindex.(vindexes.ShardAware); ok {
+		ids := make([]sqltypes.Value, len(vindexColumnsKeys))
+		for i, rowColumnKeys := range vindexColumnsKeys {
+			ids[i] = rowColumnKeys[0]
+		}
+		destinations, err = avoidUnavailableShards(vcursor, colVindex.Vindex, ins.Keyspace, ids, destinations)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return keyspaceIDs, destinations, nil
 }
 
 // processOwned creates vindex entries for the values of an owned column.
@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
@@ -314,6 +315,128 @@ func TestInsertShardedSimple(t *testing.T) {
 	})
 }
 
+func TestInsertShardedShardAware(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+	colVindex := ks.Tables["t1"].ColumnVindexes[0]
+	colVindex.Vindex = &shardAwareHash{SingleColumn: colVindex.Vindex.(vindexes.SingleColumn), fallback: "20-"}
+
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// 2 rows.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(4),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-"}
+	vc.unavailableShards = []*topodatapb.ShardReference{{Name: "-20", KeyRange: &topodatapb.KeyRange{End: []byte{0x20}}}}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		// The first row is in -20, which is unavailable, so it goes
+		// to the fallback shard.
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationShard(20-),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`ExecuteMultiShard ` +
+			`sharded.DestinationShard(20-): prefix mid1 suffix {_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"4" } ` +
+			`sharded.20-: prefix mid2 suffix {_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"4" } ` +
+			`true false`,
+	})
+}
+
+func TestInsertShardedShardAwareShort(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+	colVindex := ks.Tables["t1"].ColumnVindexes[0]
+	colVindex.Vindex = &shortShardAware{SingleColumn: colVindex.Vindex.(vindexes.SingleColumn)}
+
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// 2 rows.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(4),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.unavailableShards = []*topodatapb.ShardReference{{Name: "-20", KeyRange: &topodatapb.KeyRange{End: []byte{0x20}}}}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: vindex hash returned 1 destinations for 2 ids")
+}
+
 func TestInsertShardedFail(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -791,6 +914,70 @@ func TestInsertShardedGeo(t *testing.T) {
 	})
 }
 
+func TestInsertShardedVindexExpression(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"binary": {
+						Type: "binary",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:       "binary",
+							Column:     "email_lower",
+							Expression: "lower(email)",
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex expression columns: email
+			Values: []sqltypes.PlanValue{{
+				// rows for email
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewVarChar("A"),
+				}, {
+					Key: "email",
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20"}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{"email": sqltypes.StringBindVariable("B")}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		// The keyspace ids are the lowered emails.
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(61),DestinationKeyspaceID(62)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix {email: type:VARBINARY value:"B" } ` +
+			`sharded.-20: prefix mid2 suffix {email: type:VARBINARY value:"B" } ` +
+			`true false`,
+	})
+}
+
 func TestInsertShardedIgnoreOwned(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
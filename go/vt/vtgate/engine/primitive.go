@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
@@ -58,6 +59,22 @@ type (
 		// MaxMemoryRows returns the maxMemoryRows flag value.
 		MaxMemoryRows() int
 
+		// DDLMaxConcurrency returns the maximum number of shards a DDL
+		// is sent to at once. Zero means no limit.
+		DDLMaxConcurrency() int
+
+		// DDLShardRowsInfo returns true if a DDL result should report the
+		// rows affected on every shard.
+		DDLShardRowsInfo() bool
+
+		// DDLTimingInfo returns true if a DDL result should report the
+		// elapsed time on every shard.
+		DDLTimingInfo() bool
+
+		// RecordShardTime records the elapsed time of a statement on a
+		// shard.
+		RecordShardTime(shard string, elapsed time.Duration)
+
 		// ExceedsMaxMemoryRows returns a boolean indicating whether
 		// the maxMemoryRows value has been exceeded. Returns false
 		// if the max memory rows override directive is set to true
@@ -85,7 +102,23 @@ type (
 		// Will replace all of the Topo functions.
 		ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error)
 
-		ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) error
+		// UnavailableShards returns the shards of the keyspace that do not
+		// currently serve queries for the target tablet type.
+		UnavailableShards(keyspace string) ([]*topodatapb.ShardReference, error)
+
+		// ExecuteVSchema applies the vschema DDL and returns its result,
+		// which is empty unless the session asks for a description of
+		// the change.
+		ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error)
+
+		// CheckDropVSchemaTables returns an error if the caller is not
+		// allowed to remove any of the given tables that are in the
+		// vschema of the keyspace.
+		CheckDropVSchemaTables(keyspace string, tables []string) error
+
+		// DropVSchemaTables removes the given tables from the vschema of
+		// the keyspace, skipping the ones that are not in it.
+		DropVSchemaTables(keyspace string, tables []string) error
 
 		SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error
 
@@ -98,6 +131,11 @@ type (
 		LookupRowLockShardSession() vtgatepb.CommitOrder
 
 		FindRoutedTable(tablename sqlparser.TableName) (*vindexes.Table, error)
+
+		// RecentQueries returns the statements recently executed
+		// by vtgate, oldest first. The caller must be allowed to
+		// alter the vschema.
+		RecentQueries() ([]RecentQuery, error)
 	}
 
 	//SessionActions gives primitives ability to interact with the session state
@@ -132,6 +170,21 @@ type (
 		SetDDLStrategy(string)
 		GetDDLStrategy() string
 
+		SetDDLFailFast(bool) error
+		GetDDLFailFast() bool
+
+		SetDDLDropVSchemaTable(bool) error
+		GetDDLDropVSchemaTable() bool
+
+		SetVSchemaDDLJSON(bool) error
+		GetVSchemaDDLJSON() bool
+
+		SetCaptureVSchemaDDL(bool) error
+		GetCaptureVSchemaDDL() bool
+
+		SetVSchemaDefaultKeyspace(string) error
+		GetVSchemaDefaultKeyspace() string
+
 		GetSessionUUID() string
 
 		SetSessionEnableSystemSettings(bool) error
@@ -239,7 +292,7 @@ func Exists(m Match, p Primitive) bool {
 	return Find(m, p) != nil
 }
 
-//MarshalJSON serializes the plan into a JSON representation.
+// MarshalJSON serializes the plan into a JSON representation.
 func (p *Plan) MarshalJSON() ([]byte, error) {
 	var instructions *PrimitiveDescription
 	if p.Instructions != nil {
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*RecentQueries)(nil)
+
+// RecentQuery describes a statement recently executed by vtgate.
+type RecentQuery struct {
+	Method       string
+	StmtType     string
+	SQL          string
+	ShardQueries uint64
+	Duration     time.Duration
+}
+
+// RecentQueries returns the statements recently executed by vtgate,
+// oldest first. It backs information_schema.vitess_recent_queries.
+type RecentQueries struct {
+	noInputs
+	noTxNeeded
+}
+
+var recentQueriesFields = []*querypb.Field{
+	{Name: "method", Type: sqltypes.VarChar},
+	{Name: "type", Type: sqltypes.VarChar},
+	{Name: "sql", Type: sqltypes.VarChar},
+	{Name: "shard_queries", Type: sqltypes.Uint64},
+	{Name: "duration", Type: sqltypes.Float64},
+}
+
+// RouteType implements the Primitive interface
+func (r *RecentQueries) RouteType() string {
+	return "RecentQueries"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (r *RecentQueries) GetKeyspaceName() string {
+	return ""
+}
+
+// GetTableName implements the Primitive interface
+func (r *RecentQueries) GetTableName() string {
+	return ""
+}
+
+// Execute implements the Primitive interface
+func (r *RecentQueries) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	queries, err := vcursor.RecentQueries()
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{
+		Fields: recentQueriesFields,
+		Rows:   make([][]sqltypes.Value, 0, len(queries)),
+	}
+	for _, q := range queries {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(q.Method),
+			sqltypes.NewVarChar(q.StmtType),
+			sqltypes.NewVarChar(q.SQL),
+			sqltypes.NewUint64(q.ShardQueries),
+			sqltypes.NewFloat64(q.Duration.Seconds()),
+		})
+	}
+	return result, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (r *RecentQueries) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := r.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (r *RecentQueries) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: recentQueriesFields}, nil
+}
+
+func (r *RecentQueries) description() PrimitiveDescription {
+	return PrimitiveDescription{OperatorType: "RecentQueries"}
+}
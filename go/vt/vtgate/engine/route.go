@@ -195,6 +195,10 @@ var routeName = map[RouteOpcode]string{
 
 var (
 	partialSuccessScatterQueries = stats.NewCounter("PartialSuccessScatterQueries", "Count of partially successful scatter queries")
+
+	// VindexUsage counts the times a vindex was used to route a
+	// statement, by keyspace and vindex name.
+	VindexUsage = stats.NewCountersWithMultiLabels("VindexUsage", "Number of times a vindex was used to route a statement, by keyspace and vindex", []string{"Keyspace", "Vindex"})
 )
 
 // MarshalJSON serializes the RouteOpcode as a JSON string.
@@ -563,15 +567,47 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	}
 
 	// Map using the Vindex
+	VindexUsage.Add([]string{keyspace.Name, vindex.String()}, 1)
 	destinations, err := vindex.Map(vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
+	destinations, err = avoidUnavailableShards(vcursor, vindex, keyspace, vindexKeys, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// And use the Resolver to map to ResolvedShards.
 	return vcursor.ResolveDestinations(keyspace.Name, ids, destinations)
 }
 
+// avoidUnavailableShards lets a ShardAware vindex change the
+// destinations of the ids if some shards of the keyspace are
+// unavailable. Other vindexes keep their destinations. Every path that
+// routes by a vindex calls it, so reads and writes go to the same shard.
+func avoidUnavailableShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value, destinations []key.Destination) ([]key.Destination, error) {
+	shardAware, ok := vindex.(vindexes.ShardAware)
+	if !ok {
+		return destinations, nil
+	}
+	unavailable, err := vcursor.UnavailableShards(keyspace.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(unavailable) == 0 {
+		return destinations, nil
+	}
+	result, err := shardAware.AvoidShards(vcursor, vindexKeys, destinations, unavailable)
+	if err != nil {
+		return nil, err
+	}
+	// Callers index the result by the position of the id.
+	if len(result) != len(destinations) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex %s returned %d destinations for %d ids", vindex.String(), len(result), len(destinations))
+	}
+	return result, nil
+}
+
 func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	var err error
 	// Since Result is immutable, we make a copy.
@@ -614,6 +650,7 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 }
 
 func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	VindexUsage.Add([]string{keyspace.Name, vindex.String()}, 1)
 	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
@@ -627,6 +664,10 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	default:
 		return nil, nil, fmt.Errorf("cannot map vindex to unique keyspace id: %v", destinations[0])
 	}
+	destinations, err = avoidUnavailableShards(vcursor, vindex, keyspace, []sqltypes.Value{vindexKey}, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
 	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
 	if err != nil {
 		return nil, nil, err
@@ -638,10 +679,15 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 }
 
 func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	VindexUsage.Add([]string{keyspace.Name, vindex.String()}, 1)
 	destinations, err := vindex.Map(vcursor, vindexKey)
 	if err != nil {
 		return nil, err
 	}
+	destinations, err = avoidUnavailableShards(vcursor, vindex, keyspace, vindexKey, destinations)
+	if err != nil {
+		return nil, err
+	}
 	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
 	if err != nil {
 		return nil, err
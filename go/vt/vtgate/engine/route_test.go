@@ -28,7 +28,9 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -246,6 +248,104 @@ func TestSelectEqualUnique(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+// shardAwareHash is a hash vindex that sends the ids of an unavailable
+// shard to a fallback shard.
+type shardAwareHash struct {
+	vindexes.SingleColumn
+	fallback string
+}
+
+func (v *shardAwareHash) AvoidShards(vcursor vindexes.VCursor, ids []sqltypes.Value, destinations []key.Destination, unavailable []*topodatapb.ShardReference) ([]key.Destination, error) {
+	out := make([]key.Destination, len(destinations))
+	for i, destination := range destinations {
+		out[i] = destination
+		ksid, ok := destination.(key.DestinationKeyspaceID)
+		if !ok {
+			continue
+		}
+		for _, shard := range unavailable {
+			if key.KeyRangeContains(shard.KeyRange, ksid) {
+				out[i] = key.DestinationShard(v.fallback)
+			}
+		}
+	}
+	return out, nil
+}
+
+// shortShardAware is a broken ShardAware vindex that drops the last
+// destination.
+type shortShardAware struct {
+	vindexes.SingleColumn
+}
+
+func (v *shortShardAware) AvoidShards(vcursor vindexes.VCursor, ids []sqltypes.Value, destinations []key.Destination, unavailable []*topodatapb.ShardReference) ([]key.Destination, error) {
+	return destinations[:len(destinations)-1], nil
+}
+
+func TestSelectEqualUniqueShardAware(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = &shardAwareHash{SingleColumn: vindex.(vindexes.SingleColumn), fallback: "20-"}
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}}
+
+	// All shards are available.
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	_, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} false false`,
+	})
+
+	// The shard of the keyspace id is unavailable.
+	vc = &loggingVCursor{
+		shards:            []string{"-20", "20-"},
+		results:           []*sqltypes.Result{defaultSelectResult},
+		unavailableShards: []*topodatapb.ShardReference{{Name: "-20", KeyRange: &topodatapb.KeyRange{End: []byte{0x20}}}},
+	}
+	_, err = sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationShard(20-)`,
+		`ExecuteMultiShard ks.DestinationShard(20-): dummy_select {} false false`,
+	})
+}
+
+func TestSelectEqualUniqueShardAwareShort(t *testing.T) {
+	vindex, _ := vindexes.NewHash("hash", nil)
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = &shortShardAware{SingleColumn: vindex.(vindexes.SingleColumn)}
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}}
+
+	vc := &loggingVCursor{
+		shards:            []string{"-20", "20-"},
+		results:           []*sqltypes.Result{defaultSelectResult},
+		unavailableShards: []*topodatapb.ShardReference{{Name: "-20", KeyRange: &topodatapb.KeyRange{End: []byte{0x20}}}},
+	}
+	_, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "paramsSelectEqual: vindex hash returned 0 destinations for 1 ids")
+	vc.ExpectLog(t, nil)
+}
+
 func TestSelectNone(t *testing.T) {
 	vindex, _ := vindexes.NewHash("", nil)
 	sel := NewRoute(
@@ -17,9 +17,13 @@ limitations under the License.
 package engine
 
 import (
+	"sync"
+	"time"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
@@ -74,11 +78,30 @@ func (s *Send) GetTableName() string {
 
 // Execute implements Primitive interface
 func (s *Send) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return s.execute(vcursor, bindVars, 0, false, nil)
+}
+
+// execute sends the query to the resolved shards. If maxConcurrency is
+// positive, the shards are dispatched in batches of at most that many shards.
+// All batches are sent even if an earlier one fails, and the errors of every
+// batch are aggregated. With failFast, the first error cancels the calls of
+// its batch that are still in flight, stops the dispatch of the remaining
+// batches, and is returned on its own.
+//
+// The RowsAffected of the result is the sum of the RowsAffected of every
+// shard. If perShard is set, it is called with the result and the elapsed
+// time of every shard that succeeded. The shards of a batch are then sent one call per shard,
+// still concurrently, so that their results can be told apart.
+func (s *Send) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, maxConcurrency int, failFast bool, perShard func(*srvtopo.ResolvedShard, *sqltypes.Result, time.Duration)) (*sqltypes.Result, error) {
 	rss, _, err := vcursor.ResolveDestinations(s.Keyspace.Name, nil, []key.Destination{s.TargetDestination})
 	if err != nil {
 		return nil, vterrors.Wrap(err, "sendExecute")
 	}
 
+	if kr, ok := s.TargetDestination.(key.DestinationKeyRange); ok && len(rss) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyrange %s does not overlap any shard of keyspace %s", key.KeyRangeString(kr.KeyRange), s.Keyspace.Name)
+	}
+
 	if !s.Keyspace.Sharded && len(rss) != 1 {
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Keyspace does not have exactly one shard: %v", rss)
 	}
@@ -101,14 +124,84 @@ func (s *Send) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariabl
 	}
 
 	rollbackOnError := s.IsDML // for non-dml queries, there's no need to do a rollback
-	result, errs := vcursor.ExecuteMultiShard(rss, queries, rollbackOnError, canAutocommit)
-	err = vterrors.Aggregate(errs)
+	if maxConcurrency <= 0 || len(rss) <= maxConcurrency {
+		if failFast {
+			return executeBatchFailFast(vcursor, rss, queries, rollbackOnError, canAutocommit, perShard)
+		}
+		result, errs := executeBatch(vcursor, rss, queries, rollbackOnError, canAutocommit, perShard)
+		err = vterrors.Aggregate(errs)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	result := &sqltypes.Result{}
+	var allErrs []error
+	for start := 0; start < len(rss); start += maxConcurrency {
+		end := start + maxConcurrency
+		if end > len(rss) {
+			end = len(rss)
+		}
+		if failFast {
+			qr, err := executeBatchFailFast(vcursor, rss[start:end], queries[start:end], rollbackOnError, canAutocommit, perShard)
+			if err != nil {
+				return nil, err
+			}
+			result.AppendResult(qr)
+			continue
+		}
+		qr, errs := executeBatch(vcursor, rss[start:end], queries[start:end], rollbackOnError, canAutocommit, perShard)
+		allErrs = append(allErrs, errs...)
+		if qr != nil {
+			result.AppendResult(qr)
+		}
+	}
+	err = vterrors.Aggregate(allErrs)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
+// executeBatch sends the queries to the shards of a batch. Without
+// perShard, this is a single ExecuteMultiShard call.
+func executeBatch(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool, perShard func(*srvtopo.ResolvedShard, *sqltypes.Result, time.Duration)) (*sqltypes.Result, []error) {
+	if perShard == nil {
+		return vcursor.ExecuteMultiShard(rss, queries, rollbackOnError, canAutocommit)
+	}
+
+	results := make([]*sqltypes.Result, len(rss))
+	errs := make([]error, len(rss))
+	elapsed := make([]time.Duration, len(rss))
+	var wg sync.WaitGroup
+	for i := range rss {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start := time.Now()
+			qr, shardErrs := vcursor.ExecuteMultiShard(rss[i:i+1], queries[i:i+1], rollbackOnError, canAutocommit)
+			results[i], errs[i], elapsed[i] = qr, vterrors.Aggregate(shardErrs), time.Since(start)
+		}(i)
+	}
+	wg.Wait()
+
+	result := &sqltypes.Result{}
+	var allErrs []error
+	for i, qr := range results {
+		if errs[i] != nil {
+			allErrs = append(allErrs, errs[i])
+			continue
+		}
+		if qr == nil {
+			continue
+		}
+		perShard(rss[i], qr, elapsed[i])
+		result.AppendResult(qr)
+	}
+	return result, allErrs
+}
+
 // StreamExecute implements Primitive interface
 func (s *Send) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	rss, _, err := vcursor.ResolveDestinations(s.Keyspace.Name, nil, []key.Destination{s.TargetDestination})
@@ -163,3 +256,40 @@ func (s *Send) description() PrimitiveDescription {
 		Other:             other,
 	}
 }
+
+// executeBatchFailFast sends the queries to the shards of a batch, one call
+// per shard, under a context that is cancelled by the first error. The calls
+// still in flight are then abandoned, and only the first error is returned.
+func executeBatchFailFast(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool, perShard func(*srvtopo.ResolvedShard, *sqltypes.Result, time.Duration)) (*sqltypes.Result, error) {
+	results := make([]*sqltypes.Result, len(rss))
+	elapsed := make([]time.Duration, len(rss))
+	g, restoreCtx := vcursor.ErrorGroupCancellableContext()
+	defer restoreCtx()
+	for i := range rss {
+		currIndex := i
+		g.Go(func() error {
+			start := time.Now()
+			qr, errs := vcursor.ExecuteMultiShard(rss[currIndex:currIndex+1], queries[currIndex:currIndex+1], rollbackOnError, canAutocommit)
+			if err := vterrors.Aggregate(errs); err != nil {
+				return err
+			}
+			results[currIndex], elapsed[currIndex] = qr, time.Since(start)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	for i, qr := range results {
+		if qr == nil {
+			continue
+		}
+		if perShard != nil {
+			perShard(rss[i], qr, elapsed[i])
+		}
+		result.AppendResult(qr)
+	}
+	return result, nil
+}
@@ -408,6 +408,20 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid DDL strategy: %s", str)
 		}
 		vcursor.Session().SetDDLStrategy(str)
+	case sysvars.DDLFailFast.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetDDLFailFast)
+	case sysvars.DDLDropVSchemaTable.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetDDLDropVSchemaTable)
+	case sysvars.VSchemaDDLJSON.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetVSchemaDDLJSON)
+	case sysvars.CaptureVSchemaDDL.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetCaptureVSchemaDDL)
+	case sysvars.VSchemaDefaultKeyspace.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		return vcursor.Session().SetVSchemaDefaultKeyspace(str)
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableSystemSettings)
 	case sysvars.Charset.Name, sysvars.Names.Name:
@@ -467,6 +467,32 @@ func TestUpdateIn(t *testing.T) {
 	})
 }
 
+func TestUpdateInShardAware(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{DML: DML{
+		Opcode:   In,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		Vindex:   &shardAwareHash{SingleColumn: ks.Vindexes["hash"].(vindexes.SingleColumn), fallback: "20-"},
+		Values: []sqltypes.PlanValue{{
+			Values: []sqltypes.PlanValue{
+				{Value: sqltypes.NewInt64(1)},
+				{Value: sqltypes.NewInt64(2)},
+			}},
+		}},
+	}
+
+	// Both keyspace ids are in the unavailable shard.
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.unavailableShards = []*topodatapb.ShardReference{{Name: "-20", KeyRange: &topodatapb.KeyRange{End: []byte{0x20}}}}
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationShard(20-),DestinationShard(20-)`,
+		`ExecuteMultiShard sharded.DestinationShard(20-): dummy_update {} true true`,
+	})
+}
+
 func TestUpdateInChangedVindex(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	upd := &Update{
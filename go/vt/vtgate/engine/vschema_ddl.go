@@ -65,11 +65,7 @@ func (v *AlterVSchema) GetTableName() string {
 
 //Execute implements the Primitive interface
 func (v *AlterVSchema) Execute(vcursor VCursor, bindVars map[string]*query.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	err := vcursor.ExecuteVSchema(v.Keyspace.Name, v.AlterVschemaDDL)
-	if err != nil {
-		return nil, err
-	}
-	return &sqltypes.Result{}, nil
+	return vcursor.ExecuteVSchema(v.Keyspace.Name, v.AlterVschemaDDL)
 }
 
 //StreamExecute implements the Primitive interface
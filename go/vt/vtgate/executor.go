@@ -18,6 +18,7 @@ package vtgate
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -49,6 +50,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
@@ -57,6 +59,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -82,6 +85,11 @@ const (
 	both          = "both"
 	charset       = "charset"
 	bindVarPrefix = "__vt"
+
+	// TargetBindVar is a bind variable that overrides the target of the
+	// session, like "ks/-80@replica", for a single statement. It is
+	// checked like the target of USE.
+	TargetBindVar = "__vttarget"
 )
 
 func init() {
@@ -105,6 +113,8 @@ type Executor struct {
 	vschemaStats *VSchemaStats
 
 	vm *VSchemaManager
+
+	recentQueries *recentQueries
 }
 
 var executorOnce sync.Once
@@ -124,6 +134,8 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		plans:       cache.NewDefaultCacheImpl(cacheCfg),
 		normalize:   normalize,
 		streamSize:  streamSize,
+
+		recentQueries: newRecentQueries(*recentQueriesSize),
 	}
 
 	vschemaacl.Init()
@@ -140,10 +152,24 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathVindexCheck, e)
 	})
 	return e
 }
 
+// sendLogStats sends logStats to the query log and records the
+// statement in the recent queries.
+func (e *Executor) sendLogStats(logStats *LogStats) {
+	logStats.Send()
+	e.recentQueries.add(logStats)
+}
+
+// RecentQueries returns the statements recently sent to the query log,
+// oldest first.
+func (e *Executor) RecentQueries() []engine.RecentQuery {
+	return e.recentQueries.list()
+}
+
 // Execute executes a non-streaming query.
 func (e *Executor) Execute(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable) (result *sqltypes.Result, err error) {
 	span, ctx := trace.NewSpan(ctx, "executor.Execute")
@@ -160,7 +186,7 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 		log.Warningf("%q exceeds warning threshold of max memory rows: %v", sql, *warnMemoryRows)
 	}
 
-	logStats.Send()
+	e.sendLogStats(logStats)
 	return result, err
 }
 
@@ -184,6 +210,12 @@ func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType
 }
 
 func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	bindVars, restoreTarget, err := e.setTargetBindVar(safeSession, bindVars)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer restoreTarget()
+
 	stmtType, qr, err := e.newExecute(ctx, safeSession, sql, bindVars, logStats)
 	if err == planbuilder.ErrPlanNotSupported {
 		return e.legacyExecute(ctx, safeSession, sql, bindVars, logStats)
@@ -191,6 +223,64 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 	return stmtType, qr, err
 }
 
+// setTargetBindVar switches the session to the target given by the
+// TargetBindVar bind variable, if any, with the same checks as USE. It
+// returns the bind variables without TargetBindVar, and a function that
+// restores the target of the session after the statement, unless the
+// statement changed it, like USE does.
+func (e *Executor) setTargetBindVar(safeSession *SafeSession, bindVars map[string]*querypb.BindVariable) (map[string]*querypb.BindVariable, func(), error) {
+	bv, ok := bindVars[TargetBindVar]
+	if !ok {
+		return bindVars, func() {}, nil
+	}
+	target, err := targetFromBindVar(bv)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkTarget(e.VSchema(), safeSession, target); err != nil {
+		return nil, nil, err
+	}
+	saved := safeSession.TargetString
+	safeSession.SetTargetString(target)
+	restore := func() {
+		if safeSession.TargetString == target {
+			safeSession.SetTargetString(saved)
+		}
+	}
+	return withoutBindVar(bindVars, TargetBindVar), restore, nil
+}
+
+// targetFromBindVar returns the target given by the TargetBindVar bind
+// variable. It has to be a string in one of the forms of TargetString.
+func targetFromBindVar(bv *querypb.BindVariable) (string, error) {
+	if bv.Type != querypb.Type_VARCHAR && bv.Type != querypb.Type_VARBINARY {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s must be a string, not %v", TargetBindVar, bv.Type)
+	}
+	target := string(bv.Value)
+	if target == "" {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s must not be empty", TargetBindVar)
+	}
+	_, tabletType, _, err := topoproto.ParseDestination(target, defaultTabletType)
+	if err != nil {
+		return "", err
+	}
+	if tabletType == topodatapb.TabletType_UNKNOWN {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s %s: unknown tablet type", TargetBindVar, target)
+	}
+	return target, nil
+}
+
+// withoutBindVar returns a copy of bindVars without the named variable.
+func withoutBindVar(bindVars map[string]*querypb.BindVariable, name string) map[string]*querypb.BindVariable {
+	result := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		if k != name {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 func (e *Executor) legacyExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
 	//Start an implicit transaction if necessary.
 	if !safeSession.Autocommit && !safeSession.InTransaction() {
@@ -294,6 +384,16 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 			bindVars[key] = sqltypes.StringBindVariable(v)
 		case sysvars.DDLStrategy.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.DDLStrategy)
+		case sysvars.DDLFailFast.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.DdlFailFast)
+		case sysvars.DDLDropVSchemaTable.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.DdlDropVschemaTable)
+		case sysvars.VSchemaDDLJSON.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.VschemaDdlJson)
+		case sysvars.CaptureVSchemaDDL.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.CaptureVschemaDdl)
+		case sysvars.VSchemaDefaultKeyspace.Name:
+			bindVars[key] = sqltypes.StringBindVariable(session.VschemaDefaultKeyspace)
 		case sysvars.SessionUUID.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.SessionUUID)
 		case sysvars.SessionEnableSystemSettings.Name:
@@ -642,12 +742,12 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Rows:   rows,
 		}, nil
 	case sqlparser.KeywordString(sqlparser.VITESS_SHARDS):
-		showVitessShardsFilters := func(show *sqlparser.ShowLegacy) ([]func(string) bool, []func(string, *topodatapb.ShardReference) bool) {
+		showVitessShardsFilters := func(show *sqlparser.ShowLegacy) ([]func(string) bool, []func(string, *topodatapb.ShardReference) bool, error) {
 			keyspaceFilters := []func(string) bool{}
 			shardFilters := []func(string, *topodatapb.ShardReference) bool{}
 
 			if show.ShowTablesOpt == nil || show.ShowTablesOpt.Filter == nil {
-				return keyspaceFilters, shardFilters
+				return keyspaceFilters, shardFilters, nil
 			}
 
 			filter := show.ShowTablesOpt.Filter
@@ -659,18 +759,39 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 					return likeRexep.MatchString(topoproto.KeyspaceShardString(ks, shard.Name))
 				})
 
-				return keyspaceFilters, shardFilters
+				return keyspaceFilters, shardFilters, nil
 			}
 
-			if filter.Filter != nil {
-				// TODO build a query planner I guess? lol that should be fun
-				log.Infof("SHOW VITESS_SHARDS where clause %+v. Ignoring this (for now).", filter.Filter)
+			// Only "keyspace = 'name'" and "keyspace_id = 'hex'" predicates,
+			// combined with AND, are supported. The latter resolves the
+			// keyspace id to the shard whose key range contains it.
+			for _, expr := range sqlparser.SplitAndExpression(nil, filter.Filter) {
+				col, val, ok := showEqualityPredicate(expr)
+				switch {
+				case ok && col == "keyspace":
+					keyspaceFilters = append(keyspaceFilters, func(ks string) bool {
+						return ks == val
+					})
+				case ok && col == "keyspace_id":
+					ksid, err := hex.DecodeString(val)
+					if err != nil {
+						return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid keyspace_id %s: %v", val, err)
+					}
+					shardFilters = append(shardFilters, func(_ string, shard *topodatapb.ShardReference) bool {
+						return key.KeyRangeContains(shard.KeyRange, ksid)
+					})
+				default:
+					log.Infof("SHOW VITESS_SHARDS where clause %+v. Ignoring this (for now).", sqlparser.String(expr))
+				}
 			}
 
-			return keyspaceFilters, shardFilters
+			return keyspaceFilters, shardFilters, nil
 		}
 
-		keyspaceFilters, shardFilters := showVitessShardsFilters(show)
+		keyspaceFilters, shardFilters, err := showVitessShardsFilters(show)
+		if err != nil {
+			return nil, err
+		}
 
 		keyspaces, err := e.resolver.resolver.GetAllKeyspaces(ctx)
 		if err != nil {
@@ -728,7 +849,12 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Fields: buildVarCharFields("Target"),
 			Rows:   rows,
 		}, nil
+	case "vitess_captured_vschema_ddl":
+		return showCapturedVSchemaDDL(safeSession), nil
 	case "vschema tables":
+		if show.HasTable() {
+			return e.showTablesUsingVindex(show.Table, destKeyspace)
+		}
 		if destKeyspace == "" {
 			return nil, errNoKeyspace
 		}
@@ -752,6 +878,66 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Fields: buildVarCharFields("Tables"),
 			Rows:   rows,
 		}, nil
+	case "vschema as sql":
+		vschema := e.vm.GetCurrentSrvVschema()
+		if vschema == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+		}
+
+		ddls := topotools.VSchemaDDL(vschema)
+		rows := make([][]sqltypes.Value, len(ddls))
+		for i, ddl := range ddls {
+			rows[i] = buildVarCharRow(ddl)
+		}
+		return &sqltypes.Result{
+			Fields: buildVarCharFields("Statement"),
+			Rows:   rows,
+		}, nil
+	case "vschema acl":
+		// The rules name the users allowed to change the vschema, only
+		// show them to those users.
+		if !vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx)) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not authorized to perform vschema operations")
+		}
+		rules := vschemaacl.CurrentRules()
+		rows := [][]sqltypes.Value{
+			buildVarCharRow("default_mode", rules.DefaultMode),
+			buildVarCharRow("allow_all", strconv.FormatBool(rules.AllowAll)),
+		}
+		for _, user := range rules.Users {
+			rows = append(rows, buildVarCharRow("user", user))
+		}
+		return &sqltypes.Result{
+			Fields: buildVarCharFields("Rule", "Value"),
+			Rows:   rows,
+		}, nil
+	case "vschema backfill":
+		vschema := e.vm.GetCurrentSrvVschema()
+		if vschema == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+		}
+		return showVSchemaBackfill(vschema, show.OnTable, destKeyspace)
+	case "vschema version":
+		// The version is the one of the SrvVSchema file of the cell in
+		// the topo. Both are read at once, so that they match.
+		ts, err := e.serv.GetTopoServer()
+		if err != nil {
+			return nil, err
+		}
+		vschema, version, err := ts.GetSrvVSchemaWithVersion(ctx, e.cell)
+		if err != nil {
+			return nil, err
+		}
+		return &sqltypes.Result{
+			Fields: buildVarCharFields("Version", "Label"),
+			Rows:   [][]sqltypes.Value{buildVarCharRow(version.String(), vschema.Label)},
+		}, nil
+	case "vschema vindex params":
+		return showVindexParams(show.Table.Name.String())
+	case "vschema vindex stats":
+		return showVindexStats(), nil
+	case "vschema keyspaces":
+		return e.showVSchemaKeyspaces(show)
 	case "vschema vindexes":
 		vschema := e.vm.GetCurrentSrvVschema()
 		if vschema == nil {
@@ -787,24 +973,32 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 				if len(columns) == 0 {
 					columns = []string{colVindex.GetColumn()}
 				}
+				if expression := colVindex.GetExpression(); expression != "" {
+					columns = []string{fmt.Sprintf("%s as (%s)", strings.Join(columns, ", "), expression)}
+				}
 				if ok {
 					params := make([]string, 0, 4)
 					for k, v := range vindex.GetParams() {
 						params = append(params, fmt.Sprintf("%s=%s", k, v))
 					}
 					sort.Strings(params)
-					rows = append(rows, buildVarCharRow(strings.Join(columns, ", "), colVindex.GetName(), vindex.GetType(), strings.Join(params, "; "), vindex.GetOwner()))
+					rows = append(rows, buildVarCharRow(strings.Join(columns, ", "), colVindex.GetName(), vindex.GetType(), strings.Join(params, "; "), vindex.GetOwner(), strconv.FormatBool(colVindex.GetBackfillRequired()), strconv.FormatBool(!colVindex.GetDisabled())))
 				} else {
-					rows = append(rows, buildVarCharRow(strings.Join(columns, ", "), colVindex.GetName(), "", "", ""))
+					rows = append(rows, buildVarCharRow(strings.Join(columns, ", "), colVindex.GetName(), "", "", "", strconv.FormatBool(colVindex.GetBackfillRequired()), strconv.FormatBool(!colVindex.GetDisabled())))
 				}
 			}
 
 			return &sqltypes.Result{
-				Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+				Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 				Rows:   rows,
 			}, nil
 		}
 
+		match, err := vindexFilter(show.ShowTablesOpt)
+		if err != nil {
+			return nil, err
+		}
+
 		// For the query interface to be stable we need to sort
 		// for each of the map iterations
 		ksNames := make([]string, 0, len(vschema.Keyspaces))
@@ -816,7 +1010,10 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			ks := vschema.Keyspaces[ksName]
 
 			vindexNames := make([]string, 0, len(ks.Vindexes))
-			for name := range ks.Vindexes {
+			for name, vindex := range ks.Vindexes {
+				if !match(name, vindex) {
+					continue
+				}
 				vindexNames = append(vindexNames, name)
 			}
 			sort.Strings(vindexNames)
@@ -828,13 +1025,25 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 					params = append(params, fmt.Sprintf("%s=%s", k, v))
 				}
 				sort.Strings(params)
-				rows = append(rows, buildVarCharRow(ksName, vindexName, vindex.GetType(), strings.Join(params, "; "), vindex.GetOwner()))
+				row := buildVarCharRow(ksName, vindexName, vindex.GetType(), strings.Join(params, "; "), vindex.GetOwner())
+				if *showVindexFingerprint {
+					row = append(row, sqltypes.NewVarChar(vindexFingerprint(vindexName, vindex)))
+				}
+				rows = append(rows, row)
 			}
 		}
+		fields := buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner")
+		if *showVindexFingerprint {
+			fields = append(fields, buildVarCharFields("Fingerprint")...)
+		}
 		return &sqltypes.Result{
-			Fields: buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner"),
+			Fields: fields,
 			Rows:   rows,
 		}, nil
+	case "validate vschema":
+		return e.validateVSchema()
+	case "validate vschema routing":
+		return e.validateVSchemaRouting(ctx, show.Table.Qualifier.String(), destTabletType)
 	case sqlparser.KeywordString(sqlparser.WARNINGS):
 		fields := []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar},
@@ -863,9 +1072,436 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 	return e.handleOther(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats, ignoreMaxMemoryRows)
 }
 
+// showEqualityPredicate returns the column name and string value of a
+// "column = 'value'" predicate in a SHOW filter.
+func showEqualityPredicate(expr sqlparser.Expr) (string, string, bool) {
+	cmp, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return "", "", false
+	}
+	col, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return "", "", false
+	}
+	lit, ok := cmp.Right.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.StrVal {
+		return "", "", false
+	}
+	return col.Name.Lowered(), string(lit.Val), true
+}
+
+// vindexFilter returns a function reporting whether a vindex passes the
+// filter of a SHOW VSCHEMA VINDEXES statement. LIKE matches the vindex name,
+// and WHERE supports a single tag = 'value' comparison against the vindex tags.
+func vindexFilter(opt *sqlparser.ShowTablesOpt) (func(string, *vschemapb.Vindex) bool, error) {
+	if opt == nil || opt.Filter == nil {
+		return func(string, *vschemapb.Vindex) bool { return true }, nil
+	}
+	if opt.Filter.Like != "" {
+		re := sqlparser.LikeToRegexp(opt.Filter.Like)
+		return func(name string, _ *vschemapb.Vindex) bool { return re.MatchString(name) }, nil
+	}
+
+	if col, tag, ok := showEqualityPredicate(opt.Filter.Filter); ok && col == "tag" {
+		return func(_ string, vindex *vschemapb.Vindex) bool {
+			for _, t := range sqlparser.ParseVindexTags(vindex.GetParams()[sqlparser.VindexTagsStr]) {
+				if t == tag {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported filter for show vschema vindexes: %s, only tag = 'value' is supported", sqlparser.String(opt.Filter.Filter))
+}
+
+// vindexFingerprint returns the fingerprint of the vindex, or an empty
+// string if the vindex can't be built or doesn't have one.
+func vindexFingerprint(name string, vindex *vschemapb.Vindex) string {
+	v, err := vindexes.CreateVindex(vindex.GetType(), name, vindex.GetParams())
+	if err != nil {
+		return ""
+	}
+	if fp, ok := v.(vindexes.Fingerprinter); ok {
+		return fp.Fingerprint()
+	}
+	return ""
+}
+
+// showVSchemaKeyspaces lists the keyspaces of the current vschema
+// along with a summary of their contents and their comment. It is
+// computed purely from the SrvVSchema and never contacts the topo server
+// or any tablets.
+func (e *Executor) showVSchemaKeyspaces(show *sqlparser.ShowLegacy) (*sqltypes.Result, error) {
+	vschema := e.vm.GetCurrentSrvVschema()
+	if vschema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+
+	var filter *regexp.Regexp
+	if opt := show.ShowTablesOpt; opt != nil && opt.Filter != nil {
+		if opt.Filter.Like == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported filter for show vschema keyspaces: %s, only like is supported", sqlparser.String(opt.Filter.Filter))
+		}
+		filter = sqlparser.LikeToRegexp(opt.Filter.Like)
+	}
+
+	ksNames := make([]string, 0, len(vschema.Keyspaces))
+	for name := range vschema.Keyspaces {
+		if filter != nil && !filter.MatchString(name) {
+			continue
+		}
+		ksNames = append(ksNames, name)
+	}
+	sort.Strings(ksNames)
+
+	rows := make([][]sqltypes.Value, 0, len(ksNames))
+	for _, ksName := range ksNames {
+		ks := vschema.Keyspaces[ksName]
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewVarChar(ksName),
+			sqltypes.NewVarChar(strconv.FormatBool(ks.GetSharded())),
+			sqltypes.NewInt64(int64(len(ks.GetTables()))),
+			sqltypes.NewInt64(int64(len(ks.GetVindexes()))),
+			sqltypes.NewVarChar(ks.GetComment()),
+		})
+	}
+
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Keyspace", Type: sqltypes.VarChar},
+			{Name: "Sharded", Type: sqltypes.VarChar},
+			{Name: "Tables", Type: sqltypes.Int64},
+			{Name: "Vindexes", Type: sqltypes.Int64},
+			{Name: "Comment", Type: sqltypes.VarChar},
+		},
+		Rows: rows,
+	}, nil
+}
+
+// showTablesUsingVindex lists the tables of the keyspace whose column
+// vindexes reference the given vindex, so that it can be checked
+// before the vindex is dropped or changed.
+func (e *Executor) showTablesUsingVindex(name sqlparser.TableName, destKeyspace string) (*sqltypes.Result, error) {
+	vschema := e.vm.GetCurrentSrvVschema()
+	if vschema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+	ksName := name.Qualifier.String()
+	if ksName == "" {
+		ksName = destKeyspace
+	}
+	if ksName == "" {
+		return nil, errNoKeyspace
+	}
+	ks, ok := vschema.Keyspaces[ksName]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", ksName)
+	}
+	vindexName := name.Name.String()
+	if _, ok := ks.Vindexes[vindexName]; !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vindex %s does not exist in keyspace %s", vindexName, ksName)
+	}
+
+	var tables []string
+	for tableName, table := range ks.Tables {
+		for _, colVindex := range table.ColumnVindexes {
+			if colVindex.Name == vindexName {
+				tables = append(tables, tableName)
+				break
+			}
+		}
+	}
+	sort.Strings(tables)
+
+	rows := make([][]sqltypes.Value, len(tables))
+	for i, v := range tables {
+		rows[i] = buildVarCharRow(v)
+	}
+	return &sqltypes.Result{
+		Fields: buildVarCharFields("Tables"),
+		Rows:   rows,
+	}, nil
+}
+
+// validateVSchema reports the problems in the current vschema that are
+// tolerated at DDL time but still need to be resolved, such as vindexes
+// whose owner table has not been defined yet.
+func (e *Executor) validateVSchema() (*sqltypes.Result, error) {
+	vschema := e.vm.GetCurrentSrvVschema()
+	if vschema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+
+	ksNames := make([]string, 0, len(vschema.Keyspaces))
+	for name := range vschema.Keyspaces {
+		ksNames = append(ksNames, name)
+	}
+	sort.Strings(ksNames)
+
+	var rows [][]sqltypes.Value
+	for _, ksName := range ksNames {
+		ks := vschema.Keyspaces[ksName]
+
+		vindexNames := make([]string, 0, len(ks.Vindexes))
+		for name := range ks.Vindexes {
+			vindexNames = append(vindexNames, name)
+		}
+		sort.Strings(vindexNames)
+		for _, vindexName := range vindexNames {
+			owner := ks.Vindexes[vindexName].GetOwner()
+			if owner == "" {
+				continue
+			}
+			if _, ok := ks.Tables[owner]; !ok {
+				rows = append(rows, buildVarCharRow(ksName, vindexName, fmt.Sprintf("owner table %s not defined in vschema", owner)))
+			}
+		}
+	}
+
+	return &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Vindex", "Error"),
+		Rows:   rows,
+	}, nil
+}
+
+// validateVSchemaRouting reports the destinations that the primary
+// vindexes of the tables of a sharded keyspace can map rows to, but that
+// no shard of the keyspace covers. Only Enumerable vindexes can list
+// their destinations. The tables of other vindexes are reported as not
+// checked.
+func (e *Executor) validateVSchemaRouting(ctx context.Context, ksName string, tabletType topodatapb.TabletType) (*sqltypes.Result, error) {
+	vschema := e.vm.GetCurrentSrvVschema()
+	if vschema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+	ks, ok := vschema.Keyspaces[ksName]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", ksName)
+	}
+
+	result := &sqltypes.Result{
+		Fields: buildVarCharFields("Table", "Vindex", "Gap", "Error"),
+	}
+	if !ks.Sharded {
+		return result, nil
+	}
+	_, _, shards, err := e.resolver.resolver.GetKeyspaceShards(ctx, ksName, tabletType)
+	if err != nil {
+		return nil, err
+	}
+	gaps := keyRangeGaps(shards)
+	if len(gaps) == 0 {
+		return result, nil
+	}
+
+	var ksVindexes map[string]vindexes.Vindex
+	if ksSchema := e.VSchema().Keyspaces[ksName]; ksSchema != nil {
+		ksVindexes = ksSchema.Vindexes
+	}
+	tableNames := make([]string, 0, len(ks.Tables))
+	for name := range ks.Tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+	for _, tableName := range tableNames {
+		colVindexes := ks.Tables[tableName].ColumnVindexes
+		if len(colVindexes) == 0 {
+			continue
+		}
+		name := colVindexes[0].Name
+		vindex, ok := ksVindexes[name]
+		if !ok {
+			result.Rows = append(result.Rows, buildVarCharRow(tableName, name, "", fmt.Sprintf("cannot check: vindex %s not found", name)))
+			continue
+		}
+		rows, err := vindexRoutingGaps(tableName, name, vindex, gaps)
+		if err != nil {
+			result.Rows = append(result.Rows, buildVarCharRow(tableName, name, "", "cannot check: "+err.Error()))
+			continue
+		}
+		result.Rows = append(result.Rows, rows...)
+	}
+	return result, nil
+}
+
+// vindexRoutingGaps returns a row for every destination of the vindex
+// that falls in one of the gaps. It returns an error if the vindex
+// can't list its destinations, or returns one that can't be checked.
+func vindexRoutingGaps(tableName, name string, vindex vindexes.Vindex, gaps []*topodatapb.KeyRange) ([][]sqltypes.Value, error) {
+	destinations, err := vindexes.Destinations(vindex)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]sqltypes.Value
+	for _, destination := range destinations {
+		for _, gap := range gaps {
+			switch d := destination.(type) {
+			case key.DestinationKeyspaceID:
+				if key.KeyRangeContains(gap, d) {
+					rows = append(rows, buildVarCharRow(tableName, name, key.KeyRangeString(gap), fmt.Sprintf("keyspace id %s is not covered by any shard", hex.EncodeToString(d))))
+				}
+			case key.DestinationKeyRange:
+				if key.KeyRangesIntersect(gap, d.KeyRange) {
+					rows = append(rows, buildVarCharRow(tableName, name, key.KeyRangeString(gap), fmt.Sprintf("key range %s is not fully covered by the shards", key.KeyRangeString(d.KeyRange))))
+				}
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vindex %s has an unsupported destination %s", name, destination.String())
+			}
+		}
+	}
+	return rows, nil
+}
+
+// keyRangeGaps returns the key ranges that none of the shards cover.
+func keyRangeGaps(shards []*topodatapb.ShardReference) []*topodatapb.KeyRange {
+	sorted := append([]*topodatapb.ShardReference(nil), shards...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return key.KeyRangeStartSmaller(sorted[i].KeyRange, sorted[j].KeyRange)
+	})
+
+	var gaps []*topodatapb.KeyRange
+	// covered is the end of the key range covered by the shards so far.
+	var covered []byte
+	for _, shard := range sorted {
+		kr := shard.KeyRange
+		if kr == nil {
+			return nil
+		}
+		if bytes.Compare(kr.Start, covered) > 0 {
+			gaps = append(gaps, &topodatapb.KeyRange{Start: covered, End: kr.Start})
+		}
+		if len(kr.End) == 0 {
+			return gaps
+		}
+		if bytes.Compare(kr.End, covered) > 0 {
+			covered = kr.End
+		}
+	}
+	return append(gaps, &topodatapb.KeyRange{Start: covered})
+}
+
 // (tablet, servingState, mtst) -> bool
 type tabletFilter func(*topodatapb.Tablet, string, int64) bool
 
+// showVSchemaBackfill lists the backfill sources of the vindex bindings,
+// sorted by keyspace and table, or only those of onTable if it is set.
+func showVSchemaBackfill(vschema *vschemapb.SrvVSchema, onTable sqlparser.TableName, destKeyspace string) (*sqltypes.Result, error) {
+	ksNames := make([]string, 0, len(vschema.Keyspaces))
+	for name := range vschema.Keyspaces {
+		ksNames = append(ksNames, name)
+	}
+	sort.Strings(ksNames)
+	if !onTable.IsEmpty() {
+		ksName := onTable.Qualifier.String()
+		if ksName == "" {
+			ksName = destKeyspace
+		}
+		ks, ok := vschema.Keyspaces[ksName]
+		if !ok {
+			return nil, errNoKeyspace
+		}
+		if _, ok := ks.Tables[onTable.Name.String()]; !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "table `%s` does not exist in keyspace `%s`", onTable.Name.String(), ksName)
+		}
+		ksNames = []string{ksName}
+	}
+
+	var rows [][]sqltypes.Value
+	for _, ksName := range ksNames {
+		ks := vschema.Keyspaces[ksName]
+		tableNames := make([]string, 0, len(ks.Tables))
+		for name := range ks.Tables {
+			if onTable.IsEmpty() || name == onTable.Name.String() {
+				tableNames = append(tableNames, name)
+			}
+		}
+		sort.Strings(tableNames)
+		for _, tableName := range tableNames {
+			for _, colVindex := range ks.Tables[tableName].ColumnVindexes {
+				source := colVindex.BackfillSource
+				if source == nil {
+					continue
+				}
+				rows = append(rows, buildVarCharRow(
+					ksName,
+					tableName,
+					colVindex.Name,
+					strings.Join(source.Columns, ", "),
+					source.LookupTable,
+					strings.Join(source.From, ", "),
+					source.To,
+				))
+			}
+		}
+	}
+	return &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Table", "Vindex", "Columns", "Lookup Table", "From", "To"),
+		Rows:   rows,
+	}, nil
+}
+
+// showVindexParams returns the declared parameters of a vindex type. A
+// type without a declared schema gets a single message instead.
+func showVindexParams(vindexType string) (*sqltypes.Result, error) {
+	found := false
+	for _, t := range vindexes.RegisteredVindexTypes() {
+		if t == vindexType {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown vindex type %s", vindexType)
+	}
+	schema, ok := vindexes.VindexParamSchema(vindexType)
+	if !ok {
+		return &sqltypes.Result{
+			Fields: buildVarCharFields("Message"),
+			Rows:   [][]sqltypes.Value{buildVarCharRow(fmt.Sprintf("no param schema is declared for vindex type %s", vindexType))},
+		}, nil
+	}
+	rows := make([][]sqltypes.Value, 0, len(schema))
+	for _, spec := range schema {
+		rows = append(rows, buildVarCharRow(spec.Name, spec.Type, strconv.FormatBool(spec.Required), spec.Default))
+	}
+	return &sqltypes.Result{
+		Fields: buildVarCharFields("Name", "Type", "Required", "Default"),
+		Rows:   rows,
+	}, nil
+}
+
+// showVindexStats returns the number of times each vindex was used to
+// route a statement since vtgate started, by keyspace and vindex.
+func showVindexStats() *sqltypes.Result {
+	counts := engine.VindexUsage.Counts()
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	rows := make([][]sqltypes.Value, 0, len(keys))
+	for _, k := range keys {
+		labels := strings.SplitN(k, ".", 2)
+		if len(labels) != 2 {
+			continue
+		}
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewVarChar(labels[0]),
+			sqltypes.NewVarChar(labels[1]),
+			sqltypes.NewInt64(counts[k]),
+		})
+	}
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Keyspace", Type: sqltypes.VarChar},
+			{Name: "Vindex", Type: sqltypes.VarChar},
+			{Name: "Count", Type: sqltypes.Int64},
+		},
+		Rows: rows,
+	}
+}
+
 func (e *Executor) showTablets(show *sqlparser.ShowLegacy) (*sqltypes.Result, error) {
 	getTabletFilters := func(show *sqlparser.ShowLegacy) []tabletFilter {
 		filters := []tabletFilter{}
@@ -1027,11 +1663,22 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	logStats := NewLogStats(ctx, method, sql, bindVars)
 	stmtType := sqlparser.Preview(sql)
 	logStats.StmtType = stmtType.String()
-	defer logStats.Send()
+	defer e.sendLogStats(logStats)
 
 	if bindVars == nil {
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
+	if _, ok := bindVars[TargetBindVar]; ok {
+		var restoreTarget func()
+		bindVars, restoreTarget, err = e.setTargetBindVar(safeSession, bindVars)
+		if err != nil {
+			logStats.Error = err
+			return err
+		}
+		defer restoreTarget()
+		destKeyspace, destTabletType, _, _ := e.ParseDestinationTarget(safeSession.TargetString)
+		target = querypb.Target{Keyspace: destKeyspace, TabletType: destTabletType}
+	}
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor, _ := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv)
 	vcursor.SetIgnoreMaxMemoryRows(true)
@@ -1263,11 +1910,24 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, err
 	}
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement) {
-		e.plans.Set(planKey, plan)
+		e.cachePlan(vcursor.vschema, planKey, plan)
 	}
 	return plan, nil
 }
 
+// cachePlan caches a plan built against the given vschema, unless the
+// vschema was replaced in the meantime. SaveVSchema clears the cache
+// while holding e.mu, so checking and inserting under e.mu guarantees
+// that no plan of an old vschema is left in the cache after an update.
+func (e *Executor) cachePlan(vschema *vindexes.VSchema, planKey string, plan *engine.Plan) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.vschema != vschema {
+		return
+	}
+	e.plans.Set(planKey, plan)
+}
+
 // skipQueryPlanCache extracts SkipQueryPlanCache from session
 func skipQueryPlanCache(safeSession *SafeSession) bool {
 	if safeSession == nil || safeSession.Options == nil {
@@ -1307,6 +1967,14 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathVindexCheck:
+		failures, err := e.CheckVindexes()
+		if err != nil {
+			response.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = response.Write([]byte(err.Error()))
+			return
+		}
+		returnAsJSON(response, failures)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -1488,7 +2156,7 @@ func (e *Executor) Prepare(ctx context.Context, method string, safeSession *Safe
 	// To avoid spamming the log with no-op rollback records, ignore it if
 	// it was a no-op record (i.e. didn't issue any queries)
 	if !(logStats.StmtType == "ROLLBACK" && logStats.ShardQueries == 0) {
-		logStats.Send()
+		e.sendLogStats(logStats)
 	}
 	return fld, err
 }
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -41,9 +42,11 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -757,10 +760,10 @@ func TestExecutorShow(t *testing.T) {
 	qr, err = executor.Execute(ctx, "TestExecute", session, query, nil)
 	require.NoError(t, err)
 	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("Id", "hash_index", "hash", "", ""),
-			buildVarCharRow("name", "name_user_map", "lookup_hash", "from=name; table=name_user_map; to=user_id", "user"),
+			buildVarCharRow("Id", "hash_index", "hash", "", "", "false", "true"),
+			buildVarCharRow("name", "name_user_map", "lookup_hash", "from=name; table=name_user_map; to=user_id", "user", "false", "true"),
 		},
 	}
 	utils.MustMatch(t, wantqr, qr, query)
@@ -780,10 +783,10 @@ func TestExecutorShow(t *testing.T) {
 	qr, err = executor.Execute(ctx, "TestExecute", session, query, nil)
 	require.NoError(t, err)
 	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("Id", "hash_index", "hash", "", ""),
-			buildVarCharRow("name", "name_user_map", "lookup_hash", "from=name; table=name_user_map; to=user_id", "user"),
+			buildVarCharRow("Id", "hash_index", "hash", "", "", "false", "true"),
+			buildVarCharRow("name", "name_user_map", "lookup_hash", "from=name; table=name_user_map; to=user_id", "user", "false", "true"),
 		},
 	}
 	utils.MustMatch(t, wantqr, qr, query)
@@ -793,10 +796,10 @@ func TestExecutorShow(t *testing.T) {
 	qr, err = executor.Execute(ctx, "TestExecute", session, query, nil)
 	require.NoError(t, err)
 	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("id", "hash_index", "hash", "", ""),
-			buildVarCharRow("name, lastname", "name_lastname_keyspace_id_map", "lookup", "from=name,lastname; table=name_lastname_keyspace_id_map; to=keyspace_id", "user2"),
+			buildVarCharRow("id", "hash_index", "hash", "", "", "false", "true"),
+			buildVarCharRow("name, lastname", "name_lastname_keyspace_id_map", "lookup", "from=name,lastname; table=name_lastname_keyspace_id_map; to=keyspace_id", "user2", "false", "true"),
 		},
 	}
 	utils.MustMatch(t, wantqr, qr, query)
@@ -908,6 +911,63 @@ func TestExecutorShow(t *testing.T) {
 	assert.EqualError(t, err, want, query)
 }
 
+func TestExecutorShowVitessShardsByKeyspaceID(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	// The shard boundaries of TestExecutor are -20, 20-40, ..., e0-.
+	for ksid, shard := range map[string]string{
+		"00":   "TestExecutor/-20",
+		"1fff": "TestExecutor/-20",
+		"20":   "TestExecutor/20-40",
+		"4142": "TestExecutor/40-60",
+		"e0":   "TestExecutor/e0-",
+		"ffff": "TestExecutor/e0-",
+	} {
+		query := fmt.Sprintf("show vitess_shards where keyspace = 'TestExecutor' and keyspace_id = '%s'", ksid)
+		qr, err := executor.Execute(ctx, "TestExecute", session, query, nil)
+		require.NoError(t, err)
+		wantqr := &sqltypes.Result{
+			Fields: buildVarCharFields("Shards"),
+			Rows:   [][]sqltypes.Value{buildVarCharRow(shard)},
+		}
+		utils.MustMatch(t, wantqr, qr, query)
+	}
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "show vitess_shards where keyspace_id = 'xyz'", nil)
+	require.EqualError(t, err, "invalid keyspace_id xyz: encoding/hex: invalid byte: U+0078 'x'")
+}
+
+func TestExecutorShowVindexStats(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	// The counters are shared by all the executors of the process, so
+	// only the increase is checked.
+	hashIndexCount := func() int64 {
+		qr, err := executor.Execute(ctx, "TestExecute", session, "show vschema vindex stats", nil)
+		require.NoError(t, err)
+		for _, row := range qr.Rows {
+			if row[0].ToString() == "TestExecutor" && row[1].ToString() == "hash_index" {
+				count, err := row[2].ToInt64()
+				require.NoError(t, err)
+				return count
+			}
+		}
+		return 0
+	}
+	before := hashIndexCount()
+	for _, query := range []string{
+		"select id from user where id = 1",
+		"select id from user where id in (1, 2)",
+		"update user set a = 1 where id = 3",
+	} {
+		_, err := executor.Execute(ctx, "TestExecute", session, query, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, before+3, hashIndexCount())
+}
+
 func TestExecutorUse(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: "@master"})
@@ -942,6 +1002,39 @@ func TestExecutorUse(t *testing.T) {
 	}
 }
 
+func TestExecutorUseTargetsDDL(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	testcases := []struct {
+		use         string
+		sbc1Queries int
+		sbc2Queries int
+	}{{
+		use:         "use `TestExecutor/-20`",
+		sbc1Queries: 1,
+	}, {
+		use:         "use `TestExecutor:40-60@master`",
+		sbc2Queries: 1,
+	}, {
+		use:         "use `TestExecutor[-60]`",
+		sbc1Queries: 1,
+		sbc2Queries: 1,
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.use, func(t *testing.T) {
+			sbc1.Queries = nil
+			sbc2.Queries = nil
+			_, err := executor.Execute(ctx, "TestExecute", session, tcase.use, nil)
+			require.NoError(t, err)
+			_, err = executor.Execute(ctx, "TestExecute", session, "alter table t1 add column c int", nil)
+			require.NoError(t, err)
+			assert.Len(t, sbc1.Queries, tcase.sbc1Queries)
+			assert.Len(t, sbc2.Queries, tcase.sbc2Queries)
+		})
+	}
+}
+
 func TestExecutorComment(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 
@@ -1178,6 +1271,446 @@ func TestExecutorDDL(t *testing.T) {
 	}
 }
 
+func TestExecutorDDLTargetBindVar(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	bindVars := map[string]*querypb.BindVariable{
+		TargetBindVar: sqltypes.StringBindVariable("TestExecutor/40-60"),
+	}
+	_, err := executor.Execute(ctx, "TestExecute", session, "create table t1(id bigint primary key)", bindVars)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 1, sbc2.ExecCount.Get())
+	assert.EqualValues(t, 0, sbclookup.ExecCount.Get())
+	require.Len(t, sbc2.Queries, 1)
+	assert.Empty(t, sbc2.Queries[0].BindVariables)
+	// The target only applies to the statement.
+	assert.Equal(t, "TestExecutor", session.TargetString)
+
+	testcases := []struct {
+		bindVar *querypb.BindVariable
+		wantErr string
+	}{{
+		bindVar: sqltypes.Int64BindVariable(1),
+		wantErr: "__vttarget must be a string, not INT64",
+	}, {
+		bindVar: sqltypes.StringBindVariable(""),
+		wantErr: "__vttarget must not be empty",
+	}, {
+		bindVar: sqltypes.StringBindVariable("TestExecutor/-20@bogus"),
+		wantErr: "invalid __vttarget TestExecutor/-20@bogus: unknown tablet type",
+	}, {
+		bindVar: sqltypes.StringBindVariable("TestExecutor[-20"),
+		wantErr: "invalid key range provided. Couldn't find range end ']'",
+	}, {
+		bindVar: sqltypes.StringBindVariable("nope/-20"),
+		wantErr: "Unknown database 'nope' (errno 1049) (sqlstate 42000)",
+	}}
+	for _, tcase := range testcases {
+		_, err := executor.Execute(ctx, "TestExecute", session, "create table t1(id bigint primary key)", map[string]*querypb.BindVariable{TargetBindVar: tcase.bindVar})
+		assert.EqualError(t, err, tcase.wantErr)
+		assert.Equal(t, "TestExecutor", session.TargetString)
+	}
+}
+
+func TestExecutorTargetBindVarInTransaction(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+
+	// Like USE, the bind variable can't move a transaction off the master.
+	bindVars := map[string]*querypb.BindVariable{
+		TargetBindVar: sqltypes.StringBindVariable("TestExecutor@replica"),
+	}
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", bindVars)
+	require.EqualError(t, err, "cannot change to a non-master type in the middle of a transaction: REPLICA")
+	assert.Equal(t, "TestExecutor", session.TargetString)
+
+	bindVars[TargetBindVar] = sqltypes.StringBindVariable("TestExecutor/-20@master")
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", bindVars)
+	require.NoError(t, err)
+	assert.Equal(t, "TestExecutor", session.TargetString)
+}
+
+func TestExecutorStreamTargetBindVar(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	stream := func(bindVars map[string]*querypb.BindVariable) error {
+		return executor.StreamExecute(ctx, "TestExecuteStream", session, "select id from user", bindVars, querypb.Target{Keyspace: "TestExecutor", TabletType: topodatapb.TabletType_MASTER}, func(*sqltypes.Result) error {
+			return nil
+		})
+	}
+
+	// The statement only goes to the shard of the target, and the bind
+	// variable isn't sent to the tablet.
+	err := stream(map[string]*querypb.BindVariable{
+		TargetBindVar: sqltypes.StringBindVariable("TestExecutor/40-60"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, sbc1.Queries)
+	require.Len(t, sbc2.Queries, 1)
+	assert.NotContains(t, sbc2.Queries[0].BindVariables, TargetBindVar)
+	assert.Equal(t, "TestExecutor", session.TargetString)
+
+	err = stream(map[string]*querypb.BindVariable{
+		TargetBindVar: sqltypes.StringBindVariable("nope/-20"),
+	})
+	require.EqualError(t, err, "Unknown database 'nope' (errno 1049) (sqlstate 42000)")
+}
+
+func TestExecutorDDLFailFast(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	stmt := "create table t1(id bigint primary key)"
+
+	// By default, the DDL is sent to every shard and the errors are aggregated.
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err := executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "target: TestExecutor.-20.master")
+	assert.EqualValues(t, 1, sbc2.ExecCount.Get())
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "set @@ddl_fail_fast = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.GetDDLFailFast())
+
+	// With fail fast, only the first shard error is returned.
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	sbc2.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.Error(t, err)
+	assert.Equal(t, 1, strings.Count(err.Error(), "target: TestExecutor."), err.Error())
+
+	// Without any error, every shard is still reached.
+	sbc1.ExecCount.Set(0)
+	sbc2.ExecCount.Set(0)
+	_, err = executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 1, sbc2.ExecCount.Get())
+
+	// With batches of a single shard, the first shard error stops the
+	// dispatch.
+	*ddlMaxConcurrency = 1
+	defer func() {
+		*ddlMaxConcurrency = 0
+	}()
+	sbc2.ExecCount.Set(0)
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "target: TestExecutor.-20.master")
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+}
+
+func TestExecutorDDLTimingInfo(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	stmt := "create table t1(id bigint primary key)"
+
+	// Off by default.
+	_, err := executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Warnings)
+
+	*ddlTimingInfo = true
+	defer func() {
+		*ddlTimingInfo = false
+	}()
+	_, err = executor.Execute(ctx, "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	require.Len(t, session.Warnings, 1)
+	assert.Regexp(t, `^ddl timing: total \S+, -20: \S+, 20-40: \S+, 40-60: \S+, 60-80: \S+, 80-a0: \S+, a0-c0: \S+, c0-e0: \S+, e0-: \S+$`, session.Warnings[0].Message)
+
+	// Only DDL statements carry the timing info.
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Warnings)
+}
+
+func TestExecutorDDLKindInfo(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	*ddlKindInfo = true
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		*ddlKindInfo = false
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "alter vschema create vindex test_kind_vindex using hash", nil)
+	require.NoError(t, err)
+	require.Len(t, session.Warnings, 1)
+	assert.Equal(t, "ddl kind: vschema", session.Warnings[0].Message)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "create table t1(id bigint primary key)", nil)
+	require.NoError(t, err)
+	require.Len(t, session.Warnings, 1)
+	assert.Equal(t, "ddl kind: shard", session.Warnings[0].Message)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Warnings)
+}
+
+func TestExecutorDDLQueryInfo(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	*ddlQueryInfo = true
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		*ddlQueryInfo = false
+	}()
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "/* leading */ ALTER TABLE t1   ADD COLUMN c BIGINT DEFAULT 123 /* trailing */", nil)
+	require.NoError(t, err)
+	require.Len(t, sbc1.Queries, 1)
+	require.Len(t, session.Warnings, 1)
+	assert.Equal(t, "ddl query: "+sbc1.Queries[0].Sql, session.Warnings[0].Message)
+	assert.Equal(t, "ddl query: /* leading */ alter table t1 add column c BIGINT default 123 /* trailing */", session.Warnings[0].Message)
+
+	// Vschema DDL is not sent to the shards.
+	_, err = executor.Execute(ctx, "TestExecute", session, "alter vschema create vindex test_query_vindex using hash", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.Warnings)
+}
+
+func TestExecutorDDLShardRowsInfo(t *testing.T) {
+	*ddlShardRowsInfo = true
+	defer func() {
+		*ddlShardRowsInfo = false
+	}()
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 3}})
+	sbc2.SetResults([]*sqltypes.Result{{RowsAffected: 4}})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	qr, err := executor.Execute(ctx, "TestExecute", session, "alter table t1 add column c int", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, qr.RowsAffected)
+	require.Len(t, session.Warnings, 1)
+	assert.Equal(t, "ddl rows affected: -20: 3, 20-40: 0, 40-60: 4, 60-80: 0, 80-a0: 0, a0-c0: 0, c0-e0: 0, e0-: 0", session.Warnings[0].Message)
+}
+
+func TestExecutorExplainRouting(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	qr, err := executor.Execute(ctx, "TestExecute", session, "explain routing TestExecutor.user (1, 4, 6)", nil)
+	require.NoError(t, err)
+
+	hash, err := vindexes.CreateVindex("hash", "hash_index", nil)
+	require.NoError(t, err)
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(4), sqltypes.NewInt64(6)}
+	dests, err := hash.(vindexes.SingleColumn).Map(nil, ids)
+	require.NoError(t, err)
+
+	require.Len(t, qr.Rows, len(ids))
+	for i, dest := range dests {
+		rss, _, err := executor.resolver.resolver.ResolveDestinations(ctx, "TestExecutor", topodatapb.TabletType_MASTER, nil, []key.Destination{dest})
+		require.NoError(t, err)
+		require.Len(t, rss, 1)
+		want := fmt.Sprintf("[VARBINARY(%q) VARCHAR(\"hash_index\") VARCHAR(%q) VARBINARY(\"%x\") VARCHAR(%q)]",
+			ids[i].ToString(), dest.String(), []byte(dest.(key.DestinationKeyspaceID)), rss[0].Target.Shard)
+		assert.Equal(t, want, fmt.Sprintf("%v", qr.Rows[i]))
+	}
+
+	// No query reaches the tablets.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2, sbclookup} {
+		assert.EqualValues(t, 0, sbc.ExecCount.Get())
+	}
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "explain routing TestUnsharded.main1 (1)", nil)
+	require.EqualError(t, err, "table TestUnsharded.main1 has no primary vindex")
+}
+
+func TestExecutorExplainShards(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	qr, err := executor.Execute(ctx, "TestExecute", session, "explain shards for TestExecutor.user where id in (1, 2, 3, 4, 5, 6)", nil)
+	require.NoError(t, err)
+
+	hash, err := vindexes.CreateVindex("hash", "hash_index", nil)
+	require.NoError(t, err)
+	var ids []sqltypes.Value
+	for i := int64(1); i <= 6; i++ {
+		ids = append(ids, sqltypes.NewInt64(i))
+	}
+	dests, err := hash.(vindexes.SingleColumn).Map(nil, ids)
+	require.NoError(t, err)
+	rss, _, err := executor.resolver.resolver.ResolveDestinations(ctx, "TestExecutor", topodatapb.TabletType_MASTER, nil, dests)
+	require.NoError(t, err)
+	var want []string
+	for _, rs := range rss {
+		want = append(want, fmt.Sprintf("[VARCHAR(%q)]", rs.Target.Shard))
+	}
+	sort.Strings(want)
+	var got []string
+	for _, row := range qr.Rows {
+		got = append(got, fmt.Sprintf("%v", row))
+	}
+	assert.Equal(t, want, got)
+
+	qr, err = executor.Execute(ctx, "TestExecute", session, "explain shards for TestExecutor.user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARCHAR("-20")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	// No query reaches the tablets.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2, sbclookup} {
+		assert.EqualValues(t, 0, sbc.ExecCount.Get())
+	}
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "explain shards for TestExecutor.user where name = 'foo'", nil)
+	require.EqualError(t, err, "unsupported: explain shards only supports an equality or IN predicate on the primary vindex column Id")
+}
+
+func TestExecutorExplainVindex(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	// The unique vindex is selected over the lookup, even if the
+	// predicate on the lookup column comes first.
+	qr, err := executor.Execute(ctx, "TestExecute", session, "explain vindex for TestExecutor.user where name = 'foo' and id = ?", nil)
+	require.NoError(t, err)
+	wantFields := []string{"Vindex", "Columns", "Type", "Cost", "Unique", "Selected", "Reason"}
+	var fields []string
+	for _, field := range qr.Fields {
+		fields = append(fields, field.Name)
+	}
+	assert.Equal(t, wantFields, fields)
+	assert.Equal(t, [][]sqltypes.Value{
+		buildVarCharRow("hash_index", "Id", "hash", "1", "true", "true", "selected for SelectEqualUnique"),
+		buildVarCharRow("name_user_map", "name", "lookup_hash", "20", "false", "false", "not unique, unlike the selected vindex hash_index"),
+	}, qr.Rows)
+
+	qr, err = executor.Execute(ctx, "TestExecute", session, "explain vindex for TestExecutor.user where textcol = 'a'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{
+		buildVarCharRow("hash_index", "Id", "hash", "1", "true", "false", "no equality, IN or IS NULL predicate on Id"),
+		buildVarCharRow("name_user_map", "name", "lookup_hash", "20", "false", "false", "no equality, IN or IS NULL predicate on name"),
+	}, qr.Rows)
+
+	// No query reaches the tablets.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2, sbclookup} {
+		assert.EqualValues(t, 0, sbc.ExecCount.Get())
+	}
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "explain vindex for TestUnsharded.noauto where id = 1", nil)
+	require.EqualError(t, err, "table TestUnsharded.noauto is in unsharded keyspace TestUnsharded, which has no vindexes")
+}
+
+func TestExecutorSimulateVindexChurn(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+
+	// hash maps 1 to 166b40b44aba4bd6, 2 to 06e7ea22ce92708f and 3 to
+	// 4eb190c9a2fa169c, while numeric keeps them all in -20.
+	churn, err := executor.SimulateVindexChurn(ctx, "TestExecutor", "user", "hash_index", &vschemapb.Vindex{Type: "numeric"}, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, vindexes.ChurnResult{Rows: 3, KeyspaceIDs: 3, Shards: 1}, churn)
+
+	churn, err = executor.SimulateVindexChurn(ctx, "TestExecutor", "user", "hash_index", &vschemapb.Vindex{Type: "hash"}, 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, vindexes.ChurnResult{Rows: 100}, churn)
+
+	// No query reaches the tablets.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2, sbclookup} {
+		assert.EqualValues(t, 0, sbc.ExecCount.Get())
+	}
+
+	_, err = executor.SimulateVindexChurn(ctx, "TestExecutor", "user", "name_user_map", &vschemapb.Vindex{Type: "hash"}, 1, 3)
+	require.EqualError(t, err, "unsupported: churn of vindex name_user_map that needs to query tablets")
+	_, err = executor.SimulateVindexChurn(ctx, "TestExecutor", "user", "hash_index", &vschemapb.Vindex{Type: "nope"}, 1, 3)
+	require.EqualError(t, err, "invalid proposed definition for vindex hash_index: vindexType \"nope\" not found")
+	_, err = executor.SimulateVindexChurn(ctx, "TestExecutor", "user", "hash_index", &vschemapb.Vindex{Type: "numeric"}, 3, 1)
+	require.EqualError(t, err, "invalid sample range 3 to 1: it must have between 1 and 1000000 ids")
+}
+
+func TestExecutorExplainDDL(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	qr, err := executor.Execute(ctx, "TestExecute", session, "explain create table TestExecutor.t1 (id bigint primary key)", nil)
+	require.NoError(t, err)
+	var names []string
+	for _, field := range qr.Fields {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"statement_type", "keyspace", "shard", "query", "applied"}, names)
+	var shards []string
+	for _, row := range qr.Rows {
+		assert.Equal(t, "create table", row[0].ToString())
+		assert.Equal(t, "TestExecutor", row[1].ToString())
+		assert.Equal(t, "create table t1 (\n\tid bigint primary key\n)", row[3].ToString())
+		assert.Equal(t, "true", row[4].ToString())
+		shards = append(shards, row[2].ToString())
+	}
+	assert.Equal(t, []string{"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"}, shards)
+
+	qr, err = executor.Execute(ctx, "TestExecute", session, "explain drop view TestUnsharded.v1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARCHAR("drop view") VARCHAR("TestUnsharded") VARCHAR("0") VARCHAR("drop view v1") VARCHAR("true")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	// A range target applies the statement to every shard it covers.
+	applied := func(target string) []string {
+		t.Helper()
+		session := NewSafeSession(&vtgatepb.Session{TargetString: target})
+		qr, err := executor.Execute(ctx, "TestExecute", session, "explain alter table t1 add column c int", nil)
+		require.NoError(t, err)
+		require.Len(t, qr.Rows, 8)
+		var shards []string
+		for _, row := range qr.Rows {
+			if row[4].ToString() == "true" {
+				shards = append(shards, row[2].ToString())
+			}
+		}
+		return shards
+	}
+	assert.Equal(t, []string{"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"}, applied("TestExecutor[-]"))
+	assert.Equal(t, []string{"40-60"}, applied("TestExecutor:40-60"))
+
+	// No query reaches the tablets.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2, sbclookup} {
+		assert.EqualValues(t, 0, sbc.ExecCount.Get())
+	}
+}
+
+func TestExecutorDescribeVSchema(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	qr, err := executor.Execute(ctx, "TestExecute", session, "describe vschema TestExecutor.user", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Kind", "Name", "Columns", "Type", "Primary"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("vindex", "hash_index", "Id", "hash", "true"),
+			buildVarCharRow("vindex", "name_user_map", "name", "lookup_hash", "false"),
+			buildVarCharRow("auto_increment", "TestUnsharded.user_seq", "id", "sequence", ""),
+		},
+	}
+	utils.MustMatch(t, wantqr, qr, "describe vschema")
+
+	qr, err = executor.Execute(ctx, "TestExecute", session, "describe vschema TestExecutor.user2", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARCHAR("vindex") VARCHAR("hash_index") VARCHAR("id") VARCHAR("hash") VARCHAR("true")] [VARCHAR("vindex") VARCHAR("name_lastname_keyspace_id_map") VARCHAR("name, lastname") VARCHAR("lookup") VARCHAR("false")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "describe vschema TestExecutor.nope", nil)
+	require.EqualError(t, err, "table nope not found")
+	_, err = executor.Execute(ctx, "TestExecute", session, "describe vschema TestUnsharded.main1", nil)
+	require.EqualError(t, err, "table TestUnsharded.main1 is in unsharded keyspace TestUnsharded, which has no vindexes")
+
+	// No query reaches the tablets.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2, sbclookup} {
+		assert.EqualValues(t, 0, sbc.ExecCount.Get())
+	}
+}
+
 func TestExecutorAlterVSchemaKeyspace(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -1694,6 +2227,44 @@ func TestPassthroughDDL(t *testing.T) {
 	masterSession.TargetString = ""
 }
 
+func TestPassthroughDDLKeyRange(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	defer func() {
+		masterSession.TargetString = ""
+		getSandbox("TestExecutor").ShardSpec = DefaultShardSpec
+	}()
+
+	alterDDL := "alter table passthrough_ddl add column col bigint"
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           alterDDL,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+
+	// 30-50 overlaps the 20-40 and 40-60 shards.
+	masterSession.TargetString = "TestExecutor[30-50]"
+	_, err := executorExec(executor, alterDDL, nil)
+	require.NoError(t, err)
+	assert.Empty(t, sbc1.Queries)
+	assert.Equal(t, wantQueries, sbc2.Queries)
+	sbc2.Queries = nil
+
+	// 10-30 overlaps the -20 and 20-40 shards.
+	masterSession.TargetString = "TestExecutor[10-30]"
+	_, err = executorExec(executor, alterDDL, nil)
+	require.NoError(t, err)
+	assert.Equal(t, wantQueries, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+	sbc1.Queries = nil
+
+	// The keyspace only has shards up to 40, so nothing overlaps 60-80.
+	getSandbox("TestExecutor").ShardSpec = "-20-40"
+	masterSession.TargetString = "TestExecutor[60-80]"
+	_, err = executorExec(executor, alterDDL, nil)
+	require.EqualError(t, err, "keyrange 60-80 does not overlap any shard of keyspace TestExecutor")
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+}
+
 func TestParseEmptyTargetSingleKeyspace(t *testing.T) {
 	r, _, _, _ := createLegacyExecutorEnv()
 	altVSchema := &vindexes.VSchema{
@@ -17,20 +17,31 @@ limitations under the License.
 package vtgate
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -104,9 +115,9 @@ func waitForColVindexes(t *testing.T, ks, table string, names []string, executor
 		vschema := executor.vm.GetCurrentSrvVschema()
 		table, ok := vschema.Keyspaces[ks].Tables[table]
 
-		// The table is removed from the vschema when there are no
-		// vindexes defined
-		if !ok == (len(names) == 0) {
+		// The table is either removed from the vschema or left
+		// without vindexes when the last one is dropped
+		if !ok && len(names) == 0 {
 			return vschema
 		} else if ok && (len(names) == len(table.ColumnVindexes)) {
 			match := true
@@ -129,6 +140,23 @@ func waitForColVindexes(t *testing.T, ks, table string, names []string, executor
 	return nil
 }
 
+// waitForVSchema waits up to 100ms until the vindex manager gets notified of
+// an update for which done returns true, and returns the updated vschema.
+func waitForVSchema(t *testing.T, executor *Executor, done func(*vschemapb.SrvVSchema) bool) *vschemapb.SrvVSchema {
+	t.Helper()
+
+	for i := 0; i < 10; i++ {
+		vschema := executor.vm.GetCurrentSrvVschema()
+		if done(vschema) {
+			return vschema
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("vschema was not updated as expected")
+	return nil
+}
+
 func TestPlanExecutorAlterVSchemaKeyspace(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -156,6 +184,183 @@ func TestPlanExecutorAlterVSchemaKeyspace(t *testing.T) {
 	assert.Equal(t, vindex.Type, "hash")
 }
 
+func TestExecutorShowVSchemaKeyspaces(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test add vindex test_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_hash", vschemaUpdates, executor)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema keyspaces", nil)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(qr.Fields))
+	assert.Equal(t, []string{"Keyspace", "Sharded", "Tables", "Vindexes", "Comment"}, []string{qr.Fields[0].Name, qr.Fields[1].Name, qr.Fields[2].Name, qr.Fields[3].Name, qr.Fields[4].Name})
+	assert.EqualValues(t, 5, len(qr.Rows))
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema keyspaces like 'TestExecutor'", nil)
+	require.NoError(t, err)
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewVarChar(ks),
+		sqltypes.NewVarChar("true"),
+		sqltypes.NewInt64(14),
+		sqltypes.NewInt64(12),
+		sqltypes.NewVarChar(""),
+	}}
+	assert.Equal(t, wantRows, qr.Rows)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema keyspaces where sharded = 'true'", nil)
+	require.EqualError(t, err, "unsupported filter for show vschema keyspaces: sharded = 'true', only like is supported")
+
+	// no queries should have gone to any tablets
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	assert.EqualValues(t, 0, sbclookup.ExecCount.Get())
+}
+
+func TestExecutorShowVindexParams(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindex region_json params", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Name", "Type", "Required", "Default"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("region_map", "string", "true", ""),
+			buildVarCharRow("region_bytes", "int", "true", ""),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindex hash params", nil)
+	require.NoError(t, err)
+	wantqr = &sqltypes.Result{
+		Fields: buildVarCharFields("Message"),
+		Rows:   [][]sqltypes.Value{buildVarCharRow("no param schema is declared for vindex type hash")},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindex nope params", nil)
+	require.EqualError(t, err, "unknown vindex type nope")
+}
+
+func TestExecutorSetKeyspaceComment(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	// showComment waits until the vschema manager gets notified of the
+	// update, and returns the comment listed by SHOW.
+	showComment := func(want string) string {
+		t.Helper()
+		waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+			return vschema.Keyspaces["TestExecutor"].Comment == want
+		})
+		qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema keyspaces like 'TestExecutor'", nil)
+		require.NoError(t, err)
+		require.Len(t, qr.Rows, 1)
+		return qr.Rows[0][4].ToString()
+	}
+
+	stmt := "alter vschema keyspace TestExecutor set comment 'user data, owned by the accounts team'"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "user data, owned by the accounts team", showComment("user data, owned by the accounts team"))
+
+	// An empty comment clears it.
+	stmt = "alter vschema keyspace TestExecutor set comment = ''"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", showComment(""))
+
+	stmt = "alter vschema keyspace NoSuchKeyspace set comment 'x'"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "no keyspace with name [NoSuchKeyspace] found")
+
+	// no queries should have gone to any tablets
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	assert.EqualValues(t, 0, sbclookup.ExecCount.Get())
+}
+
+func TestExecutorRoutingRuleDDL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	// waitForRules waits up to 100ms for an update with the given
+	// number of routing rules, and returns its rules.
+	waitForRules := func(count int) []*vschemapb.RoutingRule {
+		t.Helper()
+		for {
+			select {
+			case vschema := <-vschemaUpdates:
+				if len(vschema.GetRoutingRules().GetRules()) == count {
+					return vschema.RoutingRules.Rules
+				}
+			case <-time.After(100 * time.Millisecond):
+				t.Fatalf("no vschema update with %d routing rules", count)
+			}
+		}
+	}
+
+	stmt := "alter vschema add routing rule TestExecutor.user_extra route to " + KsTestUnsharded + ".user_extra"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	rules := waitForRules(1)
+	assert.Equal(t, "TestExecutor.user_extra", rules[0].FromTable)
+	assert.Equal(t, []string{KsTestUnsharded + ".user_extra"}, rules[0].ToTables)
+
+	stmt = "alter vschema add routing rule TestExecutor.user_extra route to " + KsTestUnsharded + ".t"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "routing rule for TestExecutor.user_extra already exists")
+
+	stmt = "alter vschema add routing rule TestExecutor.music route to NoSuchKeyspace.music"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "keyspace NoSuchKeyspace not found in vschema")
+
+	stmt = "alter vschema drop routing rule TestExecutor.user_extra"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	assert.Empty(t, waitForRules(0))
+
+	// no queries should have gone to any tablets
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 0, sbc2.ExecCount.Get())
+	assert.EqualValues(t, 0, sbclookup.ExecCount.Get())
+}
+
 func TestPlanExecutorCreateVindexDDL(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -197,6 +402,227 @@ func TestPlanExecutorCreateVindexDDL(t *testing.T) {
 	}
 }
 
+func TestVSchemaManagerSubscribeReplay(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	names := []string{"replay_vindex1", "replay_vindex2", "replay_vindex3"}
+	for _, name := range names {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex "+name+" using hash", nil)
+		require.NoError(t, err)
+
+		waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+			return vschema.Keyspaces[ks].Vindexes[name] != nil
+		})
+	}
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	unsubscribe := executor.vm.Subscribe(func(vschema *vschemapb.SrvVSchema) {
+		vschemaUpdates <- vschema
+	}, true)
+	defer unsubscribe()
+
+	// The current state is delivered before Subscribe returns.
+	var vschema *vschemapb.SrvVSchema
+	select {
+	case vschema = <-vschemaUpdates:
+	default:
+		t.Fatalf("current vschema was not replayed on subscribe")
+	}
+	for _, name := range names {
+		assert.Contains(t, vschema.Keyspaces[ks].Vindexes, name)
+	}
+
+	// Later updates keep flowing to the subscriber.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex replay_vindex4 using hash", nil)
+	require.NoError(t, err)
+	waitForVindex(t, ks, "replay_vindex4", vschemaUpdates, executor)
+
+	// Without replay nothing is delivered until the next update.
+	laterUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	unsubscribeLater := executor.vm.Subscribe(func(vschema *vschemapb.SrvVSchema) {
+		laterUpdates <- vschema
+	}, false)
+	defer unsubscribeLater()
+	select {
+	case <-laterUpdates:
+		t.Fatalf("unexpected delivery without replay")
+	case <-time.After(100 * time.Millisecond):
+	}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex replay_vindex5 using hash", nil)
+	require.NoError(t, err)
+	waitForVindex(t, ks, "replay_vindex5", laterUpdates, executor)
+}
+
+func TestExecutorVSchemaUpdateOrigin(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "vschema_admin"
+	vschemaacl.Init()
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "vschema_admin"})
+
+	vschemaUpdates := make(chan *VSchemaUpdate, 4)
+	unsubscribe := executor.vm.SubscribeUpdates(func(update *VSchemaUpdate) {
+		vschemaUpdates <- update
+	}, true)
+	defer unsubscribe()
+
+	// The replayed current state has no origin.
+	update := <-vschemaUpdates
+	assert.Nil(t, update.Origin)
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "alter vschema create vindex test_origin_hash using hash", nil)
+	require.NoError(t, err)
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case update = <-vschemaUpdates:
+		case <-timeout:
+			t.Fatalf("vschema update was not delivered")
+		}
+		if _, ok := update.SrvVSchema.Keyspaces[ks].Vindexes["test_origin_hash"]; ok {
+			break
+		}
+	}
+	assert.Equal(t, &VSchemaOrigin{
+		Username:  "vschema_admin",
+		Statement: "alter vschema create vindex test_origin_hash using hash",
+	}, update.Origin)
+}
+
+func TestExecutorShowVindexesByTag(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	stmt := "alter vschema create vindex test_pii using hash with tags='pii, gdpr'"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, vindex := waitForVindex(t, ks, "test_pii", vschemaUpdates, executor)
+	assert.Equal(t, map[string]string{"tags": "pii,gdpr"}, vindex.Params)
+
+	stmt = "alter vschema create vindex test_legacy using hash with tags=legacy"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_legacy", vschemaUpdates, executor)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes where tag = 'pii'", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow(ks, "test_pii", "hash", "tags=pii,gdpr", ""),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes where tag = 'legacy'", nil)
+	require.NoError(t, err)
+	wantqr.Rows = [][]sqltypes.Value{
+		buildVarCharRow(ks, "test_legacy", "hash", "tags=legacy", ""),
+	}
+	assert.Equal(t, wantqr, qr)
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes where tag = 'none'", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes where name = 'test_pii'", nil)
+	require.EqualError(t, err, "unsupported filter for show vschema vindexes: `name` = 'test_pii', only tag = 'value' is supported")
+}
+
+func TestPlanExecutorCreateVindexUnknownType(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	wantErr := "unknown vindex type bogus; known types: " + strings.Join(vindexes.RegisteredVindexTypes(), ", ")
+	for _, stmt := range []string{
+		"alter vschema create vindex test_vindex using bogus",
+		"alter vschema on test add vindex test_vindex (id) using bogus",
+	} {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		require.EqualError(t, err, wantErr, stmt)
+	}
+	select {
+	case <-vschemaUpdates:
+		t.Error("vschema should not be updated on error")
+	default:
+	}
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex test_vindex using hash", nil)
+	require.NoError(t, err)
+	_, vindex := waitForVindex(t, ks, "test_vindex", vschemaUpdates, executor)
+	assert.Equal(t, "hash", vindex.Type)
+}
+
+func TestExecutorCreateVindexUnique(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	stmt := "alter vschema create vindex test_lookup using lookup with unique=true, table=test_lookup, from=c1, to=keyspace_id"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, `invalid definition for vindex test_lookup: vindex test_lookup is declared unique, but vindexType "lookup" is not unique`)
+
+	stmt = "alter vschema create vindex test_lookup using lookup with unique=false, table=test_lookup, from=c1, to=keyspace_id"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, vindex := waitForVindex(t, ks, "test_lookup", vschemaUpdates, executor)
+	assert.Equal(t, "false", vindex.Params["unique"])
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes like 'test_lookup'", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow(ks, "test_lookup", "lookup", "from=c1; table=test_lookup; to=keyspace_id; unique=false", ""),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+}
+
 func TestPlanExecutorDropVindexDDL(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -321,42 +747,792 @@ func TestPlanExecutorAddDropVschemaTableDDL(t *testing.T) {
 	}
 }
 
-func TestExecutorAddSequenceDDL(t *testing.T) {
+func TestExecutorShowTablesUsingVindex(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
 		*vschemaacl.AuthorizedDDLUsers = ""
 	}()
 	executor, _, _, _ := createLegacyExecutorEnv()
-	ks := KsTestUnsharded
-
-	vschema := executor.vm.GetCurrentSrvVschema()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
 
-	var vschemaTables []string
-	for t := range vschema.Keyspaces[ks].Tables {
-		vschemaTables = append(vschemaTables, t)
-	}
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
 
-	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
-	stmt := "alter vschema add sequence test_seq"
+	stmt := "alter vschema on test_using1 add vindex test_using_hash (id) using hash"
 	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
 	require.NoError(t, err)
-	_ = waitForVschemaTables(t, ks, append(vschemaTables, []string{"test_seq"}...), executor)
-	vschema = executor.vm.GetCurrentSrvVschema()
-	table := vschema.Keyspaces[ks].Tables["test_seq"]
-	wantType := "sequence"
-	if table.Type != wantType {
-		t.Errorf("want table type sequence got %v", table)
-	}
+	_, _ = waitForVindex(t, ks, "test_using_hash", vschemaUpdates, executor)
+	_ = waitForColVindexes(t, ks, "test_using1", []string{"test_using_hash"}, executor)
 
-	// Should fail adding a table on a sharded keyspace
-	ksSharded := "TestExecutor"
-	session = NewSafeSession(&vtgatepb.Session{TargetString: ksSharded})
-
-	stmt = "alter vschema add sequence sequence_table"
+	stmt = "alter vschema on test_using2 add vindex test_using_hash (c)"
 	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_ = waitForColVindexes(t, ks, "test_using2", []string{"test_using_hash"}, executor)
 
-	wantErr := "add sequence table: unsupported on sharded keyspace TestExecutor"
-	if err == nil || err.Error() != wantErr {
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Tables"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("test_using1"),
+			buildVarCharRow("test_using2"),
+		},
+	}
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema tables using vindex test_using_hash", nil)
+	require.NoError(t, err)
+	assert.Equal(t, wantqr, qr)
+
+	// The keyspace can also be given explicitly.
+	qr, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{}), "show vschema tables using vindex TestExecutor.test_using_hash", nil)
+	require.NoError(t, err)
+	assert.Equal(t, wantqr, qr)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema tables using vindex test_using_nonexistent", nil)
+	require.EqualError(t, err, "vindex test_using_nonexistent does not exist in keyspace TestExecutor")
+}
+
+func TestExecutorShowVSchemaAsSQL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test_as_sql add vindex test_as_sql_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_as_sql_hash", vschemaUpdates, executor)
+	_ = waitForColVindexes(t, ks, "test_as_sql", []string{"test_as_sql_hash"}, executor)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema as sql", nil)
+	require.NoError(t, err)
+	assert.Equal(t, buildVarCharFields("Statement"), qr.Fields)
+	var ddls []string
+	for _, row := range qr.Rows {
+		ddls = append(ddls, row[0].ToString())
+	}
+	assert.Equal(t, topotools.VSchemaDDL(executor.vm.GetCurrentSrvVschema()), ddls)
+	assert.Contains(t, ddls, "alter vschema create vindex TestExecutor.test_as_sql_hash using hash")
+	assert.Contains(t, ddls, "alter vschema on TestExecutor.test_as_sql add vindex test_as_sql_hash (id)")
+}
+
+func TestExecutorDropColVindexCascade(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test_cascade add vindex test_cascade_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_cascade_hash", vschemaUpdates, executor)
+	_ = waitForColVindexes(t, ks, "test_cascade", []string{"test_cascade_hash"}, executor)
+
+	// Without cascade, a sharded table can't be left without a vindex.
+	stmt = "alter vschema on test_cascade drop vindex test_cascade_hash"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "table TestExecutor.test_cascade would have no vindex left: use cascade to drop it from the vschema, or set scatter = true on it first")
+
+	// Once it is set as scatter, its entry is kept, with a warning.
+	stmt = "alter vschema on test_cascade set scatter = true"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Tables["test_cascade"].GetScatter()
+	})
+	stmt = "alter vschema on test_cascade drop vindex test_cascade_hash"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema := waitForColVindexes(t, ks, "test_cascade", []string{}, executor)
+	require.Contains(t, vschema.Keyspaces[ks].Tables, "test_cascade")
+	assert.True(t, vschema.Keyspaces[ks].Tables["test_cascade"].Scatter)
+	require.Len(t, session.Warnings, 1)
+	assert.EqualValues(t, mysql.ERUnknownError, session.Warnings[0].Code)
+	assert.Equal(t, "table TestExecutor.test_cascade has no vindex left and stays scatter-routed, use cascade to drop it from the vschema", session.Warnings[0].Message)
+
+	stmt = "alter vschema on test_cascade add vindex test_cascade_hash (id)"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_ = waitForColVindexes(t, ks, "test_cascade", []string{"test_cascade_hash"}, executor)
+
+	// With cascade, there is nothing to warn about.
+	session.Warnings = nil
+	stmt = "alter vschema on test_cascade drop vindex test_cascade_hash cascade"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema = waitForColVindexes(t, ks, "test_cascade", []string{}, executor)
+	assert.NotContains(t, vschema.Keyspaces[ks].Tables, "test_cascade")
+	assert.Contains(t, vschema.Keyspaces[ks].Vindexes, "test_cascade_hash")
+	assert.Empty(t, session.Warnings)
+}
+
+func TestExecutorDropAllColVindexes(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test_drop_all add vindex test_drop_all_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_drop_all_hash", vschemaUpdates, executor)
+
+	stmt = "alter vschema on test_drop_all add vindex test_drop_all_hash2 (c) using hash"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_drop_all_hash2", vschemaUpdates, executor)
+	_ = waitForColVindexes(t, ks, "test_drop_all", []string{"test_drop_all_hash", "test_drop_all_hash2"}, executor)
+
+	stmt = "alter vschema on test_drop_all set scatter = true"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	<-vschemaUpdates
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Tables["test_drop_all"].GetScatter()
+	})
+
+	// Both bindings go away in a single update.
+	stmt = "alter vschema on test_drop_all drop all vindexes"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema := <-vschemaUpdates
+	require.Contains(t, vschema.Keyspaces[ks].Tables, "test_drop_all")
+	assert.Empty(t, vschema.Keyspaces[ks].Tables["test_drop_all"].ColumnVindexes)
+	assert.True(t, vschema.Keyspaces[ks].Tables["test_drop_all"].Scatter)
+	_ = waitForColVindexes(t, ks, "test_drop_all", []string{}, executor)
+	select {
+	case <-vschemaUpdates:
+		t.Error("vschema should be updated only once")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// Dropping all the vindexes of a table that has none doesn't update the vschema.
+	stmt = "alter vschema add reference table test_drop_all_ref"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	<-vschemaUpdates
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Tables["test_drop_all_ref"] != nil
+	})
+	stmt = "alter vschema on test_drop_all_ref drop all vindexes"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	select {
+	case <-vschemaUpdates:
+		t.Error("vschema should not be updated when there are no vindexes to drop")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestExecutorCopyKeyspace(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test_copy add vindex test_copy_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_copy_hash", vschemaUpdates, executor)
+
+	stmt = "alter vschema on test_copy add vindex test_copy_lookup (c) using lookup with table=test_copy_lkp, from=c, to=keyspace_id"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_copy_lookup", vschemaUpdates, executor)
+	_ = waitForColVindexes(t, ks, "test_copy", []string{"test_copy_hash", "test_copy_lookup"}, executor)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema copy keyspace TestExecutor to TestExecutorStaging", nil)
+	require.NoError(t, err)
+	vschema := <-vschemaUpdates
+	want, got := vschema.Keyspaces[ks], vschema.Keyspaces["TestExecutorStaging"]
+	assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+	assert.Contains(t, got.Vindexes, "test_copy_hash")
+	assert.Contains(t, got.Vindexes, "test_copy_lookup")
+
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces["TestExecutorStaging"] != nil
+	})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema copy keyspace TestExecutor to TestExecutorStaging", nil)
+	require.EqualError(t, err, "vschema already contains keyspace TestExecutorStaging")
+}
+
+func TestExecutorDropTableDropsVSchemaTable(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	for _, table := range []string{"test_drop1", "test_drop2"} {
+		stmt := fmt.Sprintf("alter vschema on %s add vindex test_drop_hash (id) using hash", table)
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		require.NoError(t, err)
+		<-vschemaUpdates
+		_ = waitForColVindexes(t, ks, table, []string{"test_drop_hash"}, executor)
+	}
+
+	// By default, the vschema is left as is.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "drop table test_drop1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "drop table test_drop1", sbc1.Queries[0].Sql)
+	assert.Contains(t, executor.vm.GetCurrentSrvVschema().Keyspaces[ks].Tables, "test_drop1")
+	sbc1.Queries = nil
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @@ddl_drop_vschema_table = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.GetDDLDropVSchemaTable())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "drop table test_drop1, test_drop_unknown", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "drop table test_drop1, test_drop_unknown", sbc1.Queries[0].Sql)
+	vschema := <-vschemaUpdates
+	assert.NotContains(t, vschema.Keyspaces[ks].Tables, "test_drop1")
+	assert.Contains(t, vschema.Keyspaces[ks].Tables, "test_drop2")
+	assert.Contains(t, vschema.Keyspaces[ks].Vindexes, "test_drop_hash")
+	sbc1.Queries = nil
+
+	// A caller that can't change the vschema doesn't get to drop the
+	// table either.
+	*vschemaacl.AuthorizedDDLUsers = ""
+	vschemaacl.Init()
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "drop table test_drop2", nil)
+	require.EqualError(t, err, "not authorized to perform vschema operations")
+	assert.Empty(t, sbc1.Queries)
+	assert.Contains(t, executor.vm.GetCurrentSrvVschema().Keyspaces[ks].Tables, "test_drop2")
+
+	// Tables that are not in the vschema don't need the permission.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "drop table test_drop_unknown", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "drop table test_drop_unknown", sbc1.Queries[0].Sql)
+}
+
+func TestExecutorAddColVindexes(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	// A failing binding doesn't update the vschema.
+	stmt := "alter vschema on test add vindexes (id using hash, c1 using nope)"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown vindex type nope")
+
+	stmt = "alter vschema on test add vindexes (id using hash, name using unicode_loose_md5)"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+
+	// Both bindings come in a single update.
+	var vschema *vschemapb.SrvVSchema
+	select {
+	case vschema = <-vschemaUpdates:
+	case <-time.After(time.Second):
+		t.Fatalf("vschema was not updated")
+	}
+	table := vschema.Keyspaces[ks].Tables["test"]
+	require.NotNil(t, table)
+	require.Len(t, table.ColumnVindexes, 2)
+	assert.Equal(t, "hash_id", table.ColumnVindexes[0].Name)
+	assert.Equal(t, []string{"id"}, table.ColumnVindexes[0].Columns)
+	assert.Equal(t, "unicode_loose_md5_name", table.ColumnVindexes[1].Name)
+	assert.Equal(t, []string{"name"}, table.ColumnVindexes[1].Columns)
+	select {
+	case <-vschemaUpdates:
+		t.Fatalf("unexpected vschema update")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestExecutorSetParentTable(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	// Each statement is checked against the vschema updated by the
+	// previous ones, so they wait for the table they change to be done.
+	execute := func(stmt, table string, done func(*vschemapb.Table) bool) {
+		t.Helper()
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		require.NoError(t, err, stmt)
+		waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+			tbl := vschema.Keyspaces[ks].Tables[table]
+			return tbl != nil && done(tbl)
+		})
+	}
+	hasVindex := func(tbl *vschemapb.Table) bool { return len(tbl.ColumnVindexes) > 0 }
+	execute("alter vschema on orders add vindexes (id using hash)", "orders", hasVindex)
+	execute("alter vschema on order_item add vindexes (order_id using hash)", "order_item", hasVindex)
+	execute("alter vschema on order_note add vindexes (order_id using unicode_loose_md5)", "order_note", hasVindex)
+	execute("alter vschema on order_item set parent orders on (order_id) references (id)", "order_item", func(tbl *vschemapb.Table) bool {
+		return tbl.Parent != nil
+	})
+
+	want := &vschemapb.ParentTable{Table: "TestExecutor.orders", Columns: []string{"order_id"}, ReferencedColumns: []string{"id"}}
+	got := executor.vm.GetCurrentSrvVschema().Keyspaces[ks].Tables["order_item"].Parent
+	assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+
+	stmt := "alter vschema on order_note set parent orders on (order_id) references (id)"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "primary vindex unicode_loose_md5_order_id of table order_note is not compatible with primary vindex hash_id of parent table TestExecutor.orders")
+
+	stmt = "alter vschema on order_item set parent nope.orders on (order_id) references (id)"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "keyspace nope not found in vschema")
+}
+
+func TestExecutorDisableVindex(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{})
+
+	// waitForDisabled waits until the vschema manager gets notified of
+	// the update.
+	waitForDisabled := func(disabled bool) {
+		t.Helper()
+		waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+			return vschema.Keyspaces[ks].Tables["user"].ColumnVindexes[1].Disabled == disabled
+		})
+	}
+	query := "select id from user where name = 'foo'"
+
+	// The lookup vindex routes the query to a single shard.
+	lookupResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("b|a", "varbinary|varbinary"),
+		"foo|1",
+	)
+	sbclookup.SetResults([]*sqltypes.Result{lookupResult})
+	_, err := executor.Execute(context.Background(), "TestExecute", session, query, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ExecCount.Get())
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get()+sbc2.ExecCount.Get())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema on TestExecutor.user disable vindex name_user_map", nil)
+	require.NoError(t, err)
+	waitForDisabled(true)
+
+	// Once it is disabled, the query is scattered.
+	sbc1.ExecCount.Set(0)
+	sbc2.ExecCount.Set(0)
+	sbclookup.ExecCount.Set(0)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, query, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbclookup.ExecCount.Get())
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 1, sbc2.ExecCount.Get())
+
+	// The vindex is still defined and bound to the table.
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes on TestExecutor.user", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 2)
+	assert.Equal(t, `[VARCHAR("name") VARCHAR("name_user_map") VARCHAR("lookup_hash") VARCHAR("from=name; table=name_user_map; to=user_id") VARCHAR("user") VARCHAR("false") VARCHAR("false")]`, fmt.Sprintf("%v", qr.Rows[1]))
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema on TestExecutor.user disable vindex hash_index", nil)
+	require.EqualError(t, err, "vindex hash_index is the primary vindex of table TestExecutor.user and cannot be disabled")
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema on TestExecutor.user enable vindex name_user_map", nil)
+	require.NoError(t, err)
+	waitForDisabled(false)
+	sbclookup.ExecCount.Set(0)
+	sbclookup.SetResults([]*sqltypes.Result{lookupResult})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, query, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbclookup.ExecCount.Get())
+}
+
+func TestExecutorCaptureVSchemaDDL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	// Statements are only captured once the session asks for it.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex capture_ignored using hash", nil)
+	require.NoError(t, err)
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Vindexes["capture_ignored"] != nil
+	})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @@capture_vschema_ddl = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.GetCaptureVSchemaDDL())
+
+	// Each statement waits for the vschema manager to see the previous
+	// one.
+	stmts := []struct {
+		sql  string
+		done func(*vschemapb.Keyspace) bool
+	}{{
+		sql:  "alter vschema create vindex capture_hash using hash",
+		done: func(ks *vschemapb.Keyspace) bool { return ks.Vindexes["capture_hash"] != nil },
+	}, {
+		sql:  "alter vschema on capture_t1 add vindex capture_hash (id)",
+		done: func(ks *vschemapb.Keyspace) bool { return len(ks.Tables["capture_t1"].GetColumnVindexes()) == 1 },
+	}, {
+		sql:  "alter vschema on capture_t1 add vindex (name) using unicode_loose_md5",
+		done: func(ks *vschemapb.Keyspace) bool { return len(ks.Tables["capture_t1"].GetColumnVindexes()) == 2 },
+	}, {
+		sql:  "alter vschema on capture_t2 add vindex capture_hash (id)",
+		done: func(ks *vschemapb.Keyspace) bool { return ks.Tables["capture_t2"] != nil },
+	}, {
+		// The values of the params are quoted again when the statement is
+		// captured, so that it can be replayed.
+		sql:  "alter vschema create vindex capture_lkp using lookup with table=`TestExecutor.capture_lkp`, from=`c1,c2`, to=keyspace_id",
+		done: func(ks *vschemapb.Keyspace) bool { return ks.Vindexes["capture_lkp"] != nil },
+	}, {
+		sql:  "alter vschema drop vindex capture_ignored",
+		done: func(ks *vschemapb.Keyspace) bool { return ks.Vindexes["capture_ignored"] == nil },
+	}}
+	for _, stmt := range stmts {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt.sql, nil)
+		require.NoError(t, err)
+		waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+			return stmt.done(vschema.Keyspaces[ks])
+		})
+	}
+	// A failing statement isn't captured.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema drop vindex capture_nope", nil)
+	require.Error(t, err)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vitess_captured_vschema_ddl", nil)
+	require.NoError(t, err)
+	wantRows := [][]sqltypes.Value{
+		buildVarCharRow(ks, "alter vschema create vindex capture_hash using hash"),
+		buildVarCharRow(ks, "alter vschema on capture_t1 add vindex capture_hash (id)"),
+		buildVarCharRow(ks, "alter vschema on capture_t1 add vindex unicode_loose_md5_name (`name`) using unicode_loose_md5"),
+		buildVarCharRow(ks, "alter vschema on capture_t2 add vindex capture_hash (id)"),
+		buildVarCharRow(ks, "alter vschema create vindex capture_lkp using lookup with table=`TestExecutor.capture_lkp`, from=`c1,c2`, to=keyspace_id"),
+		buildVarCharRow(ks, "alter vschema drop vindex capture_ignored"),
+	}
+	assert.Equal(t, wantRows, qr.Rows)
+
+	// The vindex dropped by the last statement was created before the
+	// capture, so the whole replay fails.
+	captured := session.GetCapturedVSchemaDDL()
+	empty := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{ks: {Sharded: true}},
+	}
+	got, err := executor.ReplayVSchemaDDL(empty, captured)
+	require.EqualError(t, err, "statement 6: vindex capture_ignored does not exists in keyspace TestExecutor")
+	assert.Nil(t, got)
+
+	// Replaying the other statements on an empty keyspace rebuilds the
+	// objects they created.
+	got, err = executor.ReplayVSchemaDDL(empty, captured[:5])
+	require.NoError(t, err)
+	assert.Empty(t, empty.Keyspaces[ks].Tables)
+	live := executor.vm.GetCurrentSrvVschema().Keyspaces[ks]
+	want := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"capture_hash":           live.Vindexes["capture_hash"],
+			"unicode_loose_md5_name": live.Vindexes["unicode_loose_md5_name"],
+			"capture_lkp":            live.Vindexes["capture_lkp"],
+		},
+		Tables: map[string]*vschemapb.Table{
+			"capture_t1": live.Tables["capture_t1"],
+			"capture_t2": live.Tables["capture_t2"],
+		},
+	}
+	assert.True(t, proto.Equal(want, got.Keyspaces[ks]), "got %v, want %v", got.Keyspaces[ks], want)
+
+	// A script is captured as the statements it applied, which can be
+	// replayed.
+	script := "alter vschema create vindex capture_script1 using hash; " +
+		"alter vschema drop vindex capture_nope; " +
+		"alter vschema create vindex capture_script2 using hash"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema apply '"+script+"'", nil)
+	require.NoError(t, err)
+	captured = session.GetCapturedVSchemaDDL()
+	require.Len(t, captured, 8)
+	assert.Equal(t, "alter vschema create vindex capture_script1 using hash", captured[6].Statement)
+	assert.Equal(t, "alter vschema create vindex capture_script2 using hash", captured[7].Statement)
+	got, err = executor.ReplayVSchemaDDL(empty, captured[6:])
+	require.NoError(t, err)
+	assert.Contains(t, got.Keyspaces[ks].Vindexes, "capture_script1")
+	assert.Contains(t, got.Keyspaces[ks].Vindexes, "capture_script2")
+
+	// Once the session captured as many statements as allowed, the next
+	// ones are still applied, but not captured.
+	defer func(max int) {
+		*maxCapturedVSchemaDDL = max
+	}(*maxCapturedVSchemaDDL)
+	*maxCapturedVSchemaDDL = 8
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex capture_over using hash", nil)
+	require.NoError(t, err)
+	assert.Len(t, session.GetCapturedVSchemaDDL(), 8)
+	require.Len(t, session.Warnings, 1)
+	assert.Equal(t, "vschema ddl not captured, the session already captured 8 statements", session.Warnings[0].Message)
+}
+
+func TestExecutorVSchemaDDLJSON(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	// By default, the result is empty.
+	stmt := "alter vschema create vindex test_json_plain using hash"
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Fields)
+	assert.Empty(t, qr.Rows)
+	_, _ = waitForVindex(t, ks, "test_json_plain", vschemaUpdates, executor)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @@vschema_ddl_json = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.GetVSchemaDDLJSON())
+
+	stmt = "alter vschema create vindex test_json_lookup using lookup with table=t_lkp, from=c1, to=keyspace_id"
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Fields, 1)
+	assert.Equal(t, "vschema_change", qr.Fields[0].Name)
+	require.Len(t, qr.Rows, 1)
+	var change map[string]interface{}
+	require.NoError(t, json.Unmarshal(qr.Rows[0][0].ToBytes(), &change))
+	assert.Equal(t, map[string]interface{}{
+		"action":   "create vindex",
+		"keyspace": ks,
+		"vindex": map[string]interface{}{
+			"name": "test_json_lookup",
+			"type": "lookup",
+			"params": map[string]interface{}{
+				"table": "t_lkp",
+				"from":  "c1",
+				"to":    "keyspace_id",
+			},
+		},
+	}, change)
+	_, _ = waitForVindex(t, ks, "test_json_lookup", vschemaUpdates, executor)
+
+	stmt = "alter vschema on test_json add vindex test_json_plain (id)"
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.JSONEq(t, `{
+		"action": "on table add vindex",
+		"keyspace": "TestExecutor",
+		"bindings": [{"table": "test_json", "vindex": "test_json_plain", "columns": ["id"]}]
+	}`, qr.Rows[0][0].ToString())
+}
+
+func TestExecutorAddVindexGeneratedName(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema on test_gen add vindex (Id) using hash", nil)
+	require.NoError(t, err)
+	_, vindex := waitForVindex(t, ks, "hash_id", vschemaUpdates, executor)
+	assert.Equal(t, "hash", vindex.Type)
+	_ = waitForColVindexes(t, ks, "test_gen", []string{"hash_id"}, executor)
+
+	// Another vindex already holds the generated name.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex xxhash_c using hash", nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "xxhash_c", vschemaUpdates, executor)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema on test_gen add vindex (c) using xxhash", nil)
+	require.EqualError(t, err, "vindex xxhash_c defined with type hash not xxhash")
+}
+
+func TestExecutorVSchemaSizeLimit(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		*vschemaMaxTables = 100000
+		*vschemaMaxVindexes = 100000
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	vschema := <-vschemaUpdates
+	*vschemaMaxTables = len(vschema.Keyspaces[ks].Tables)
+	*vschemaMaxVindexes = len(vschema.Keyspaces[ks].Vindexes)
+
+	stmt := "alter vschema on test_limit add vindex hash_index (id)"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, fmt.Sprintf("vschema of keyspace TestExecutor would have %d tables, more than the limit of %d", *vschemaMaxTables+1, *vschemaMaxTables))
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	stmt = "alter vschema create vindex test_limit_vindex using hash"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, fmt.Sprintf("vschema of keyspace TestExecutor would have %d vindexes, more than the limit of %d", *vschemaMaxVindexes+1, *vschemaMaxVindexes))
+
+	select {
+	case <-vschemaUpdates:
+		t.Error("vschema should not be updated when a limit is hit")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// A keyspace over the limit can still shrink.
+	*vschemaMaxTables = 1
+	stmt = "alter vschema drop table music_extra"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema = <-vschemaUpdates
+	assert.NotContains(t, vschema.Keyspaces[ks].Tables, "music_extra")
+}
+
+func TestExecutorRenameVschemaTable(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test_rename add vindex test_rename_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_rename_hash", vschemaUpdates, executor)
+
+	stmt = "alter vschema rename table test_rename to test_renamed"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema := waitForColVindexes(t, ks, "test_renamed", []string{"test_rename_hash"}, executor)
+	assert.NotContains(t, vschema.Keyspaces[ks].Tables, "test_rename")
+	assert.Equal(t, []string{"id"}, vschema.Keyspaces[ks].Tables["test_renamed"].ColumnVindexes[0].Columns)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "vschema does not contain table test_rename in keyspace TestExecutor")
+
+	// The sequence of the sharded tables can't be renamed from under them.
+	stmt = "alter vschema rename table " + KsTestUnsharded + ".user_seq to user_seq2"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "cannot rename table TestUnsharded.user_seq: referenced by tables of other keyspaces: TestExecutor.music, TestExecutor.user")
+}
+
+func TestExecutorAddSequenceDDL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := KsTestUnsharded
+
+	vschema := executor.vm.GetCurrentSrvVschema()
+
+	var vschemaTables []string
+	for t := range vschema.Keyspaces[ks].Tables {
+		vschemaTables = append(vschemaTables, t)
+	}
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	stmt := "alter vschema add sequence test_seq"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_ = waitForVschemaTables(t, ks, append(vschemaTables, []string{"test_seq"}...), executor)
+	vschema = executor.vm.GetCurrentSrvVschema()
+	table := vschema.Keyspaces[ks].Tables["test_seq"]
+	wantType := "sequence"
+	if table.Type != wantType {
+		t.Errorf("want table type sequence got %v", table)
+	}
+
+	// Should fail adding a table on a sharded keyspace
+	ksSharded := "TestExecutor"
+	session = NewSafeSession(&vtgatepb.Session{TargetString: ksSharded})
+
+	stmt = "alter vschema add sequence sequence_table"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+
+	wantErr := "add sequence table: unsupported on sharded keyspace TestExecutor"
+	if err == nil || err.Error() != wantErr {
 		t.Errorf("want error %v got %v", wantErr, err)
 	}
 
@@ -381,6 +1557,95 @@ func TestExecutorAddSequenceDDL(t *testing.T) {
 	}
 }
 
+func TestExecutorAddAutoIncCrossKeyspace(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	var unshardedTables []string
+	for table := range executor.vm.GetCurrentSrvVschema().Keyspaces[KsTestUnsharded].Tables {
+		unshardedTables = append(unshardedTables, table)
+	}
+	for _, table := range []string{"test_table_seq", "test_not_seq"} {
+		stmt := "alter vschema add table " + KsTestUnsharded + "." + table
+		if table == "test_table_seq" {
+			stmt = "alter vschema add sequence " + KsTestUnsharded + "." + table
+		}
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		require.NoError(t, err, stmt)
+		unshardedTables = append(unshardedTables, table)
+		_ = waitForVschemaTables(t, KsTestUnsharded, unshardedTables, executor)
+	}
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema on test_table add vindex test_hash (id) using hash", nil)
+	require.NoError(t, err)
+	_ = waitForColVindexes(t, ks, "test_table", []string{"test_hash"}, executor)
+
+	for stmt, wantErr := range map[string]string{
+		"alter vschema on test_table add auto_increment id using " + KsTestUnsharded + ".nope":    "sequence nope not found in keyspace " + KsTestUnsharded,
+		"alter vschema on test_table add auto_increment id using " + KsTestUnsharded + ".test_not_seq": "table " + KsTestUnsharded + ".test_not_seq is not a sequence",
+		"alter vschema on test_table add auto_increment id using NoSuchKeyspace.test_table_seq":         "keyspace NoSuchKeyspace not found in vschema",
+		"alter vschema on test_table add auto_increment id using nope":                            "sequence nope not found in vschema",
+	} {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		assert.EqualError(t, err, wantErr, stmt)
+	}
+
+	// The sequence of a sharded table lives in the unsharded keyspace.
+	stmt := "alter vschema on " + ks + ".test_table add auto_increment id using " + KsTestUnsharded + ".test_table_seq"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+
+	vschema := waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Tables["test_table"].GetAutoIncrement() != nil
+	})
+	wantAutoInc := &vschemapb.AutoIncrement{Column: "id", Sequence: KsTestUnsharded + ".test_table_seq"}
+	assert.Equal(t, wantAutoInc, vschema.Keyspaces[ks].Tables["test_table"].AutoIncrement)
+
+	// The executor resolves the sequence in the other keyspace.
+	table, err := executor.VSchema().FindTable(ks, "test_table")
+	require.NoError(t, err)
+	require.NotNil(t, table.AutoIncrement)
+	assert.Equal(t, KsTestUnsharded, table.AutoIncrement.Sequence.Keyspace.Name)
+	assert.Equal(t, vindexes.TypeSequence, table.AutoIncrement.Sequence.Type)
+}
+
+func TestExecutorAddReferenceTableDDL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+
+	vschema := executor.vm.GetCurrentSrvVschema()
+
+	var vschemaTables []string
+	for t := range vschema.Keyspaces[ks].Tables {
+		vschemaTables = append(vschemaTables, t)
+	}
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	stmt := "alter vschema add reference table test_ref source=" + KsTestUnsharded + ".test_ref"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_ = waitForVschemaTables(t, ks, append(vschemaTables, "test_ref"), executor)
+	table := executor.vm.GetCurrentSrvVschema().Keyspaces[ks].Tables["test_ref"]
+	assert.Equal(t, "reference", table.Type)
+	assert.Equal(t, KsTestUnsharded+".test_ref", table.Source)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "vschema already contains table test_ref in keyspace TestExecutor")
+
+	// A plain table is still not allowed in a sharded keyspace.
+	stmt = "alter vschema add table test_plain"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "add vschema table: unsupported on sharded keyspace TestExecutor")
+}
+
 func TestExecutorAddDropVindexDDL(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = "%"
 	defer func() {
@@ -420,9 +1685,9 @@ func TestExecutorAddDropVindexDDL(t *testing.T) {
 		t.Fatalf("error in show vschema vindexes on TestExecutor.test: %v", err)
 	}
 	wantqr := &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("id", "test_hash", "hash", "", ""),
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
 		},
 		RowsAffected: 1,
 	}
@@ -464,9 +1729,9 @@ func TestExecutorAddDropVindexDDL(t *testing.T) {
 		t.Fatalf("error in show vschema vindexes on TestExecutor.test: %v", err)
 	}
 	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("id", "test_hash", "hash", "", ""),
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
 		},
 		RowsAffected: 1,
 	}
@@ -502,10 +1767,10 @@ func TestExecutorAddDropVindexDDL(t *testing.T) {
 		t.Fatalf("error in show vschema vindexes on TestExecutor.test: %v", err)
 	}
 	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("id", "test_hash", "hash", "", ""),
-			buildVarCharRow("c1, c2", "test_lookup", "lookup", "from=c1,c2; table=test_lookup; to=keyspace_id", "test"),
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
+			buildVarCharRow("c1, c2", "test_lookup", "lookup", "from=c1,c2; table=test_lookup; to=keyspace_id", "test", "true", "true"),
 		},
 		RowsAffected: 2,
 	}
@@ -540,11 +1805,11 @@ func TestExecutorAddDropVindexDDL(t *testing.T) {
 		t.Fatalf("error in show vschema vindexes on TestExecutor.test: %v", err)
 	}
 	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("id", "test_hash", "hash", "", ""),
-			buildVarCharRow("c1, c2", "test_lookup", "lookup", "from=c1,c2; table=test_lookup; to=keyspace_id", "test"),
-			buildVarCharRow("id2", "test_hash_id2", "hash", "", ""),
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
+			buildVarCharRow("c1, c2", "test_lookup", "lookup", "from=c1,c2; table=test_lookup; to=keyspace_id", "test", "true", "true"),
+			buildVarCharRow("id2", "test_hash_id2", "hash", "", "", "true", "true"),
 		},
 		RowsAffected: 3,
 	}
@@ -567,10 +1832,10 @@ func TestExecutorAddDropVindexDDL(t *testing.T) {
 			t.Fatalf("error in show vschema vindexes on TestExecutor.test: %v", err)
 		}
 		wantqr = &sqltypes.Result{
-			Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+			Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
 			Rows: [][]sqltypes.Value{
-				buildVarCharRow("id", "test_hash", "hash", "", ""),
-				buildVarCharRow("id2", "test_hash_id2", "hash", "", ""),
+				buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
+				buildVarCharRow("id2", "test_hash_id2", "hash", "", "", "true", "true"),
 			},
 			RowsAffected: 2,
 		}
@@ -630,87 +1895,430 @@ func TestExecutorAddDropVindexDDL(t *testing.T) {
 		t.Fatalf("table test2 not defined in vschema")
 	}
 
-	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes on TestExecutor.test2", nil)
-	if err != nil {
-		t.Fatalf("error in show vschema vindexes on TestExecutor.test2: %v", err)
-	}
-	wantqr = &sqltypes.Result{
-		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner"),
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes on TestExecutor.test2", nil)
+	if err != nil {
+		t.Fatalf("error in show vschema vindexes on TestExecutor.test2: %v", err)
+	}
+	wantqr = &sqltypes.Result{
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
+			buildVarCharRow("c1, c2", "test_lookup", "lookup", "from=c1,c2; table=test_lookup; to=keyspace_id", "test", "true", "true"),
+		},
+		RowsAffected: 2,
+	}
+	if !reflect.DeepEqual(qr, wantqr) {
+		t.Errorf("show vschema vindexes on TestExecutor.test:\n%+v, want\n%+v", qr, wantqr)
+	}
+
+	stmt = "alter vschema on test2 add vindex nonexistent (c1,c2)"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = "vindex nonexistent does not exist in keyspace TestExecutor"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on test2 add vindex test_hash (c1,c2) using lookup"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = "vindex test_hash defined with type hash not lookup"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on test2 add vindex test_lookup (c1,c2) using lookup with owner=xyz"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = "vindex test_lookup defined with owner test not xyz"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on test2 add vindex test_lookup (c1,c2) using lookup with owner=`test`, foo=bar"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = `vindex test_lookup defined with different parameters: foo (existing <unset>, provided "bar"), from (existing "c1,c2", provided <unset>), table (existing "test_lookup", provided <unset>), to (existing "keyspace_id", provided <unset>)`
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on nonexistent drop vindex test_lookup"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = "table TestExecutor.nonexistent not defined in vschema"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on nonexistent drop vindex test_lookup"
+	_, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: "InvalidKeyspace"}), stmt, nil)
+	wantErr = "table InvalidKeyspace.nonexistent not defined in vschema"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on nowhere.nohow drop vindex test_lookup"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = "table nowhere.nohow not defined in vschema"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	stmt = "alter vschema on test drop vindex test_lookup"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	wantErr = "vindex test_lookup not defined in table TestExecutor.test"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("got %v want err %s", err, wantErr)
+	}
+
+	// no queries should have gone to any tablets
+	wantCount := []int64{0, 0, 0}
+	gotCount := []int64{
+		sbc1.ExecCount.Get(),
+		sbc2.ExecCount.Get(),
+		sbclookup.ExecCount.Get(),
+	}
+	if !reflect.DeepEqual(gotCount, wantCount) {
+		t.Errorf("Exec %s: %v, want %v", "", gotCount, wantCount)
+	}
+}
+
+func TestExecutorPlanCacheVindexDrop(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	// waitForPrimaryVindex waits up to 100ms until the executor gets the
+	// vschema in which the primary vindex of test is bound to column. An
+	// empty column waits for test to have no vindex left.
+	waitForPrimaryVindex := func(column string) {
+		t.Helper()
+		for i := 0; i < 10; i++ {
+			table, err := executor.VSchema().FindTable(ks, "test")
+			if column == "" && table != nil && len(table.ColumnVindexes) == 0 {
+				return
+			}
+			if err == nil && table != nil && len(table.ColumnVindexes) > 0 && table.ColumnVindexes[0].Columns[0].EqualString(column) {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("primary vindex of test not bound to %s", column)
+	}
+	execute := func(sql string) {
+		t.Helper()
+		sbc1.Queries = nil
+		sbc2.Queries = nil
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		require.NoError(t, err)
+	}
+
+	execute("alter vschema on test add vindex test_hash (id) using hash")
+	waitForPrimaryVindex("id")
+
+	// The query is routed to the shard of id 1 through the vindex, and
+	// its plan is cached.
+	query := "select c1 from test where id = 1"
+	execute(query)
+	assert.Len(t, sbc1.Queries, 1)
+	assert.Empty(t, sbc2.Queries)
+	stale, err := newVCursorImpl(ctx, session, makeComments(""), executor, nil, executor.vm, executor.VSchema(), executor.resolver.resolver, nil)
+	require.NoError(t, err)
+
+	execute("alter vschema on test set scatter = true")
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Tables["test"].GetScatter()
+	})
+	execute("alter vschema on test drop vindex test_hash")
+	waitForPrimaryVindex("")
+	execute("alter vschema on test add vindex test_hash_c1 (c1) using hash")
+	waitForPrimaryVindex("c1")
+
+	// A plan built against the old vschema after the update is not
+	// cached either.
+	_, err = executor.getPlan(stale, query, makeComments(""), nil, false, nil)
+	require.NoError(t, err)
+	executor.plans.Wait()
+
+	// id is no longer a vindex column, so the query is scattered.
+	execute(query)
+	assert.Len(t, sbc1.Queries, 1)
+	assert.Len(t, sbc2.Queries, 1)
+}
+
+func TestExecutorAddVindexDeferredOwner(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "validate vschema", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+
+	stmt := "alter vschema on test add vindex test_hash (id) using hash"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_hash", vschemaUpdates, executor)
+
+	// The owner table doesn't exist yet.
+	stmt = "alter vschema on test add vindex test_lookup (c1) using lookup with owner=`test_owner`, from=`c1`, table=test_lookup, to=keyspace_id"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema, vindex := waitForVindex(t, ks, "test_lookup", vschemaUpdates, executor)
+	assert.Equal(t, "test_owner", vindex.Owner)
+	_, ok := vschema.Keyspaces[ks].Tables["test_owner"]
+	assert.False(t, ok)
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show warnings", nil)
+	require.NoError(t, err)
+	wantWarnings := [][]sqltypes.Value{{
+		sqltypes.NewVarChar("Warning"),
+		sqltypes.NewUint32(mysql.ERNoSuchTable),
+		sqltypes.NewVarChar("owner table test_owner of vindex test_lookup not defined in vschema"),
+	}}
+	assert.Equal(t, wantWarnings, qr.Rows)
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "validate vschema", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Vindex", "Error"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow(ks, "test_lookup", "owner table test_owner not defined in vschema"),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	// Create the owner table afterwards.
+	stmt = "alter vschema on test_owner add vindex test_hash (id)"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	wantTables := []string{"test_owner"}
+	for t := range vschema.Keyspaces[ks].Tables {
+		wantTables = append(wantTables, t)
+	}
+	_ = waitForVschemaTables(t, ks, wantTables, executor)
+
+	// The warning is cleared by the next non-SHOW statement.
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show warnings", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "validate vschema", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+}
+
+func TestExecutorAddVindexBackfillRequired(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	// The primary vindex of a new table doesn't need a backfill.
+	stmt := "alter vschema on test add vindex test_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_hash", vschemaUpdates, executor)
+
+	// A secondary vindex does, unless told otherwise.
+	stmt = "alter vschema on test add vindex test_lookup (c1) using lookup with owner=`test`, from=`c1`, table=test_lookup, to=keyspace_id"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_lookup", vschemaUpdates, executor)
+
+	stmt = "alter vschema on test add vindex test_hash_id2 (id2) using hash with backfill_required=false"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, vindex := waitForVindex(t, ks, "test_hash_id2", vschemaUpdates, executor)
+	assert.Empty(t, vindex.Params)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes on TestExecutor.test", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
+			buildVarCharRow("c1", "test_lookup", "lookup", "from=c1; table=test_lookup; to=keyspace_id", "test", "true", "true"),
+			buildVarCharRow("id2", "test_hash_id2", "hash", "", "", "false", "true"),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	stmt = "alter vschema on test add vindex test_hash_id3 (id3) using hash with backfill_required=maybe"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "invalid value for backfill_required: maybe")
+}
+
+func TestExecutorAddVindexBackfillSource(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test add vindex test_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_hash", vschemaUpdates, executor)
+
+	// The rows of the owner populate the lookup table.
+	stmt = "alter vschema on test add vindex test_lookup (c1, c2) using lookup with owner=`test`, from=`a,b`, table=test_lookup, to=keyspace_id"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema, _ := waitForVindex(t, ks, "test_lookup", vschemaUpdates, executor)
+	want := &vschemapb.BackfillSource{
+		Table:       "TestExecutor.test",
+		Columns:     []string{"c1", "c2"},
+		LookupTable: "test_lookup",
+		From:        []string{"a", "b"},
+		To:          "keyspace_id",
+	}
+	got := vschema.Keyspaces[ks].Tables["test"].ColumnVindexes[1].BackfillSource
+	assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+
+	// A lookup bound to a table that doesn't own it has nothing to
+	// backfill from that table.
+	stmt = "alter vschema on test add vindex other_lookup (c3) using lookup with owner=`other`, from=c, table=other_lookup, to=keyspace_id"
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	vschema, _ = waitForVindex(t, ks, "other_lookup", vschemaUpdates, executor)
+	assert.Nil(t, vschema.Keyspaces[ks].Tables["test"].ColumnVindexes[2].BackfillSource)
+
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Table", "Vindex", "Columns", "Lookup Table", "From", "To"),
 		Rows: [][]sqltypes.Value{
-			buildVarCharRow("id", "test_hash", "hash", "", ""),
-			buildVarCharRow("c1, c2", "test_lookup", "lookup", "from=c1,c2; table=test_lookup; to=keyspace_id", "test"),
+			buildVarCharRow("TestExecutor", "test", "test_lookup", "c1, c2", "test_lookup", "a, b", "keyspace_id"),
 		},
-		RowsAffected: 2,
 	}
-	if !reflect.DeepEqual(qr, wantqr) {
-		t.Errorf("show vschema vindexes on TestExecutor.test:\n%+v, want\n%+v", qr, wantqr)
+	for _, sql := range []string{"show vschema backfill on test", "show vschema backfill"} {
+		qr, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		require.NoError(t, err)
+		assert.Equal(t, wantqr, qr, sql)
 	}
 
-	stmt = "alter vschema on test2 add vindex nonexistent (c1,c2)"
-	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "vindex nonexistent does not exist in keyspace TestExecutor"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema backfill on nope", nil)
+	require.EqualError(t, err, "table `nope` does not exist in keyspace `TestExecutor`")
+}
 
-	stmt = "alter vschema on test2 add vindex test_hash (c1,c2) using lookup"
-	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "vindex test_hash defined with type hash not lookup"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+func TestExecutorAddVindexExpression(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
 
-	stmt = "alter vschema on test2 add vindex test_lookup (c1,c2) using lookup with owner=xyz"
-	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "vindex test_lookup defined with owner test not xyz"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+	stmt := "alter vschema on test add vindex test_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_hash", vschemaUpdates, executor)
 
-	stmt = "alter vschema on test2 add vindex test_lookup (c1,c2) using lookup with owner=`test`, foo=bar"
+	stmt = "alter vschema on test add vindex test_email_md5 (email_lower as (lower(email))) using unicode_loose_md5"
 	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "vindex test_lookup defined with different parameters"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
+	require.NoError(t, err)
+	vschema, _ := waitForVindex(t, ks, "test_email_md5", vschemaUpdates, executor)
+	assert.Equal(t, "lower(email)", vschema.Keyspaces[ks].Tables["test"].ColumnVindexes[1].Expression)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes on TestExecutor.test", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Columns", "Name", "Type", "Params", "Owner", "Backfill Required", "Enabled"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("id", "test_hash", "hash", "", "", "false", "true"),
+			buildVarCharRow("email_lower as (lower(email))", "test_email_md5", "unicode_loose_md5", "", "", "true", "true"),
+		},
 	}
+	assert.Equal(t, wantqr, qr)
 
-	stmt = "alter vschema on nonexistent drop vindex test_lookup"
+	stmt = "alter vschema on test add vindex test_name_md5 (name_hash as (md5(name))) using unicode_loose_md5"
 	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "table TestExecutor.nonexistent not defined in vschema"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+	require.EqualError(t, err, "vindex expression md5(`name`): function md5 is not supported")
+}
 
-	stmt = "alter vschema on nonexistent drop vindex test_lookup"
-	_, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: "InvalidKeyspace"}), stmt, nil)
-	wantErr = "table InvalidKeyspace.nonexistent not defined in vschema"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+func TestExecutorAddVindexPrimaryNotUnique(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
 
-	stmt = "alter vschema on nowhere.nohow drop vindex test_lookup"
+	stmt := "alter vschema on test_nonunique add vindex test_nonunique_lookup (c1) using lookup with table=test_nonunique_lookup, from=c1, to=keyspace_id"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "vindex test_nonunique_lookup is not unique and cannot be the primary vindex of table test_nonunique")
+
+	vschema := executor.vm.GetCurrentSrvVschema()
+	assert.NotContains(t, vschema.Keyspaces["TestExecutor"].Tables, "test_nonunique")
+	assert.NotContains(t, vschema.Keyspaces["TestExecutor"].Vindexes, "test_nonunique_lookup")
+}
+
+func TestExecutorAddVindexDifferentParams(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	stmt := "alter vschema on test add vindex test_hash (id) using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_hash", vschemaUpdates, executor)
+
+	stmt = "alter vschema on test add vindex test_lookup (c1,c2) using lookup with owner=`test`, from=`c1,c2`, table=test_lookup, to=keyspace_id"
 	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "table nowhere.nohow not defined in vschema"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+	require.NoError(t, err)
+	_, _ = waitForVindex(t, ks, "test_lookup", vschemaUpdates, executor)
 
-	stmt = "alter vschema on test drop vindex test_lookup"
+	stmt = "alter vschema on test2 add vindex test_lookup (c1,c2) using lookup with owner=`test`, from=`c1,c2`, table=test_lookup2, to=keyspace_id"
 	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
-	wantErr = "vindex test_lookup not defined in table TestExecutor.test"
-	if err == nil || err.Error() != wantErr {
-		t.Errorf("got %v want err %s", err, wantErr)
-	}
+	require.EqualError(t, err, `vindex test_lookup defined with different parameters: table (existing "test_lookup", provided "test_lookup2")`)
 
-	// no queries should have gone to any tablets
-	wantCount := []int64{0, 0, 0}
-	gotCount := []int64{
-		sbc1.ExecCount.Get(),
-		sbc2.ExecCount.Get(),
-		sbclookup.ExecCount.Get(),
-	}
-	if !reflect.DeepEqual(gotCount, wantCount) {
-		t.Errorf("Exec %s: %v, want %v", "", gotCount, wantCount)
+	select {
+	case <-vschemaUpdates:
+		t.Error("vschema should not be updated on error")
+	default:
 	}
 }
 
@@ -765,3 +2373,289 @@ func TestPlanExecutorVindexDDLACL(t *testing.T) {
 	// restore the disallowed state
 	*vschemaacl.AuthorizedDDLUsers = ""
 }
+
+func TestExecutorShowVSchemaACL(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "bob, alice"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "alice"})
+
+	// Users outside of the ACL can't see it.
+	ctxOther := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "mallory"})
+	_, err := executor.Execute(ctxOther, "TestExecute", session, "show vschema acl", nil)
+	require.EqualError(t, err, "not authorized to perform vschema operations")
+
+	qr, err := executor.Execute(ctx, "TestExecute", session, "show vschema acl", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Rule", "Value"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow("default_mode", "deny"),
+			buildVarCharRow("allow_all", "false"),
+			buildVarCharRow("user", "alice"),
+			buildVarCharRow("user", "bob"),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	// The command reports the ACL of the last reload.
+	*vschemaacl.AuthorizedDDLUsers = "carol, alice"
+	vschemaacl.Init()
+	qr, err = executor.Execute(ctx, "TestExecute", session, "show vschema acl", nil)
+	require.NoError(t, err)
+	wantqr.Rows = [][]sqltypes.Value{
+		buildVarCharRow("default_mode", "deny"),
+		buildVarCharRow("allow_all", "false"),
+		buildVarCharRow("user", "alice"),
+		buildVarCharRow("user", "carol"),
+	}
+	assert.Equal(t, wantqr, qr)
+}
+
+func TestExecutorShowVindexesFingerprint(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	*showVindexFingerprint = true
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		*showVindexFingerprint = false
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+
+	vschemaUpdates := make(chan *vschemapb.SrvVSchema, 4)
+	executor.serv.WatchSrvVSchema(context.Background(), "aa", func(vschema *vschemapb.SrvVSchema, err error) {
+		vschemaUpdates <- vschema
+	})
+	<-vschemaUpdates
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+	for name, stmt := range map[string]string{
+		"test_fp_a": "alter vschema create vindex test_fp_a using lookup with table=fp_lookup, from=c, to=keyspace_id",
+		"test_fp_b": "alter vschema create vindex test_fp_b using lookup with to=keyspace_id, table=fp_lookup, from=c",
+		"test_fp_c": "alter vschema create vindex test_fp_c using hash",
+	} {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		require.NoError(t, err)
+		_, _ = waitForVindex(t, ks, name, vschemaUpdates, executor)
+	}
+
+	want, err := vindexes.CreateVindex("lookup", "test_fp_a", map[string]string{"table": "fp_lookup", "from": "c", "to": "keyspace_id"})
+	require.NoError(t, err)
+	fingerprint := want.(vindexes.Fingerprinter).Fingerprint()
+	require.NotEmpty(t, fingerprint)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema vindexes like 'test_fp_%'", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner", "Fingerprint"),
+		Rows: [][]sqltypes.Value{
+			buildVarCharRow(ks, "test_fp_a", "lookup", "from=c; table=fp_lookup; to=keyspace_id", "", fingerprint),
+			buildVarCharRow(ks, "test_fp_b", "lookup", "from=c; table=fp_lookup; to=keyspace_id", "", fingerprint),
+			buildVarCharRow(ks, "test_fp_c", "hash", "", "", ""),
+		},
+	}
+	assert.Equal(t, wantqr, qr)
+}
+
+func TestExecutorVSchemaDefaultKeyspace(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{})
+
+	stmt := "alter vschema create vindex test_default_ks using hash"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.EqualError(t, err, "keyspace not specified")
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @@vschema_default_keyspace = 'TestExecutor'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, ks, session.GetVSchemaDefaultKeyspace())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Vindexes["test_default_ks"] != nil
+	})
+	assert.Equal(t, "", session.TargetString)
+
+	// The default keyspace is ignored for users who aren't authorized.
+	*vschemaacl.AuthorizedDDLUsers = "vschema_admin"
+	vschemaacl.Init()
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema create vindex test_default_ks2 using hash", nil)
+	require.EqualError(t, err, "keyspace not specified")
+}
+
+func TestExecutorSetScatterTable(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema on page_views set scatter = true", nil)
+	require.NoError(t, err)
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Keyspaces[ks].Tables["page_views"] != nil
+	})
+
+	// The table has no vindex, so the statement goes to all the shards.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select * from page_views where id = 1", nil)
+	require.NoError(t, err)
+	assert.Len(t, sbc1.Queries, 1)
+	assert.Len(t, sbc2.Queries, 1)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema on page_views set scatter = false", nil)
+	require.EqualError(t, err, "table TestExecutor.page_views has no vindexes, it has to stay scatter-routed")
+}
+
+func TestExecutorApplyVSchemaScript(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	script := "alter vschema create vindex script_vdx1 using hash; " +
+		"alter vschema drop vindex script_missing; " +
+		"alter vschema create vindex script_vdx2 using hash"
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema apply '"+script+"'", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 3)
+	assert.Equal(t, "ok", qr.Rows[0][1].ToString())
+	assert.Equal(t, "error", qr.Rows[1][1].ToString())
+	assert.Equal(t, "vindex script_missing does not exists in keyspace TestExecutor", qr.Rows[1][2].ToString())
+	assert.Equal(t, "alter vschema create vindex script_vdx2 using hash", qr.Rows[2][0].ToString())
+	assert.Equal(t, "ok", qr.Rows[2][1].ToString())
+
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		vindexes := vschema.Keyspaces[ks].Vindexes
+		return vindexes["script_vdx1"] != nil && vindexes["script_vdx2"] != nil
+	})
+}
+
+func TestExecutorSetVSchemaLabel(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema set label = '2024-06-release-3'", nil)
+	require.NoError(t, err)
+	waitForVSchema(t, executor, func(vschema *vschemapb.SrvVSchema) bool {
+		return vschema.Label != ""
+	})
+
+	// The numeric version is the one of the SrvVSchema in the topo.
+	ts, err := executor.serv.GetTopoServer()
+	require.NoError(t, err)
+	_, version, err := ts.GetSrvVSchemaWithVersion(context.Background(), "aa")
+	require.NoError(t, err)
+	_, err = strconv.ParseUint(version.String(), 10, 64)
+	require.NoError(t, err)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "show vschema version", nil)
+	require.NoError(t, err)
+	wantqr := &sqltypes.Result{
+		Fields: buildVarCharFields("Version", "Label"),
+		Rows:   [][]sqltypes.Value{buildVarCharRow(version.String(), "2024-06-release-3")},
+	}
+	assert.Equal(t, wantqr, qr)
+
+	// Any update of the vschema changes the version, not the label.
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "alter vschema on test_label add vindex test_label_hash (id) using hash", nil)
+	require.NoError(t, err)
+	_ = waitForColVindexes(t, "TestExecutor", "test_label", []string{"test_label_hash"}, executor)
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "show vschema version", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.NotEqual(t, version.String(), qr.Rows[0][0].ToString())
+	assert.Equal(t, "2024-06-release-3", qr.Rows[0][1].ToString())
+}
+
+func TestExecutorValidateVSchemaRouting(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	defer func() {
+		getSandbox("TestExecutor").ShardSpec = DefaultShardSpec
+	}()
+	ks := "TestExecutor"
+	session := NewSafeSession(&vtgatepb.Session{TargetString: ks})
+
+	// The null vindex maps every row to keyspace id 00.
+	stmt := "alter vschema on test_null add vindex test_null_vdx (id) using `null`"
+	_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+	require.NoError(t, err)
+	_ = waitForColVindexes(t, ks, "test_null", []string{"test_null_vdx"}, executor)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "validate vschema routing TestExecutor", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+
+	// Keyspace id 00 is covered by a shard, so only the vindexes that
+	// can't list their destinations are reported.
+	getSandbox("TestExecutor").ShardSpec = "-20-40"
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "validate vschema routing TestExecutor", nil)
+	require.NoError(t, err)
+	assert.Equal(t, buildVarCharFields("Table", "Vindex", "Gap", "Error"), qr.Fields)
+	assert.Contains(t, qr.Rows, buildVarCharRow("user", "hash_index", "", "cannot check: vindex hash_index cannot enumerate its destinations"))
+	for _, row := range qr.Rows {
+		assert.NotEqual(t, "test_null", row[0].ToString())
+		assert.Contains(t, row[3].ToString(), "cannot check: ")
+	}
+
+	// No shard covers keyspace id 00 anymore.
+	getSandbox("TestExecutor").ShardSpec = "20-80-"
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "validate vschema routing TestExecutor", nil)
+	require.NoError(t, err)
+	assert.Contains(t, qr.Rows, buildVarCharRow("test_null", "test_null_vdx", "-20", "keyspace id 00 is not covered by any shard"))
+	assert.Contains(t, qr.Rows, buildVarCharRow("user", "hash_index", "", "cannot check: vindex hash_index cannot enumerate its destinations"))
+
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "validate vschema routing TestUnsharded", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "validate vschema routing no_such_ks", nil)
+	require.EqualError(t, err, "keyspace no_such_ks not found in vschema")
+}
+
+func TestKeyRangeGaps(t *testing.T) {
+	shards := func(specs ...string) []*topodatapb.ShardReference {
+		var refs []*topodatapb.ShardReference
+		for _, spec := range specs {
+			kr, err := key.ParseShardingSpec(spec)
+			require.NoError(t, err)
+			refs = append(refs, &topodatapb.ShardReference{Name: spec, KeyRange: kr[0]})
+		}
+		return refs
+	}
+	gapsString := func(gaps []*topodatapb.KeyRange) []string {
+		var strs []string
+		for _, gap := range gaps {
+			strs = append(strs, key.KeyRangeString(gap))
+		}
+		return strs
+	}
+
+	assert.Empty(t, keyRangeGaps(shards("-80", "80-")))
+	assert.Empty(t, keyRangeGaps(shards("80-", "-40", "40-80")))
+	assert.Equal(t, []string{"40-60"}, gapsString(keyRangeGaps(shards("-40", "60-"))))
+	assert.Equal(t, []string{"-20", "80-"}, gapsString(keyRangeGaps(shards("20-80"))))
+	assert.Equal(t, []string{"-"}, gapsString(keyRangeGaps(nil)))
+}
@@ -53,6 +53,7 @@ type LogStats struct {
 	PlanTime      time.Duration
 	ExecuteTime   time.Duration
 	CommitTime    time.Duration
+	ShardTimes    map[string]time.Duration
 	Error         error
 }
 
@@ -74,6 +75,15 @@ func (stats *LogStats) Send() {
 	QueryLogger.Send(stats)
 }
 
+// RecordShardTime records elapsed, the time a statement took on shard.
+// It must not be called concurrently.
+func (stats *LogStats) RecordShardTime(shard string, elapsed time.Duration) {
+	if stats.ShardTimes == nil {
+		stats.ShardTimes = make(map[string]time.Duration)
+	}
+	stats.ShardTimes[shard] = elapsed
+}
+
 // Context returns the context used by LogStats.
 func (stats *LogStats) Context() context.Context {
 	return stats.Ctx
@@ -18,6 +18,9 @@ package vtgate
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/mysql"
@@ -181,6 +184,26 @@ func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *
 		errCount := e.logExecutionEnd(logStats, execStart, plan, err, qr)
 		plan.AddStats(1, time.Since(logStats.StartTime), uint64(logStats.ShardQueries), logStats.RowsAffected, logStats.RowsReturned, errCount)
 
+		if err == nil && plan.Type == sqlparser.StmtDDL {
+			if *ddlKindInfo {
+				safeSession.RecordWarning(&querypb.QueryWarning{
+					Message: "ddl kind: " + ddlKind(plan),
+				})
+			}
+			if *ddlQueryInfo {
+				if query, ok := ddlQuery(plan); ok {
+					safeSession.RecordWarning(&querypb.QueryWarning{
+						Message: "ddl query: " + vcursor.marginComments.Leading + query + vcursor.marginComments.Trailing,
+					})
+				}
+			}
+			if *ddlTimingInfo {
+				safeSession.RecordWarning(&querypb.QueryWarning{
+					Message: ddlTiming(logStats),
+				})
+			}
+		}
+
 		// Check if there was partial DML execution. If so, rollback the transaction.
 		if err != nil && safeSession.InTransaction() && vcursor.rollbackOnPartialExec {
 			_ = e.txConn.Rollback(ctx, safeSession)
@@ -190,6 +213,48 @@ func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *
 	}
 }
 
+// These are the kinds of DDL reported by -ddl_kind_info.
+const (
+	ddlKindVSchema = "vschema"
+	ddlKindShard   = "shard"
+)
+
+// ddlKind tells whether a DDL plan changes the vschema or is sent to
+// the shards.
+func ddlKind(plan *engine.Plan) string {
+	if _, ok := plan.Instructions.(*engine.AlterVSchema); ok {
+		return ddlKindVSchema
+	}
+	return ddlKindShard
+}
+
+// ddlTiming describes the total elapsed time of a DDL and the elapsed
+// time on every shard it was sent to.
+func ddlTiming(logStats *LogStats) string {
+	shards := make([]string, 0, len(logStats.ShardTimes))
+	for shard := range logStats.ShardTimes {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	timing := []string{fmt.Sprintf("total %v", logStats.ExecuteTime)}
+	for _, shard := range shards {
+		timing = append(timing, fmt.Sprintf("%s: %v", shard, logStats.ShardTimes[shard]))
+	}
+	return "ddl timing: " + strings.Join(timing, ", ")
+}
+
+// ddlQuery returns the statement a DDL plan sends to the shards, without
+// the margin comments. Vschema DDL is not sent anywhere.
+func ddlQuery(plan *engine.Plan) (string, bool) {
+	switch primitive := plan.Instructions.(type) {
+	case *engine.DDL:
+		return primitive.SQL, true
+	case *engine.Send:
+		return primitive.Query, true
+	}
+	return "", false
+}
+
 func (e *Executor) logExecutionEnd(logStats *LogStats, execStart time.Time, plan *engine.Plan, err error, qr *sqltypes.Result) uint64 {
 	logStats.ExecuteTime = time.Since(execStart)
 
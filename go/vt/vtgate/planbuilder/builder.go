@@ -54,6 +54,7 @@ type ContextVSchema interface {
 	AllKeyspace() ([]*vindexes.Keyspace, error)
 	GetSemTable() *semantics.SemTable
 	Planner() PlannerVersion
+	VSchemaDDLDefaultKeyspace() string
 }
 
 // PlannerVersion is an alias here to make the code more readable
@@ -250,7 +251,11 @@ func buildLoadPlan(query string, vschema ContextVSchema) (engine.Primitive, erro
 }
 
 func buildVSchemaDDLPlan(stmt *sqlparser.AlterVschema, vschema ContextVSchema) (engine.Primitive, error) {
-	_, keyspace, _, err := vschema.TargetDestination(stmt.Table.Qualifier.String())
+	qualifier := stmt.Table.Qualifier.String()
+	if qualifier == "" {
+		qualifier = vschema.VSchemaDDLDefaultKeyspace()
+	}
+	_, keyspace, _, err := vschema.TargetDestination(qualifier)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +96,12 @@ func buildDDLPlans(sql string, ddlStatement sqlparser.DDLStatement, vschema Cont
 	if destination == nil {
 		destination = key.DestinationAllShards{}
 	}
+	// A key range target, like `ks[20-60]`, sends the DDL to every shard
+	// that overlaps the range. The range doesn't have to start or end on
+	// shard boundaries.
+	if kr, ok := destination.(key.DestinationExactKeyRange); ok {
+		destination = key.DestinationKeyRange{KeyRange: kr.KeyRange}
+	}
 
 	query := sql
 	// If the query is fully parsed, generate the query from the ast. Otherwise, use the original query
@@ -137,10 +143,27 @@ func findTableDestinationAndKeyspace(vschema ContextVSchema, ddlStatement sqlpar
 	} else {
 		keyspace = table.Keyspace
 		ddlStatement.SetTable("", table.Name.String())
+		if destination == nil {
+			destination = sessionDestination(vschema, keyspace)
+		}
 	}
 	return destination, keyspace, nil
 }
 
+// sessionDestination returns the destination targeted by the session, as
+// set by USE `ks/-80` or USE `ks:-80`, if the session targets the given
+// keyspace. Otherwise, it returns nil.
+func sessionDestination(vschema ContextVSchema, keyspace *vindexes.Keyspace) key.Destination {
+	if vschema.Destination() == nil {
+		return nil
+	}
+	ks, err := vschema.DefaultKeyspace()
+	if err != nil || ks.Name != keyspace.Name {
+		return nil
+	}
+	return vschema.Destination()
+}
+
 func buildAlterView(vschema ContextVSchema, ddl *sqlparser.AlterView) (key.Destination, *vindexes.Keyspace, error) {
 	// For Alter View, we require that the view exist and the select query can be satisfied within the keyspace itself
 	// We should remove the keyspace name from the table name, as the database name in MySQL might be different than the keyspace name
@@ -241,6 +264,9 @@ func buildDropViewOrTable(vschema ContextVSchema, ddlStatement sqlparser.DDLStat
 			ddlStatement.GetFromTables()[i] = sqlparser.TableName{
 				Name: table.Name,
 			}
+			if destinationTab == nil {
+				destinationTab = sessionDestination(vschema, keyspaceTab)
+			}
 		}
 
 		if destination == nil && keyspace == nil {
@@ -284,6 +310,9 @@ func buildRenameTable(vschema ContextVSchema, renameTable *sqlparser.RenameTable
 			tabPair.FromTable = sqlparser.TableName{
 				Name: table.Name,
 			}
+			if destinationFrom == nil {
+				destinationFrom = sessionDestination(vschema, keyspaceFrom)
+			}
 		}
 
 		if tabPair.ToTable.Qualifier.String() != "" {
@@ -32,7 +32,7 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpc
 	var ksidVindex vindexes.SingleColumn
 	var ksidCol string
 	for _, index := range table.Ordered {
-		if !index.Vindex.IsUnique() {
+		if !index.Vindex.IsUnique() || index.Disabled {
 			continue
 		}
 		single, ok := index.Vindex.(vindexes.SingleColumn)
@@ -47,7 +47,7 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpc
 			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
 		}
 
-		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
+		if pv, ok := getMatch(where.Expr, index); ok {
 			opcode := engine.Equal
 			if pv.IsList() {
 				opcode = engine.In
@@ -56,22 +56,25 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpc
 		}
 	}
 	if ksidVindex == nil {
+		if table.Scatter {
+			return engine.Scatter, nil, "", nil, nil, nil
+		}
 		return engine.Scatter, nil, "", nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
 	}
 	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
 }
 
 // getMatch returns the matched value if there is an equality
-// constraint on the specified column that can be used to
-// decide on a route.
-func getMatch(node sqlparser.Expr, col sqlparser.ColIdent) (pv sqltypes.PlanValue, ok bool) {
+// constraint on the column of the vindex, or on its expression, that
+// can be used to decide on a route.
+func getMatch(node sqlparser.Expr, colVindex *vindexes.ColumnVindex) (pv sqltypes.PlanValue, ok bool) {
 	filters := splitAndExpression(nil, node)
 	for _, filter := range filters {
 		comparison, ok := filter.(*sqlparser.ComparisonExpr)
 		if !ok {
 			continue
 		}
-		if !nameMatch(comparison.Left, col) {
+		if !nameMatch(comparison.Left, colVindex.Columns[0]) && !exprMatch(comparison.Left, colVindex) {
 			continue
 		}
 		switch comparison.Operator {
@@ -100,6 +103,10 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
+func exprMatch(node sqlparser.Expr, colVindex *vindexes.ColumnVindex) bool {
+	return colVindex.Expression != nil && vindexes.MatchVindexExpression(node, colVindex.Expression)
+}
+
 func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
 	edml := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
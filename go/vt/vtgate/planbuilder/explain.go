@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -26,6 +28,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 // Builds an explain-plan for the given Primitive
@@ -33,7 +36,18 @@ func buildExplainPlan(stmt sqlparser.Explain, vschema ContextVSchema) (engine.Pr
 	switch explain := stmt.(type) {
 	case *sqlparser.ExplainTab:
 		return explainTabPlan(explain, vschema)
+	case *sqlparser.ExplainRouting:
+		return explainRoutingPlan(explain, vschema)
+	case *sqlparser.ExplainShards:
+		return explainShardsPlan(explain, vschema)
+	case *sqlparser.ExplainVindex:
+		return explainVindexPlan(explain, vschema)
+	case *sqlparser.ExplainVSchema:
+		return explainVSchemaPlan(explain, vschema)
 	case *sqlparser.ExplainStmt:
+		if ddl, ok := explain.Statement.(sqlparser.DDLStatement); ok {
+			return explainDDLPlan(ddl, vschema)
+		}
 		if explain.Type == sqlparser.VitessType {
 			return buildVitessTypePlan(explain, vschema)
 		}
@@ -61,6 +75,255 @@ func explainTabPlan(explain *sqlparser.ExplainTab, vschema ContextVSchema) (engi
 	}, nil
 }
 
+func explainRoutingPlan(explain *sqlparser.ExplainRouting, vschema ContextVSchema) (engine.Primitive, error) {
+	table, vindex, err := explainPrimaryVindex(explain.Table, vschema)
+	if err != nil {
+		return nil, err
+	}
+	values, err := sqlparser.NewPlanValue(explain.Values)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ExplainRouting{
+		Keyspace: table.Keyspace,
+		Table:    table.Name.String(),
+		Vindex:   vindex,
+		Values:   values,
+	}, nil
+}
+
+func explainShardsPlan(explain *sqlparser.ExplainShards, vschema ContextVSchema) (engine.Primitive, error) {
+	table, vindex, err := explainPrimaryVindex(explain.Table, vschema)
+	if err != nil {
+		return nil, err
+	}
+	column := table.ColumnVindexes[0].Columns[0]
+	unsupported := vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: explain shards only supports an equality or IN predicate on the primary vindex column %s", column.String())
+
+	cmp, ok := explain.Where.Expr.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return nil, unsupported
+	}
+	col, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok || !col.Name.Equal(column) {
+		return nil, unsupported
+	}
+	var tuple sqlparser.ValTuple
+	switch cmp.Operator {
+	case sqlparser.EqualOp:
+		tuple = sqlparser.ValTuple{cmp.Right}
+	case sqlparser.InOp:
+		if tuple, ok = cmp.Right.(sqlparser.ValTuple); !ok {
+			return nil, unsupported
+		}
+	default:
+		return nil, unsupported
+	}
+	values, err := sqlparser.NewPlanValue(tuple)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ExplainRouting{
+		Keyspace:   table.Keyspace,
+		Table:      table.Name.String(),
+		Vindex:     vindex,
+		Values:     values,
+		ShardsOnly: true,
+	}, nil
+}
+
+// explainVindexPlan plans a select on the table with the predicate of
+// the statement, and reports for every column vindex of the table
+// whether the planner selected it to route the query, and why. Nothing
+// is executed.
+func explainVindexPlan(explain *sqlparser.ExplainVindex, vschema ContextVSchema) (engine.Primitive, error) {
+	table, _, _, _, err := vschema.FindTable(explain.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !table.Keyspace.Sharded {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s is in unsharded keyspace %s, which has no vindexes", sqlparser.String(explain.Table), table.Keyspace.Name)
+	}
+
+	// The select is parsed from its text so that planning it leaves the
+	// statement untouched.
+	query := sqlparser.String(&sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+		From:        sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: explain.Table}},
+		Where:       explain.Where,
+	})
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := createInstructionFor(query, stmt, vschema)
+	if err != nil {
+		return nil, err
+	}
+	route, ok := plan.(*engine.Route)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: explain vindex for a query planned as %s", plan.RouteType())
+	}
+
+	// The planner only considers the cheapest vindex bound to a column.
+	cheapest := make(map[string]*vindexes.ColumnVindex)
+	var selected *vindexes.ColumnVindex
+	for _, colVindex := range table.ColumnVindexes {
+		if route.Vindex != nil && colVindex.Vindex == vindexes.Vindex(route.Vindex) {
+			selected = colVindex
+		}
+		if !isSingleColumn(colVindex) || colVindex.Disabled {
+			continue
+		}
+		column := colVindex.Columns[0].Lowered()
+		if c := cheapest[column]; c == nil || c.Vindex.Cost() > colVindex.Vindex.Cost() {
+			cheapest[column] = colVindex
+		}
+	}
+	predicates := sqlparser.SplitAndExpression(nil, explain.Where.Expr)
+
+	rows := make([][]sqltypes.Value, 0, len(table.ColumnVindexes))
+	for _, colVindex := range table.ColumnVindexes {
+		columns := make([]string, len(colVindex.Columns))
+		for i, col := range colVindex.Columns {
+			columns[i] = col.String()
+		}
+		var reason string
+		switch column := colVindex.Columns[0]; {
+		case colVindex == selected:
+			reason = "selected for " + route.RouteType()
+		case colVindex.Disabled:
+			reason = "disabled"
+		case !isSingleColumn(colVindex):
+			reason = "multi-column vindexes are not used to route queries"
+		case !hasVindexPredicate(predicates, column):
+			reason = fmt.Sprintf("no equality, IN or IS NULL predicate on %s", column.String())
+		case cheapest[column.Lowered()] != colVindex:
+			reason = fmt.Sprintf("vindex %s on column %s is cheaper", cheapest[column.Lowered()].Name, column.String())
+		case selected == nil:
+			reason = "predicate cannot be routed by a vindex"
+		case selected.Vindex.IsUnique() && !colVindex.Vindex.IsUnique():
+			reason = fmt.Sprintf("not unique, unlike the selected vindex %s", selected.Name)
+		default:
+			reason = fmt.Sprintf("cost %d is not lower than the cost %d of the selected vindex %s", colVindex.Vindex.Cost(), selected.Vindex.Cost(), selected.Name)
+		}
+		rows = append(rows, buildVarCharRow(
+			colVindex.Name,
+			strings.Join(columns, ", "),
+			colVindex.Type,
+			strconv.Itoa(colVindex.Vindex.Cost()),
+			strconv.FormatBool(colVindex.Vindex.IsUnique()),
+			strconv.FormatBool(colVindex == selected),
+			reason,
+		))
+	}
+	return engine.NewRowsPrimitive(rows, buildVarCharFields("Vindex", "Columns", "Type", "Cost", "Unique", "Selected", "Reason")), nil
+}
+
+func isSingleColumn(colVindex *vindexes.ColumnVindex) bool {
+	_, ok := colVindex.Vindex.(vindexes.SingleColumn)
+	return ok
+}
+
+// hasVindexPredicate returns true if one of the predicates is of a kind
+// the planner can route on a vindex of the column: an equality, an IN
+// or an IS NULL.
+func hasVindexPredicate(predicates []sqlparser.Expr, column sqlparser.ColIdent) bool {
+	isColumn := func(expr sqlparser.Expr) bool {
+		col, ok := expr.(*sqlparser.ColName)
+		return ok && col.Name.Equal(column)
+	}
+	for _, predicate := range predicates {
+		switch predicate := predicate.(type) {
+		case *sqlparser.ComparisonExpr:
+			switch predicate.Operator {
+			case sqlparser.EqualOp:
+				if isColumn(predicate.Left) || isColumn(predicate.Right) {
+					return true
+				}
+			case sqlparser.InOp:
+				if isColumn(predicate.Left) {
+					return true
+				}
+			}
+		case *sqlparser.IsExpr:
+			if predicate.Operator == sqlparser.IsNullOp && isColumn(predicate.Expr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// explainDDLPlan plans the DDL like it would be executed, and reports
+// where its query would be sent instead of sending it.
+func explainDDLPlan(ddl sqlparser.DDLStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	plan, err := buildGeneralDDLPlan(sqlparser.String(ddl), ddl, vschema)
+	if err != nil {
+		return nil, err
+	}
+	send := plan.(*engine.DDL).NormalDDL
+	object := "table"
+	switch ddl.(type) {
+	case *sqlparser.CreateView, *sqlparser.AlterView, *sqlparser.DropView:
+		object = "view"
+	}
+	return &engine.ExplainDDL{
+		Keyspace:          send.Keyspace,
+		TargetDestination: send.TargetDestination,
+		StatementType:     ddl.GetAction().ToString() + " " + object,
+		Query:             send.Query,
+	}, nil
+}
+
+// explainVSchemaPlan describes the vindexes and the auto increment of a
+// table in a sharded keyspace. The first vindex is the primary one.
+func explainVSchemaPlan(explain *sqlparser.ExplainVSchema, vschema ContextVSchema) (engine.Primitive, error) {
+	table, _, _, _, err := vschema.FindTable(explain.Table)
+	if err != nil {
+		return nil, err
+	}
+	if !table.Keyspace.Sharded {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s is in unsharded keyspace %s, which has no vindexes", sqlparser.String(explain.Table), table.Keyspace.Name)
+	}
+
+	rows := make([][]sqltypes.Value, 0, len(table.ColumnVindexes)+1)
+	for i, colVindex := range table.ColumnVindexes {
+		columns := make([]string, len(colVindex.Columns))
+		for j, col := range colVindex.Columns {
+			columns[j] = col.String()
+		}
+		rows = append(rows, buildVarCharRow("vindex", colVindex.Name, strings.Join(columns, ", "), colVindex.Type, strconv.FormatBool(i == 0)))
+	}
+	if autoInc := table.AutoIncrement; autoInc != nil {
+		sequence := autoInc.Sequence.Keyspace.Name + "." + autoInc.Sequence.Name.String()
+		rows = append(rows, buildVarCharRow("auto_increment", sequence, autoInc.Column.String(), vindexes.TypeSequence, ""))
+	}
+	return engine.NewRowsPrimitive(rows, buildVarCharFields("Kind", "Name", "Columns", "Type", "Primary")), nil
+}
+
+// explainPrimaryVindex returns the table and its primary vindex for the
+// explain routing statements, which only support vindexes that can map
+// values without querying the tablets.
+func explainPrimaryVindex(tableName sqlparser.TableName, vschema ContextVSchema) (*vindexes.Table, vindexes.SingleColumn, error) {
+	table, _, _, _, err := vschema.FindTable(tableName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !table.Keyspace.Sharded || len(table.ColumnVindexes) == 0 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s has no primary vindex", sqlparser.String(tableName))
+	}
+	primary := table.ColumnVindexes[0]
+	single, ok := primary.Vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: explain routing for multi-column vindex %s", primary.Name)
+	}
+	if single.NeedsVCursor() {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: explain routing for vindex %s that needs to query tablets", primary.Name)
+	}
+	return table, single, nil
+}
+
 func buildVitessTypePlan(explain *sqlparser.ExplainStmt, vschema ContextVSchema) (engine.Primitive, error) {
 	innerInstruction, err := createInstructionFor(sqlparser.String(explain.Statement), explain.Statement, vschema)
 	if err != nil {
@@ -110,6 +110,9 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 }
 
 func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+	if len(table.ColumnVindexes) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: insert into scatter-routed table %s without a vindex", table.Name.String())
+	}
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -162,10 +165,21 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	}
 
 	// Fill out the 3-d Values structure. Please see documentation of Insert.Values for details.
+	// The column of a vindex on an expression is generated by MySQL, so
+	// the values of the columns the expression reads are used instead.
 	routeValues := make([]sqltypes.PlanValue, len(eins.Table.ColumnVindexes))
 	for vIdx, colVindex := range eins.Table.ColumnVindexes {
-		routeValues[vIdx].Values = make([]sqltypes.PlanValue, len(colVindex.Columns))
-		for colIdx, col := range colVindex.Columns {
+		cols := colVindex.Columns
+		if colVindex.Expression != nil {
+			for _, col := range colVindex.Columns {
+				if ins.Columns.FindColumn(col) >= 0 {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column %s of vindex %s is generated and cannot be inserted", col.String(), colVindex.Name)
+				}
+			}
+			cols = vindexes.VindexExpressionColumns(colVindex.Expression)
+		}
+		routeValues[vIdx].Values = make([]sqltypes.PlanValue, len(cols))
+		for colIdx, col := range cols {
 			routeValues[vIdx].Values[colIdx].Values = make([]sqltypes.PlanValue, len(rows))
 			colNum := findOrAddColumn(ins, col)
 			for rowNum, row := range rows {
@@ -178,6 +192,9 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 		}
 	}
 	for _, colVindex := range eins.Table.ColumnVindexes {
+		if colVindex.Expression != nil {
+			continue
+		}
 		for _, col := range colVindex.Columns {
 			colNum := findOrAddColumn(ins, col)
 			for rowNum, row := range rows {
@@ -266,7 +283,11 @@ func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 func isVindexChanging(setClauses sqlparser.UpdateExprs, colVindexes []*vindexes.ColumnVindex) bool {
 	for _, assignment := range setClauses {
 		for _, vcol := range colVindexes {
-			for _, col := range vcol.Columns {
+			cols := vcol.Columns
+			if vcol.Expression != nil {
+				cols = vindexes.VindexExpressionColumns(vcol.Expression)
+			}
+			for _, col := range cols {
 				if col.Equal(assignment.Name.Name) {
 					valueExpr, isValuesFuncExpr := assignment.Expr.(*sqlparser.ValuesFuncExpr)
 					if !isValuesFuncExpr {
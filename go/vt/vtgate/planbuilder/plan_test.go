@@ -205,6 +205,40 @@ func TestSysVarSetDisabled(t *testing.T) {
 	testFile(t, "set_sysvar_disabled_cases.txt", testOutputTempDir, vschemaWrapper, false)
 }
 
+func TestPlanScatterTable(t *testing.T) {
+	ks := &vindexes.Keyspace{Name: "sharded", Sharded: true}
+	vschema := &vschemaWrapper{
+		v: &vindexes.VSchema{
+			Keyspaces: map[string]*vindexes.KeyspaceSchema{
+				"sharded": {
+					Keyspace: ks,
+					Tables: map[string]*vindexes.Table{
+						"analytics": {Name: sqlparser.NewTableIdent("analytics"), Keyspace: ks, Scatter: true},
+						"unmarked":  {Name: sqlparser.NewTableIdent("unmarked"), Keyspace: ks},
+					},
+				},
+			},
+		},
+		version: V3,
+	}
+
+	for _, query := range []string{
+		"select * from sharded.analytics where id = 1",
+		"update sharded.analytics set a = 1 where id = 1",
+		"delete from sharded.analytics where id = 1",
+	} {
+		plan, err := TestBuilder(query, vschema)
+		require.NoError(t, err, query)
+		require.Contains(t, plan.Instructions.RouteType(), "Scatter", query)
+	}
+
+	_, err := TestBuilder("insert into sharded.analytics(id) values (1)", vschema)
+	require.EqualError(t, err, "unsupported: insert into scatter-routed table analytics without a vindex")
+
+	_, err = TestBuilder("delete from sharded.unmarked where id = 1", vschema)
+	require.EqualError(t, err, "table without a primary vindex is not expected")
+}
+
 func TestOne(t *testing.T) {
 	vschema := &vschemaWrapper{
 		v: loadSchema(t, "schema_test.json"),
@@ -322,6 +356,10 @@ func (vw *vschemaWrapper) SysVarSetEnabled() bool {
 	return vw.sysVarEnabled
 }
 
+func (vw *vschemaWrapper) VSchemaDDLDefaultKeyspace() string {
+	return ""
+}
+
 func (vw *vschemaWrapper) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
 	var keyspaceName string
 	if vw.keyspace != nil {
@@ -567,7 +567,7 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 	switch rb.eroute.Opcode {
 	case engine.SelectEqualUnique:
-		if opcode == engine.SelectEqualUnique && vindex.Cost() < rb.eroute.Vindex.Cost() {
+		if opcode == engine.SelectEqualUnique && vindexes.Cheaper(vindex, rb.eroute.Vindex) {
 			rb.updateRoute(opcode, vindex, values)
 		}
 	case engine.SelectEqual:
@@ -575,7 +575,7 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		case engine.SelectEqualUnique:
 			rb.updateRoute(opcode, vindex, values)
 		case engine.SelectEqual:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
+			if vindexes.Cheaper(vindex, rb.eroute.Vindex) {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
@@ -584,7 +584,7 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		case engine.SelectEqualUnique, engine.SelectEqual:
 			rb.updateRoute(opcode, vindex, values)
 		case engine.SelectIN:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
+			if vindexes.Cheaper(vindex, rb.eroute.Vindex) {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
@@ -593,7 +593,7 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN:
 			rb.updateRoute(opcode, vindex, values)
 		case engine.SelectMultiEqual:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
+			if vindexes.Cheaper(vindex, rb.eroute.Vindex) {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
@@ -676,7 +676,7 @@ func (rb *route) computeISPlan(pb *primitiveBuilder, comparison *sqlparser.IsExp
 // computeINPlan computes the plan for an IN constraint.
 func (rb *route) computeINPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, expr sqlparser.Expr) {
 	switch comparison.Left.(type) {
-	case *sqlparser.ColName:
+	case *sqlparser.ColName, *sqlparser.FuncExpr:
 		return rb.computeSimpleINPlan(pb, comparison)
 	case sqlparser.ValTuple:
 		return rb.computeCompositeINPlan(pb, comparison)
@@ -782,7 +782,7 @@ func bestOfComposite(opcode1, opcode2 engine.RouteOpcode, vindex1, vindex2 vinde
 	if opcode2 == engine.SelectScatter {
 		return opcode1, vindex1, values1
 	}
-	if vindex1.Cost() < vindex2.Cost() {
+	if vindexes.Cheaper(vindex1, vindex2) {
 		return opcode1, vindex1, values1
 	}
 	return opcode2, vindex2, values2
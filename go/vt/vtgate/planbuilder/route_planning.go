@@ -46,6 +46,14 @@ func gen4Planner(_ string) func(sqlparser.Statement, ContextVSchema) (engine.Pri
 }
 
 func newBuildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	p, err := handleRecentQueriesSelect(sel)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		return p, nil
+	}
+
 	semTable, err := semantics.Analyse(sel) // TODO no nil no
 	if err != nil {
 		return nil, err
@@ -325,7 +333,7 @@ func (rp *routePlan) pickBestAvailableVindex() {
 			continue
 		}
 		// Choose the minimum cost vindex from the ones which are covered
-		if rp.vindex == nil || v.vindex.Vindex.Cost() < rp.vindex.Cost() {
+		if rp.vindex == nil || vindexes.Cheaper(v.vindex.Vindex, rp.vindex) {
 			rp.vindex = v.vindex.Vindex
 			rp.vindexValues = v.values
 		}
@@ -630,6 +638,9 @@ func createRoutePlan(table *queryTable, solves semantics.TableSet, vschema Conte
 	}
 
 	for _, columnVindex := range vschemaTable.ColumnVindexes {
+		if columnVindex.Disabled {
+			continue
+		}
 		plan.vindexPreds = append(plan.vindexPreds, &vindexPlusPredicates{vindex: columnVindex})
 	}
 
@@ -667,7 +678,7 @@ func findColumnVindex(a *routePlan, exp sqlparser.Expr, sem *semantics.SemTable)
 		if leftDep.IsSolvedBy(table.qtable.tableID) {
 			for _, vindex := range table.vtable.ColumnVindexes {
 				singCol, isSingle := vindex.Vindex.(vindexes.SingleColumn)
-				if isSingle && vindex.Columns[0].Equal(left.Name) {
+				if isSingle && !vindex.Disabled && vindex.Columns[0].Equal(left.Name) {
 					return singCol
 				}
 			}
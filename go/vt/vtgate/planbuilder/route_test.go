@@ -132,3 +132,24 @@ func TestUnionCanMerge(t *testing.T) {
 		}
 	}
 }
+
+// selectiveIndex is a non-unique Vindex that reports its selectivity.
+type selectiveIndex struct {
+	hashIndex
+	selectivity float64
+}
+
+func (*selectiveIndex) IsUnique() bool         { return false }
+func (v *selectiveIndex) Selectivity() float64 { return v.selectivity }
+
+func TestBestOfCompositeSelectivity(t *testing.T) {
+	low := &selectiveIndex{hashIndex: hashIndex{name: "low"}, selectivity: 2}
+	high := &selectiveIndex{hashIndex: hashIndex{name: "high"}, selectivity: 10}
+
+	// Both vindexes have the same cost, so the more selective one wins
+	// regardless of the order.
+	_, vindex, _ := bestOfComposite(engine.SelectIN, engine.SelectIN, low, high, nil, nil)
+	assert.Equal(t, "low", vindex.String())
+	_, vindex, _ = bestOfComposite(engine.SelectIN, engine.SelectIN, high, low, nil, nil)
+	assert.Equal(t, "low", vindex.String())
+}
@@ -47,6 +47,14 @@ func buildSelectPlan(query string) func(sqlparser.Statement, ContextVSchema) (en
 			return p, nil
 		}
 
+		p, err = handleRecentQueriesSelect(sel)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			return p, nil
+		}
+
 		pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 		if err := pb.processSelect(sel, nil, query); err != nil {
 			return nil, err
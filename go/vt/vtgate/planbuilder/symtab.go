@@ -108,7 +108,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 
 	for _, cv := range vschemaTable.ColumnVindexes {
 		single, ok := cv.Vindex.(vindexes.SingleColumn)
-		if !ok {
+		if !ok || cv.Disabled {
 			continue
 		}
 		for i, cvcol := range cv.Columns {
@@ -392,10 +392,12 @@ func ResultFromNumber(rcs []*resultColumn, val *sqlparser.Literal) (int, error)
 
 // Vindex returns the vindex if the expression is a plain column reference
 // that is part of the specified route, and has an associated vindex.
+// It also returns the vindex of a table of the route if the expression
+// is the one the vindex is on.
 func (st *symtab) Vindex(expr sqlparser.Expr, scope *route) vindexes.SingleColumn {
 	col, ok := expr.(*sqlparser.ColName)
 	if !ok {
-		return nil
+		return st.expressionVindex(expr, scope)
 	}
 	if col.Metadata == nil {
 		// Find will set the Metadata.
@@ -410,6 +412,50 @@ func (st *symtab) Vindex(expr sqlparser.Expr, scope *route) vindexes.SingleColum
 	return c.vindex
 }
 
+// expressionVindex returns the vindex on the given expression of a table
+// of the specified route, if there's one.
+func (st *symtab) expressionVindex(expr sqlparser.Expr, scope *route) vindexes.SingleColumn {
+	for _, t := range st.tables {
+		if t.vschemaTable == nil {
+			continue
+		}
+		for _, cv := range t.vschemaTable.ColumnVindexes {
+			single, ok := cv.Vindex.(vindexes.SingleColumn)
+			if !ok || cv.Disabled || cv.Expression == nil || !vindexes.MatchVindexExpression(expr, cv.Expression) {
+				continue
+			}
+			if st.isTableExpr(expr, t, scope) {
+				return single
+			}
+		}
+	}
+	return nil
+}
+
+// isTableExpr returns true if all the columns of the expression are
+// columns of the table, which is part of the specified route.
+func (st *symtab) isTableExpr(expr sqlparser.Expr, t *table, scope *route) bool {
+	isTableExpr := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		if col.Metadata == nil {
+			if _, _, err := st.Find(col); err != nil {
+				isTableExpr = false
+				return false, nil
+			}
+		}
+		c, ok := col.Metadata.(*column)
+		if !ok || c.Origin() != scope || t.columns[col.Name.Lowered()] != c {
+			isTableExpr = false
+		}
+		return isTableExpr, nil
+	}, expr)
+	return isTableExpr
+}
+
 // BuildColName builds a *sqlparser.ColName for the resultColumn specified
 // by the index. The built ColName will correctly reference the resultColumn
 // it was built from.
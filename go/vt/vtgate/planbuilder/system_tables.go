@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"strings"
+
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -118,3 +120,32 @@ func shouldRewrite(e sqlparser.Expr) bool {
 	}
 	return true
 }
+
+// recentQueriesTable is the virtual table listing the statements
+// recently executed by vtgate.
+const recentQueriesTable = "vitess_recent_queries"
+
+// handleRecentQueriesSelect plans a select from the virtual
+// information_schema.vitess_recent_queries table. Only a plain
+// select * is supported on it. It returns nil if sel selects from
+// anything else.
+func handleRecentQueriesSelect(sel *sqlparser.Select) (engine.Primitive, error) {
+	if len(sel.From) != 1 {
+		return nil, nil
+	}
+	tableExpr, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, nil
+	}
+	tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+	if !ok || !strings.EqualFold(tableName.Qualifier.String(), "information_schema") || !strings.EqualFold(tableName.Name.String(), recentQueriesTable) {
+		return nil, nil
+	}
+	if len(sel.SelectExprs) != 1 || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: only select * is supported on information_schema.%s", recentQueriesTable)
+	}
+	if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: only select * is supported on information_schema.%s", recentQueriesTable)
+	}
+	return &engine.RecentQueries{}, nil
+}
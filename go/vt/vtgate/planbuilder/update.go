@@ -60,6 +60,16 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 	changedVindexes := make(map[string]*engine.VindexValues)
 	buf, offset := initialQuery(ksidCol, table)
 	for i, vindex := range table.ColumnVindexes {
+		if vindex.Expression != nil {
+			for _, col := range vindexes.VindexExpressionColumns(vindex.Expression) {
+				for _, assignment := range update.Exprs {
+					if col.Equal(assignment.Name.Name) {
+						return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update the columns of the expression of a vindex. Invalid update on vindex: %v", vindex.Name)
+					}
+				}
+			}
+			continue
+		}
 		vindexValueMap := make(map[string]sqltypes.PlanValue)
 		first := true
 		for _, vcol := range vindex.Columns {
@@ -18,9 +18,14 @@ package vtgate
 
 import (
 	"flag"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"sync"
 
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/log"
 )
 
 var (
@@ -38,6 +43,9 @@ var (
 
 	// queryLogToFile controls whether query logs are sent to a file
 	queryLogToFile = flag.String("log_queries_to_file", "", "Enable query logging to the specified file")
+
+	// queryLogToFileMaxSize controls the rotation of the query log file
+	queryLogToFileMaxSize = flag.Int64("log_queries_to_file_max_size", 0, "Rotate the file set by -log_queries_to_file once it grows past this many bytes, keeping the previous file with a .1 suffix. 0 disables rotation, and the file is reopened on SIGUSR2 instead.")
 )
 
 func initQueryLogger(vtg *VTGate) error {
@@ -53,7 +61,13 @@ func initQueryLogger(vtg *VTGate) error {
 		queryzHandler(vtg.executor, w, r)
 	})
 
-	if *queryLogToFile != "" {
+	if *queryLogToFile != "" && *queryLogToFileMaxSize > 0 {
+		f, err := openRotatingFile(*queryLogToFile, *queryLogToFileMaxSize)
+		if err != nil {
+			return err
+		}
+		startQueryLogSink(QueryLogger, f, streamlog.GetFormatter(QueryLogger))
+	} else if *queryLogToFile != "" {
 		_, err := QueryLogger.LogToFile(*queryLogToFile, streamlog.GetFormatter(QueryLogger))
 		if err != nil {
 			return err
@@ -62,3 +76,98 @@ func initQueryLogger(vtg *VTGate) error {
 
 	return nil
 }
+
+// startQueryLogSink subscribes to logger and writes every entry to w
+// with logf, until the returned function is called.
+func startQueryLogSink(logger *streamlog.StreamLogger, w io.Writer, logf streamlog.LogFormatter) (stop func()) {
+	ch := logger.Subscribe("FileSink")
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		formatParams := url.Values{"full": {}}
+		for {
+			select {
+			case record := <-ch:
+				if err := logf(w, formatParams, record); err != nil {
+					log.Warningf("Failed to write to the query log file: %v", err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		logger.Unsubscribe(ch)
+		close(done)
+		<-stopped
+	}
+}
+
+// rotatingFile is a file that is rotated once it grows past maxSize.
+// The previous file is kept with a .1 suffix.
+type rotatingFile struct {
+	path    string
+	maxSize int64
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+func openRotatingFile(path string, maxSize int64) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, maxSize: maxSize}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f = f
+	r.size = fi.Size()
+	return nil
+}
+
+// Write implements io.Writer. An entry is never split across files.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return err
+	}
+	// Reopen the file even if it can't be renamed, so that the next
+	// entries can still be written.
+	renameErr := os.Rename(r.path, r.path+".1")
+	if err := r.open(); err != nil {
+		return err
+	}
+	return renameErr
+}
+
+// Close closes the file.
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/streamlog"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// syncBuffer is a bytes.Buffer that can be written and read concurrently.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestQueryLogSink(t *testing.T) {
+	saved := *streamlog.QueryLogFormat
+	*streamlog.QueryLogFormat = streamlog.QueryLogFormatJSON
+	defer func() {
+		*streamlog.QueryLogFormat = saved
+	}()
+
+	executor, _, _, _ := createLegacyExecutorEnv()
+	var buf syncBuffer
+	stop := startQueryLogSink(QueryLogger, &buf, streamlog.GetFormatter(QueryLogger))
+	defer stop()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+	_, err := executor.Execute(ctx, "TestExecute", session, "alter table t1 add column c int", nil)
+	require.NoError(t, err)
+
+	var line string
+	for timeout := time.After(5 * time.Second); line == ""; {
+		select {
+		case <-timeout:
+			t.Fatal("timed out waiting for the query log entry")
+		case <-time.After(10 * time.Millisecond):
+			if i := strings.IndexByte(buf.String(), '\n'); i >= 0 {
+				line = buf.String()[:i]
+			}
+		}
+	}
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(line), &entry), line)
+	assert.Equal(t, "TestExecute", entry["Method"])
+	assert.Equal(t, "DDL", entry["StmtType"])
+	assert.Equal(t, "alter table t1 add column c int", entry["SQL"])
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "querylog")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	name := path.Join(dir, "querylog.txt")
+
+	f, err := openRotatingFile(name, 10)
+	require.NoError(t, err)
+	for _, entry := range []string{"one\n", "two\n", "three\n", "a very long entry\n"} {
+		_, err := f.Write([]byte(entry))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	// Entries are not split, even if they are longer than the max size.
+	current, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, "a very long entry\n", string(current))
+	previous, err := ioutil.ReadFile(name + ".1")
+	require.NoError(t, err)
+	assert.Equal(t, "three\n", string(previous))
+
+	// The size of an existing file counts towards the max size.
+	f, err = openRotatingFile(name, 100)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("four\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	current, err = ioutil.ReadFile(name)
+	require.NoError(t, err)
+	assert.Equal(t, "a very long entry\nfour\n", string(current))
+}
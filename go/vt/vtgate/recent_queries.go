@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"sync"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// recentQueries is a ring buffer holding the last statements sent
+// to the query log. It backs information_schema.vitess_recent_queries.
+// The statements are redacted, so that the literals they hold, which
+// can be user data, are not kept.
+type recentQueries struct {
+	mu      sync.Mutex
+	entries []engine.RecentQuery
+	// next is the position the next entry is written to.
+	next int
+	full bool
+}
+
+func newRecentQueries(size int) *recentQueries {
+	return &recentQueries{entries: make([]engine.RecentQuery, size)}
+}
+
+// add records the statement described by stats, overwriting the
+// oldest one if the buffer is full.
+func (rq *recentQueries) add(stats *LogStats) {
+	if len(rq.entries) == 0 {
+		return
+	}
+	// Statements that don't parse are recorded with an empty SQL.
+	sql, _ := sqlparser.RedactSQLQuery(stats.SQL)
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	rq.entries[rq.next] = engine.RecentQuery{
+		Method:       stats.Method,
+		StmtType:     stats.StmtType,
+		SQL:          sql,
+		ShardQueries: stats.ShardQueries,
+		Duration:     stats.TotalTime(),
+	}
+	rq.next++
+	if rq.next == len(rq.entries) {
+		rq.next = 0
+		rq.full = true
+	}
+}
+
+// list returns the recorded statements, oldest first.
+func (rq *recentQueries) list() []engine.RecentQuery {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	if !rq.full {
+		return append([]engine.RecentQuery(nil), rq.entries[:rq.next]...)
+	}
+	result := make([]engine.RecentQuery, 0, len(rq.entries))
+	result = append(result, rq.entries[rq.next:]...)
+	return append(result, rq.entries[:rq.next]...)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+)
+
+func TestRecentQueriesWrap(t *testing.T) {
+	rq := newRecentQueries(2)
+	assert.Empty(t, rq.list())
+
+	for _, sql := range []string{"select 1 from t1", "select 1 from t2", "select 1 from t3"} {
+		rq.add(&LogStats{SQL: sql})
+	}
+	queries := rq.list()
+	require.Len(t, queries, 2)
+	assert.Equal(t, "select :redacted1 from t2", queries[0].SQL)
+	assert.Equal(t, "select :redacted1 from t3", queries[1].SQL)
+
+	// A zero sized buffer records nothing.
+	rq = newRecentQueries(0)
+	rq.add(&LogStats{SQL: "select 1 from t1"})
+	assert.Empty(t, rq.list())
+}
+
+func TestExecutorRecentQueries(t *testing.T) {
+	defer func(size int) {
+		*recentQueriesSize = size
+	}(*recentQueriesSize)
+	*recentQueriesSize = 20
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	stmts := []string{
+		"create table t1(id bigint primary key)",
+		"alter table t2 add primary key id",
+		"rename table t2 to t3",
+		"truncate table t2",
+		"drop table t2",
+	}
+	for _, stmt := range stmts {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, stmt, nil)
+		require.NoError(t, err)
+	}
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "select id from TestUnsharded.main1 where col = 'secret'", nil)
+	require.NoError(t, err)
+
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "select * from information_schema.vitess_recent_queries", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, len(stmts)+1)
+	for i := range stmts {
+		row := qr.Rows[i]
+		assert.Equal(t, "TestExecute", row[0].ToString())
+		assert.Equal(t, "DDL", row[1].ToString())
+		assert.Equal(t, sqltypes.NewUint64(8), row[3])
+	}
+	assert.Equal(t, "drop table t2", qr.Rows[4][2].ToString())
+	// The literals are not kept.
+	assert.Equal(t, "select id from TestUnsharded.main1 where col = :redacted1", qr.Rows[5][2].ToString())
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select sql from information_schema.vitess_recent_queries", nil)
+	require.EqualError(t, err, "unsupported: only select * is supported on information_schema.vitess_recent_queries")
+
+	*vschemaacl.AuthorizedDDLUsers = ""
+	vschemaacl.Init()
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select * from information_schema.vitess_recent_queries", nil)
+	require.EqualError(t, err, "not authorized to read the recent queries")
+}
+
+func TestExecutorRecentQueriesDisabled(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		vschemaacl.Init()
+	}()
+	executor, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "select * from information_schema.vitess_recent_queries", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.Rows)
+}
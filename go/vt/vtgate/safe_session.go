@@ -478,6 +478,103 @@ func (session *SafeSession) GetSessionUUID() string {
 	return session.SessionUUID
 }
 
+// SetDDLFailFast set the DdlFailFast setting.
+func (session *SafeSession) SetDDLFailFast(failFast bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.DdlFailFast = failFast
+}
+
+// GetDDLFailFast returns the DdlFailFast value.
+func (session *SafeSession) GetDDLFailFast() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.DdlFailFast
+}
+
+// SetDDLDropVSchemaTable set the DdlDropVschemaTable setting.
+func (session *SafeSession) SetDDLDropVSchemaTable(drop bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.DdlDropVschemaTable = drop
+}
+
+// GetDDLDropVSchemaTable returns the DdlDropVschemaTable value.
+func (session *SafeSession) GetDDLDropVSchemaTable() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.DdlDropVschemaTable
+}
+
+// SetVSchemaDDLJSON set the VschemaDdlJson setting.
+func (session *SafeSession) SetVSchemaDDLJSON(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.VschemaDdlJson = enable
+}
+
+// GetVSchemaDDLJSON returns the VschemaDdlJson value.
+func (session *SafeSession) GetVSchemaDDLJSON() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.VschemaDdlJson
+}
+
+// SetCaptureVSchemaDDL set the CaptureVschemaDdl setting.
+func (session *SafeSession) SetCaptureVSchemaDDL(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.CaptureVschemaDdl = enable
+}
+
+// GetCaptureVSchemaDDL returns the CaptureVschemaDdl value.
+func (session *SafeSession) GetCaptureVSchemaDDL() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.CaptureVschemaDdl
+}
+
+// SetVSchemaDefaultKeyspace set the VschemaDefaultKeyspace setting.
+func (session *SafeSession) SetVSchemaDefaultKeyspace(keyspace string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.VschemaDefaultKeyspace = keyspace
+}
+
+// GetVSchemaDefaultKeyspace returns the VschemaDefaultKeyspace value.
+func (session *SafeSession) GetVSchemaDefaultKeyspace() string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.VschemaDefaultKeyspace
+}
+
+// RecordVSchemaDDL records an ALTER VSCHEMA statement applied by the
+// session, if it captures them. It returns false if the statement isn't
+// recorded because the session already captured max of them.
+func (session *SafeSession) RecordVSchemaDDL(keyspace, statement string, max int) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.CaptureVschemaDdl {
+		return true
+	}
+	if len(session.CapturedVschemaDdl) >= max {
+		return false
+	}
+	session.CapturedVschemaDdl = append(session.CapturedVschemaDdl, &vtgatepb.CapturedVSchemaDDL{
+		Keyspace:  keyspace,
+		Statement: statement,
+	})
+	return true
+}
+
+// GetCapturedVSchemaDDL returns the ALTER VSCHEMA statements captured
+// by the session.
+func (session *SafeSession) GetCapturedVSchemaDDL() []*vtgatepb.CapturedVSchemaDDL {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.CapturedVschemaDdl
+}
+
 // SetSessionEnableSystemSettings set the SessionEnableSystemSettings setting.
 func (session *SafeSession) SetSessionEnableSystemSettings(allow bool) {
 	session.mu.Lock()
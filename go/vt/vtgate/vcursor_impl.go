@@ -27,6 +27,7 @@ import (
 
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/sync/errgroup"
 
 	"vitess.io/vitess/go/mysql"
@@ -69,6 +70,7 @@ type iExecute interface {
 	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
+	RecentQueries() []engine.RecentQuery
 
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
@@ -78,7 +80,9 @@ type iExecute interface {
 type VSchemaOperator interface {
 	GetCurrentSrvVschema() *vschemapb.SrvVSchema
 	GetCurrentVschema() (*vindexes.VSchema, error)
-	UpdateVSchema(ctx context.Context, ksName string, vschema *vschemapb.SrvVSchema) error
+	UpdateVSchema(ctx context.Context, ksName string, vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) error
+	UpdateRoutingRules(ctx context.Context, vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) error
+	UpdateVSchemaLabel(ctx context.Context, vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) error
 }
 
 // vcursorImpl implements the VCursor functionality used by dependent
@@ -108,41 +112,487 @@ func (vc *vcursorImpl) GetKeyspace() string {
 	return vc.keyspace
 }
 
-func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) error {
-	srvVschema := vc.vm.GetCurrentSrvVschema()
-	if srvVschema == nil {
-		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+// checkVSchemaSize rejects a vschema change that grows a keyspace beyond
+// -vschema_max_tables or -vschema_max_vindexes. A keyspace that is
+// already over a limit can still shrink.
+func checkVSchemaSize(ksName string, orig, ks *vschemapb.Keyspace) error {
+	if *vschemaMaxTables > 0 && len(ks.Tables) > *vschemaMaxTables && len(ks.Tables) > len(orig.GetTables()) {
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "vschema of keyspace %s would have %d tables, more than the limit of %d", ksName, len(ks.Tables), *vschemaMaxTables)
+	}
+	if *vschemaMaxVindexes > 0 && len(ks.Vindexes) > *vschemaMaxVindexes && len(ks.Vindexes) > len(orig.GetVindexes()) {
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "vschema of keyspace %s would have %d vindexes, more than the limit of %d", ksName, len(ks.Vindexes), *vschemaMaxVindexes)
 	}
+	return nil
+}
 
-	allowed := vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(vc.ctx))
-	if !allowed {
-		return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not authorized to perform vschema operations")
+// checkAutoIncSequence makes sure that the sequence of an auto increment
+// is a sequence table of the vschema. It can be in another keyspace than
+// the table. An unqualified sequence has to be defined in a single
+// keyspace, since it is looked up across keyspaces when the vschema is
+// built.
+func checkAutoIncSequence(srvVschema *vschemapb.SrvVSchema, sequence sqlparser.TableName) error {
+	name := sequence.Name.String()
+	if ksName := sequence.Qualifier.String(); ksName != "" {
+		ks, ok := srvVschema.Keyspaces[ksName]
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", ksName)
+		}
+		table := ks.Tables[name]
+		if table == nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "sequence %s not found in keyspace %s", name, ksName)
+		}
+		if table.Type != vindexes.TypeSequence {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s.%s is not a sequence", ksName, name)
+		}
+		return nil
+	}
 
+	var ksNames []string
+	for ksName, ks := range srvVschema.Keyspaces {
+		if table := ks.Tables[name]; table != nil && table.Type == vindexes.TypeSequence {
+			ksNames = append(ksNames, ksName)
+		}
 	}
+	switch len(ksNames) {
+	case 0:
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "sequence %s not found in vschema", name)
+	case 1:
+		return nil
+	}
+	sort.Strings(ksNames)
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "ambiguous sequence %s: defined in keyspaces %s", name, strings.Join(ksNames, ", "))
+}
 
+// checkRenamedTableReferences rejects the rename of a table that tables
+// of other keyspaces reference, as their parent or as the sequence of
+// their auto increment. References from the keyspace of the table are
+// updated by the rename itself.
+func checkRenamedTableReferences(srvVschema *vschemapb.SrvVSchema, ksName, tableName string) error {
+	table := srvVschema.Keyspaces[ksName].GetTables()[tableName]
+	if table == nil {
+		return nil
+	}
+	qualified := fmt.Sprintf("%s.%s", ksName, tableName)
+	var refs []string
+	for otherKsName, otherKs := range srvVschema.Keyspaces {
+		if otherKsName == ksName {
+			continue
+		}
+		for otherName, other := range otherKs.Tables {
+			if other.Parent != nil && other.Parent.Table == qualified {
+				refs = append(refs, fmt.Sprintf("%s.%s", otherKsName, otherName))
+				continue
+			}
+			if table.Type != vindexes.TypeSequence || other.AutoIncrement == nil {
+				continue
+			}
+			if seq := other.AutoIncrement.Sequence; seq == qualified || seq == tableName {
+				refs = append(refs, fmt.Sprintf("%s.%s", otherKsName, otherName))
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+	sort.Strings(refs)
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot rename table %s: referenced by tables of other keyspaces: %s", qualified, strings.Join(refs, ", "))
+}
+
+// applyKeyspaceVSchemaDDL applies a vschema DDL to the vschema of a
+// keyspace of the SrvVSchema. The keyspace is the qualifier of the
+// statement, or the given keyspace by default. It returns the name of
+// the keyspace, its vschema before the statement, which is nil for a
+// new keyspace, and after it.
+func applyKeyspaceVSchemaDDL(srvVschema *vschemapb.SrvVSchema, keyspace string, vschemaDDL *sqlparser.AlterVschema) (string, *vschemapb.Keyspace, *vschemapb.Keyspace, error) {
 	// Resolve the keyspace either from the table qualifier or the target keyspace
 	var ksName string
-	if !vschemaDDL.Table.IsEmpty() {
+	if !vschemaDDL.Table.IsEmpty() || vschemaDDL.Action == sqlparser.SetKeyspaceCommentDDLAction {
 		ksName = vschemaDDL.Table.Qualifier.String()
 	}
 	if ksName == "" {
 		ksName = keyspace
 	}
 	if ksName == "" {
-		return errNoKeyspace
+		return "", nil, nil, errNoKeyspace
+	}
+
+	if vschemaDDL.Action == sqlparser.AddAutoIncDDLAction {
+		if err := checkAutoIncSequence(srvVschema, vschemaDDL.AutoIncSpec.Sequence); err != nil {
+			return "", nil, nil, err
+		}
+	}
+	if vschemaDDL.Action == sqlparser.RenameVschemaTableDDLAction {
+		if err := checkRenamedTableReferences(srvVschema, ksName, vschemaDDL.Table.Name.String()); err != nil {
+			return "", nil, nil, err
+		}
+	}
+	if vschemaDDL.Action == sqlparser.SetParentTableDDLAction {
+		parentKsName := vschemaDDL.ParentSpec.Parent.Qualifier.String()
+		if parentKsName == "" {
+			parentKsName = ksName
+		}
+		parentKs, ok := srvVschema.Keyspaces[parentKsName]
+		if !ok {
+			return "", nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", parentKsName)
+		}
+		if err := topotools.CheckParentTable(ksName, srvVschema.Keyspaces[ksName], parentKsName, parentKs, vschemaDDL); err != nil {
+			return "", nil, nil, err
+		}
 	}
 
 	ks := srvVschema.Keyspaces[ksName]
+	var orig *vschemapb.Keyspace
+	if ks != nil {
+		orig = proto.Clone(ks).(*vschemapb.Keyspace)
+	}
 	ks, err := topotools.ApplyVSchemaDDL(ksName, ks, vschemaDDL)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if err := checkVSchemaSize(ksName, orig, ks); err != nil {
+		return "", nil, nil, err
+	}
+	return ksName, orig, ks, nil
+}
 
+// copyKeyspaceVSchema returns the name and vschema of the keyspace
+// created by a COPY KEYSPACE statement.
+func copyKeyspaceVSchema(srvVschema *vschemapb.SrvVSchema, vschemaDDL *sqlparser.AlterVschema) (string, *vschemapb.Keyspace, error) {
+	src, dst := vschemaDDL.Table.Qualifier.String(), vschemaDDL.NewName.Qualifier.String()
+	srcKs, ok := srvVschema.Keyspaces[src]
+	if !ok {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", src)
+	}
+	if _, ok := srvVschema.Keyspaces[dst]; ok {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "vschema already contains keyspace %s", dst)
+	}
+
+	ks := topotools.CopyVSchemaKeyspace(src, srcKs, dst)
+	if err := checkVSchemaSize(dst, nil, ks); err != nil {
+		return "", nil, err
+	}
+	return dst, ks, nil
+}
+
+// applyRoutingRuleVSchemaDDL returns the routing rules of the SrvVSchema
+// once the routing rule DDL is applied. The keyspace queries are routed
+// to must be in the vschema.
+func applyRoutingRuleVSchemaDDL(srvVschema *vschemapb.SrvVSchema, vschemaDDL *sqlparser.AlterVschema) (*vschemapb.RoutingRules, error) {
+	if vschemaDDL.Action == sqlparser.AddRoutingRuleDDLAction {
+		if ksName := vschemaDDL.NewName.Qualifier.String(); ksName != "" && srvVschema.Keyspaces[ksName] == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace %s not found in vschema", ksName)
+		}
+	}
+	return topotools.ApplyRoutingRuleDDL(srvVschema.RoutingRules, vschemaDDL)
+}
+
+// ExecuteVSchema implements the VCursor interface. A statement that is
+// applied is recorded in the session if it captures them. The
+// statements of a script are recorded one by one instead, so that they
+// can be replayed.
+func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	result, err := vc.executeVSchema(keyspace, vschemaDDL)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if vschemaDDL.Action != sqlparser.ApplyVSchemaScriptDDLAction {
+		vc.recordVSchemaDDL(keyspace, vschemaDDL)
+	}
+	return result, nil
+}
+
+// recordVSchemaDDL records the applied statement in the session, with
+// a warning if the session can't capture more of them.
+func (vc *vcursorImpl) recordVSchemaDDL(keyspace string, vschemaDDL *sqlparser.AlterVschema) {
+	if !vc.safeSession.RecordVSchemaDDL(keyspace, capturedVSchemaDDL(vschemaDDL), *maxCapturedVSchemaDDL) {
+		vc.safeSession.RecordWarning(&querypb.QueryWarning{
+			Message: fmt.Sprintf("vschema ddl not captured, the session already captured %d statements", *maxCapturedVSchemaDDL),
+		})
+	}
+}
+
+// capturedVSchemaDDL returns the statement as it is captured. The
+// parser keeps the values of vindex params unquoted, so they are quoted
+// again the way topotools.VSchemaDDL prints them. Otherwise a value like
+// a list of columns or a qualified table would not parse on replay.
+func capturedVSchemaDDL(vschemaDDL *sqlparser.AlterVschema) string {
+	ddl := *vschemaDDL
+	if ddl.VindexSpec != nil {
+		spec := *ddl.VindexSpec
+		spec.Params = quoteVindexParams(spec.Params)
+		ddl.VindexSpec = &spec
+	}
+	if ddl.VindexBindings != nil {
+		ddl.VindexBindings = make([]*sqlparser.VindexBinding, len(vschemaDDL.VindexBindings))
+		for i, binding := range vschemaDDL.VindexBindings {
+			spec := *binding.Spec
+			spec.Params = quoteVindexParams(spec.Params)
+			ddl.VindexBindings[i] = &sqlparser.VindexBinding{Column: binding.Column, Spec: &spec}
+		}
+	}
+	ddl.SequenceParams = quoteVindexParams(ddl.SequenceParams)
+	return sqlparser.String(&ddl)
+}
+
+// quoteVindexParams returns a copy of the params with their values quoted
+// as identifiers when needed. String values keep their quotes.
+func quoteVindexParams(params []sqlparser.VindexParam) []sqlparser.VindexParam {
+	if params == nil {
+		return nil
+	}
+	quoted := make([]sqlparser.VindexParam, len(params))
+	for i, param := range params {
+		quoted[i] = param
+		if !strings.HasPrefix(param.Val, "'") {
+			quoted[i].Val = sqlparser.String(sqlparser.NewColIdent(param.Val))
+		}
+	}
+	return quoted
+}
+
+func (vc *vcursorImpl) executeVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	srvVschema := vc.vm.GetCurrentSrvVschema()
+	if srvVschema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+
+	allowed := vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(vc.ctx))
+	if !allowed {
+		return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not authorized to perform vschema operations")
+
+	}
+
+	if vschemaDDL.Action == sqlparser.ApplyVSchemaScriptDDLAction {
+		return vc.applyVSchemaScript(srvVschema, keyspace, vschemaDDL.Script)
+	}
+	return vc.applyVSchemaDDL(srvVschema, keyspace, vschemaDDL)
+}
+
+// applyVSchemaDDL applies a vschema DDL to the SrvVSchema and saves the
+// result to the topo.
+func (vc *vcursorImpl) applyVSchemaDDL(srvVschema *vschemapb.SrvVSchema, keyspace string, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	switch vschemaDDL.Action {
+	case sqlparser.CopyKeyspaceDDLAction:
+		return vc.copyVSchemaKeyspace(srvVschema, vschemaDDL)
+	case sqlparser.AddRoutingRuleDDLAction, sqlparser.DropRoutingRuleDDLAction:
+		return vc.alterRoutingRules(srvVschema, vschemaDDL)
+	case sqlparser.SetVSchemaLabelDDLAction:
+		return vc.setVSchemaLabel(srvVschema, vschemaDDL)
+	}
+
+	ksName, orig, ks, err := applyKeyspaceVSchemaDDL(srvVschema, keyspace, vschemaDDL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Statements that leave the keyspace as is, like dropping all the
+	// vindexes of a table that has none, don't update the topo.
+	if orig != nil && proto.Equal(orig, ks) {
+		return vc.vschemaDDLResult(describeVSchemaChange(ksName, orig, ks, vschemaDDL))
 	}
 
 	srvVschema.Keyspaces[ksName] = ks
 
-	return vc.vm.UpdateVSchema(vc.ctx, ksName, srvVschema)
+	if err := vc.vm.UpdateVSchema(vc.ctx, ksName, srvVschema, vc.vschemaOrigin(sqlparser.String(vschemaDDL))); err != nil {
+		return nil, err
+	}
 
+	// A vindex can be created with an owner table that is only added to
+	// the vschema later on. Let the client know through SHOW WARNINGS.
+	var specs []*sqlparser.VindexSpec
+	switch vschemaDDL.Action {
+	case sqlparser.CreateVindexDDLAction, sqlparser.AddColVindexDDLAction:
+		specs = append(specs, vschemaDDL.VindexSpec)
+	case sqlparser.AddColVindexesDDLAction:
+		for _, binding := range vschemaDDL.VindexBindings {
+			specs = append(specs, binding.Spec)
+		}
+	}
+	for _, spec := range specs {
+		if spec.Type.IsEmpty() {
+			continue
+		}
+		vindex := ks.Vindexes[spec.Name.String()]
+		if vindex.Owner != "" && ks.Tables[vindex.Owner] == nil {
+			vc.safeSession.RecordWarning(&querypb.QueryWarning{
+				Code:    mysql.ERNoSuchTable,
+				Message: fmt.Sprintf("owner table %s of vindex %s not defined in vschema", vindex.Owner, spec.Name.String()),
+			})
+		}
+	}
+
+	// Without cascade, dropping the last vindex of a scatter table keeps
+	// the table entry.
+	switch vschemaDDL.Action {
+	case sqlparser.DropColVindexDDLAction, sqlparser.DropAllColVindexesDDLAction:
+		tableName := vschemaDDL.Table.Name.String()
+		table := ks.Tables[tableName]
+		if len(orig.GetTables()[tableName].GetColumnVindexes()) != 0 && table.GetScatter() && len(table.GetColumnVindexes()) == 0 {
+			vc.safeSession.RecordWarning(&querypb.QueryWarning{
+				Code:    mysql.ERUnknownError,
+				Message: fmt.Sprintf("table %s.%s has no vindex left and stays scatter-routed, use cascade to drop it from the vschema", ksName, tableName),
+			})
+		}
+	}
+	return vc.vschemaDDLResult(describeVSchemaChange(ksName, orig, ks, vschemaDDL))
+}
+
+// applyVSchemaScript applies the ALTER VSCHEMA statements of the script
+// one after the other. Unlike a single statement, the script is not
+// atomic: a statement that fails doesn't stop the next ones. The result
+// has a row with the outcome of every statement, so that only the
+// failed ones have to be fixed and applied again.
+//
+// There is no atomic mode: the statements of a script may change several
+// keyspaces and the routing rules, which are saved to the topo one at a
+// time, so a failure can't roll back the ones that were saved.
+func (vc *vcursorImpl) applyVSchemaScript(srvVschema *vschemapb.SrvVSchema, keyspace, script string) (*sqltypes.Result, error) {
+	pieces, err := sqlparser.SplitStatementToPieces(script)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Statement", Type: sqltypes.VarChar},
+			{Name: "Outcome", Type: sqltypes.VarChar},
+			{Name: "Error", Type: sqltypes.VarChar},
+		},
+	}
+	for _, piece := range pieces {
+		piece = strings.TrimSpace(piece)
+		// Each statement sees the changes of the previous ones, which
+		// the vschema manager may not have received yet. A statement
+		// that fails leaves the vschema as it was.
+		next := proto.Clone(srvVschema).(*vschemapb.SrvVSchema)
+		outcome, message := "ok", ""
+		if err := vc.applyVSchemaScriptStatement(next, keyspace, piece); err != nil {
+			outcome, message = "error", err.Error()
+		} else {
+			srvVschema = next
+		}
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(piece),
+			sqltypes.NewVarChar(outcome),
+			sqltypes.NewVarChar(message),
+		})
+	}
+	return result, nil
+}
+
+func (vc *vcursorImpl) applyVSchemaScriptStatement(srvVschema *vschemapb.SrvVSchema, keyspace, sql string) error {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return err
+	}
+	vschemaDDL, ok := stmt.(*sqlparser.AlterVschema)
+	if !ok || vschemaDDL.Action == sqlparser.ApplyVSchemaScriptDDLAction {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "only ALTER VSCHEMA statements can be applied by a script")
+	}
+	if _, err := vc.applyVSchemaDDL(srvVschema, keyspace, vschemaDDL); err != nil {
+		return err
+	}
+	vc.recordVSchemaDDL(keyspace, vschemaDDL)
+	return nil
+}
+
+// vschemaTables returns the given tables that are in the vschema of the
+// keyspace, or an error if the caller is not allowed to remove them.
+func (vc *vcursorImpl) vschemaTables(srvVschema *vschemapb.SrvVSchema, keyspace string, tables []string) ([]string, error) {
+	var found []string
+	for _, table := range tables {
+		if _, ok := srvVschema.Keyspaces[keyspace].GetTables()[table]; ok {
+			found = append(found, table)
+		}
+	}
+	if len(found) != 0 && !vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(vc.ctx)) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not authorized to perform vschema operations")
+	}
+	return found, nil
+}
+
+// CheckDropVSchemaTables implements the VCursor interface.
+func (vc *vcursorImpl) CheckDropVSchemaTables(keyspace string, tables []string) error {
+	srvVschema := vc.vm.GetCurrentSrvVschema()
+	if srvVschema == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+	_, err := vc.vschemaTables(srvVschema, keyspace, tables)
+	return err
+}
+
+// DropVSchemaTables implements the VCursor interface. The tables are
+// removed in a single vschema update.
+func (vc *vcursorImpl) DropVSchemaTables(keyspace string, tables []string) error {
+	srvVschema := vc.vm.GetCurrentSrvVschema()
+	if srvVschema == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+	found, err := vc.vschemaTables(srvVschema, keyspace, tables)
+	if err != nil || len(found) == 0 {
+		return err
+	}
+
+	ks := proto.Clone(srvVschema.Keyspaces[keyspace]).(*vschemapb.Keyspace)
+	for _, table := range found {
+		delete(ks.Tables, table)
+	}
+	srvVschema.Keyspaces[keyspace] = ks
+	return vc.vm.UpdateVSchema(vc.ctx, keyspace, srvVschema, vc.vschemaOrigin(fmt.Sprintf("drop table %s from keyspace %s", strings.Join(found, ", "), keyspace)))
+}
+
+// copyVSchemaKeyspace installs a copy of the vschema of keyspace src as
+// the vschema of the new keyspace dst, in a single update.
+func (vc *vcursorImpl) copyVSchemaKeyspace(srvVschema *vschemapb.SrvVSchema, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	dst, ks, err := copyKeyspaceVSchema(srvVschema, vschemaDDL)
+	if err != nil {
+		return nil, err
+	}
+
+	srvVschema.Keyspaces[dst] = ks
+	if err := vc.vm.UpdateVSchema(vc.ctx, dst, srvVschema, vc.vschemaOrigin(sqlparser.String(vschemaDDL))); err != nil {
+		return nil, err
+	}
+	return vc.vschemaDDLResult(&vschemaChange{Action: vschemaDDL.Action.ToString(), Keyspace: dst})
+}
+
+// alterRoutingRules applies a routing rule DDL to the routing rules of
+// the SrvVSchema.
+func (vc *vcursorImpl) alterRoutingRules(srvVschema *vschemapb.SrvVSchema, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	rules, err := applyRoutingRuleVSchemaDDL(srvVschema, vschemaDDL)
+	if err != nil {
+		return nil, err
+	}
+	// An added rule is described as it is after the statement, and a
+	// dropped one as it was before it.
+	change := &vschemaChange{Action: vschemaDDL.Action.ToString()}
+	from := topotools.RoutingRuleTableName(vschemaDDL.Table)
+	if vschemaDDL.Action == sqlparser.AddRoutingRuleDDLAction {
+		change.RoutingRule = findRoutingRule(rules, from)
+	} else {
+		change.RoutingRule = findRoutingRule(srvVschema.RoutingRules, from)
+	}
+	srvVschema.RoutingRules = rules
+	if err := vc.vm.UpdateRoutingRules(vc.ctx, srvVschema, vc.vschemaOrigin(sqlparser.String(vschemaDDL))); err != nil {
+		return nil, err
+	}
+	return vc.vschemaDDLResult(change)
+}
+
+func (vc *vcursorImpl) setVSchemaLabel(srvVschema *vschemapb.SrvVSchema, vschemaDDL *sqlparser.AlterVschema) (*sqltypes.Result, error) {
+	srvVschema.Label = vschemaDDL.Label
+	if err := vc.vm.UpdateVSchemaLabel(vc.ctx, srvVschema, vc.vschemaOrigin(sqlparser.String(vschemaDDL))); err != nil {
+		return nil, err
+	}
+	return vc.vschemaDDLResult(&vschemaChange{Action: vschemaDDL.Action.ToString(), Label: vschemaDDL.Label})
+}
+
+// vschemaOrigin returns the origin of a vschema update made by the
+// statement on behalf of the caller.
+func (vc *vcursorImpl) vschemaOrigin(statement string) *VSchemaOrigin {
+	return &VSchemaOrigin{
+		Username:  callerid.ImmediateCallerIDFromContext(vc.ctx).GetUsername(),
+		Statement: sqlparser.TruncateForLog(statement),
+	}
 }
 
 // newVcursorImpl creates a vcursorImpl. Before creating this object, you have to separate out any marginComments that came with
@@ -193,6 +643,34 @@ func (vc *vcursorImpl) MaxMemoryRows() int {
 	return *maxMemoryRows
 }
 
+// DDLMaxConcurrency returns the ddl_max_concurrency flag value.
+func (vc *vcursorImpl) DDLMaxConcurrency() int {
+	return *ddlMaxConcurrency
+}
+
+// DDLShardRowsInfo returns the ddl_shard_rows_info flag value.
+func (vc *vcursorImpl) DDLShardRowsInfo() bool {
+	return *ddlShardRowsInfo
+}
+
+// DDLTimingInfo returns the ddl_timing_info flag value.
+func (vc *vcursorImpl) DDLTimingInfo() bool {
+	return *ddlTimingInfo
+}
+
+// RecordShardTime is part of the engine.VCursor interface.
+func (vc *vcursorImpl) RecordShardTime(shard string, elapsed time.Duration) {
+	vc.logStats.RecordShardTime(shard, elapsed)
+}
+
+// RecentQueries is part of the engine.VCursor interface.
+func (vc *vcursorImpl) RecentQueries() ([]engine.RecentQuery, error) {
+	if !vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(vc.ctx)) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "not authorized to read the recent queries")
+	}
+	return vc.executor.RecentQueries(), nil
+}
+
 // ExceedsMaxMemoryRows returns a boolean indicating whether the maxMemoryRows value has been exceeded.
 // Returns false if the max memory rows override directive is set to true.
 func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
@@ -332,6 +810,20 @@ func (vc *vcursorImpl) SysVarSetEnabled() bool {
 	return vc.GetSessionEnableSystemSettings()
 }
 
+// VSchemaDDLDefaultKeyspace implements the ContextVSchema interface.
+// The default keyspace of the session only applies when the target
+// doesn't name a keyspace and the caller is authorized to perform
+// vschema operations.
+func (vc *vcursorImpl) VSchemaDDLDefaultKeyspace() string {
+	if vc.keyspace != "" {
+		return ""
+	}
+	if !vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(vc.ctx)) {
+		return ""
+	}
+	return vc.safeSession.GetVSchemaDefaultKeyspace()
+}
+
 // KeyspaceExists provides whether the keyspace exists or not.
 func (vc *vcursorImpl) KeyspaceExists(ks string) bool {
 	return vc.vschema.Keyspaces[ks] != nil
@@ -487,23 +979,61 @@ func (vc *vcursorImpl) ResolveDestinations(keyspace string, ids []*querypb.Value
 	return vc.resolver.ResolveDestinations(vc.ctx, keyspace, vc.tabletType, ids, destinations)
 }
 
+// UnavailableShards implements the VCursor interface. A shard is
+// unavailable if its SrvKeyspace disables the query service for the
+// tablet type of the session.
+func (vc *vcursorImpl) UnavailableShards(keyspace string) ([]*topodatapb.ShardReference, error) {
+	_, srvKeyspace, allShards, err := vc.resolver.GetKeyspaceShards(vc.ctx, keyspace, vc.tabletType)
+	if err != nil {
+		return nil, err
+	}
+	disabled := make(map[string]bool)
+	for _, partition := range srvKeyspace.GetPartitions() {
+		if partition.ServedType != vc.tabletType {
+			continue
+		}
+		for _, control := range partition.ShardTabletControls {
+			if control.QueryServiceDisabled {
+				disabled[control.Name] = true
+			}
+		}
+	}
+	var unavailable []*topodatapb.ShardReference
+	for _, shard := range allShards {
+		if disabled[shard.Name] {
+			unavailable = append(unavailable, shard)
+		}
+	}
+	return unavailable, nil
+}
+
 func (vc *vcursorImpl) Session() engine.SessionActions {
 	return vc
 }
 
 func (vc *vcursorImpl) SetTarget(target string) error {
+	if err := checkTarget(vc.vschema, vc.safeSession, target); err != nil {
+		return err
+	}
+	vc.safeSession.SetTargetString(target)
+	return nil
+}
+
+// checkTarget checks that the session can switch to the target: its
+// keyspace has to be in the vschema, and a transaction can't move to a
+// non-master tablet type.
+func checkTarget(vschema *vindexes.VSchema, safeSession *SafeSession, target string) error {
 	keyspace, tabletType, _, err := topoprotopb.ParseDestination(target, defaultTabletType)
 	if err != nil {
 		return err
 	}
-	if _, ok := vc.vschema.Keyspaces[keyspace]; !ignoreKeyspace(keyspace) && !ok {
+	if _, ok := vschema.Keyspaces[keyspace]; !ignoreKeyspace(keyspace) && !ok {
 		return mysql.NewSQLError(mysql.ERBadDb, mysql.SSSyntaxErrorOrAccessViolation, "Unknown database '%s'", keyspace)
 	}
 
-	if vc.safeSession.InTransaction() && tabletType != topodatapb.TabletType_MASTER {
+	if safeSession.InTransaction() && tabletType != topodatapb.TabletType_MASTER {
 		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot change to a non-master type in the middle of a transaction: %v", tabletType)
 	}
-	vc.safeSession.SetTargetString(target)
 	return nil
 }
 
@@ -663,6 +1193,61 @@ func (vc *vcursorImpl) GetSessionUUID() string {
 	return vc.safeSession.GetSessionUUID()
 }
 
+// SetDDLFailFast implements the SessionActions interface
+func (vc *vcursorImpl) SetDDLFailFast(failFast bool) error {
+	vc.safeSession.SetDDLFailFast(failFast)
+	return nil
+}
+
+// GetDDLFailFast implements the SessionActions interface
+func (vc *vcursorImpl) GetDDLFailFast() bool {
+	return vc.safeSession.GetDDLFailFast()
+}
+
+// SetDDLDropVSchemaTable implements the SessionActions interface
+func (vc *vcursorImpl) SetDDLDropVSchemaTable(drop bool) error {
+	vc.safeSession.SetDDLDropVSchemaTable(drop)
+	return nil
+}
+
+// GetDDLDropVSchemaTable implements the SessionActions interface
+func (vc *vcursorImpl) GetDDLDropVSchemaTable() bool {
+	return vc.safeSession.GetDDLDropVSchemaTable()
+}
+
+// SetVSchemaDDLJSON implements the SessionActions interface
+func (vc *vcursorImpl) SetVSchemaDDLJSON(enable bool) error {
+	vc.safeSession.SetVSchemaDDLJSON(enable)
+	return nil
+}
+
+// GetVSchemaDDLJSON implements the SessionActions interface
+func (vc *vcursorImpl) GetVSchemaDDLJSON() bool {
+	return vc.safeSession.GetVSchemaDDLJSON()
+}
+
+// SetCaptureVSchemaDDL implements the SessionActions interface
+func (vc *vcursorImpl) SetCaptureVSchemaDDL(enable bool) error {
+	vc.safeSession.SetCaptureVSchemaDDL(enable)
+	return nil
+}
+
+// GetCaptureVSchemaDDL implements the SessionActions interface
+func (vc *vcursorImpl) GetCaptureVSchemaDDL() bool {
+	return vc.safeSession.GetCaptureVSchemaDDL()
+}
+
+// SetVSchemaDefaultKeyspace implements the SessionActions interface
+func (vc *vcursorImpl) SetVSchemaDefaultKeyspace(keyspace string) error {
+	vc.safeSession.SetVSchemaDefaultKeyspace(keyspace)
+	return nil
+}
+
+// GetVSchemaDefaultKeyspace implements the SessionActions interface
+func (vc *vcursorImpl) GetVSchemaDefaultKeyspace() string {
+	return vc.safeSession.GetVSchemaDefaultKeyspace()
+}
+
 // SetSessionEnableSystemSettings implements the SessionActions interface
 func (vc *vcursorImpl) SetSessionEnableSystemSettings(allow bool) error {
 	vc.safeSession.SetSessionEnableSystemSettings(allow)
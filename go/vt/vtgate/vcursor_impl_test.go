@@ -35,7 +35,15 @@ func (f fakeVSchemaOperator) GetCurrentVschema() (*vindexes.VSchema, error) {
 	return f.vschema, nil
 }
 
-func (f fakeVSchemaOperator) UpdateVSchema(ctx context.Context, ksName string, vschema *vschema.SrvVSchema) error {
+func (f fakeVSchemaOperator) UpdateVSchema(ctx context.Context, ksName string, vschema *vschema.SrvVSchema, origin *VSchemaOrigin) error {
+	panic("implement me")
+}
+
+func (f fakeVSchemaOperator) UpdateRoutingRules(ctx context.Context, vschema *vschema.SrvVSchema, origin *VSchemaOrigin) error {
+	panic("implement me")
+}
+
+func (f fakeVSchemaOperator) UpdateVSchemaLabel(ctx context.Context, vschema *vschema.SrvVSchema, origin *VSchemaOrigin) error {
 	panic("implement me")
 }
 
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const pathVindexCheck = "/debug/vindex_check"
+
+// VindexCheckFailure is a vindex of the SrvVSchema that cannot be
+// created by the factory registered for its type.
+type VindexCheckFailure struct {
+	Keyspace string
+	Name     string
+	Type     string
+	Error    string
+}
+
+// CheckVindexes creates every vindex of the current SrvVSchema and
+// returns the ones that fail, sorted by keyspace and name. Unlike
+// loading the vschema, which stops at the first bad vindex of a
+// keyspace, it reports all of them.
+func (e *Executor) CheckVindexes() ([]VindexCheckFailure, error) {
+	srvVSchema := e.vm.GetCurrentSrvVschema()
+	if srvVSchema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vschema not loaded")
+	}
+	return checkVindexes(srvVSchema), nil
+}
+
+func checkVindexes(srvVSchema *vschemapb.SrvVSchema) []VindexCheckFailure {
+	ksNames := make([]string, 0, len(srvVSchema.Keyspaces))
+	for ksName := range srvVSchema.Keyspaces {
+		ksNames = append(ksNames, ksName)
+	}
+	sort.Strings(ksNames)
+
+	var failures []VindexCheckFailure
+	for _, ksName := range ksNames {
+		ks := srvVSchema.Keyspaces[ksName]
+		names := make([]string, 0, len(ks.Vindexes))
+		for name := range ks.Vindexes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			vindex := ks.Vindexes[name]
+			if _, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params); err != nil {
+				failures = append(failures, VindexCheckFailure{
+					Keyspace: ksName,
+					Name:     name,
+					Type:     vindex.Type,
+					Error:    err.Error(),
+				})
+			}
+		}
+	}
+	return failures
+}
+
+// logVindexCheck logs the vindexes of the SrvVSchema that cannot be
+// created. It is run on the first SrvVSchema received at startup.
+func logVindexCheck(srvVSchema *vschemapb.SrvVSchema) {
+	for _, failure := range checkVindexes(srvVSchema) {
+		log.Warningf("Vindex %s.%s of type %s cannot be created: %s", failure.Keyspace, failure.Name, failure.Type, failure.Error)
+	}
+}
+
+// VindexGolden is an id and the keyspace id a vindex is expected to map
+// it to.
+type VindexGolden struct {
+	ID         sqltypes.Value
+	KeyspaceID []byte
+}
+
+// CompareVindexMap creates a vindex of the given type and params, maps
+// the id of every golden entry, and returns the entries that are not
+// mapped to their keyspace id. The result has the id, the expected
+// keyspace id in hex, and the actual destination: a keyspace id in hex,
+// or the destination itself if it isn't a single keyspace id. Only
+// vindexes that don't need a vcursor can be compared.
+func CompareVindexMap(vindexType string, params map[string]string, golden []VindexGolden) (*sqltypes.Result, error) {
+	vindex, err := vindexes.CreateVindex(vindexType, vindexType, params)
+	if err != nil {
+		return nil, err
+	}
+	if vindex.NeedsVCursor() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vindex type %s needs a vcursor and cannot be compared", vindexType)
+	}
+
+	rowsColValues := make([][]sqltypes.Value, 0, len(golden))
+	for _, entry := range golden {
+		rowsColValues = append(rowsColValues, []sqltypes.Value{entry.ID})
+	}
+	destinations, err := vindexes.Map(vindex, nil, rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.VarChar},
+			{Name: "expected", Type: sqltypes.VarChar},
+			{Name: "actual", Type: sqltypes.VarChar},
+		},
+	}
+	for i, entry := range golden {
+		var actual string
+		switch dest := destinations[i].(type) {
+		case key.DestinationKeyspaceID:
+			if bytes.Equal(dest, entry.KeyspaceID) {
+				continue
+			}
+			actual = hex.EncodeToString(dest)
+		default:
+			actual = dest.String()
+		}
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(entry.ID.ToString()),
+			sqltypes.NewVarChar(hex.EncodeToString(entry.KeyspaceID)),
+			sqltypes.NewVarChar(actual),
+		})
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestCheckVindexes(t *testing.T) {
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash":   {Type: "hash"},
+					"lookup": {Type: "lookup", Params: map[string]string{"table": "t_lkp", "from": "c1", "to": "keyspace_id"}},
+					"static": {Type: "numeric_static_map", Params: map[string]string{"json_path": "/nonexistent.json"}},
+				},
+			},
+			"ks2": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"bogus": {Type: "bogus"},
+					"hash":  {Type: "hash", Params: map[string]string{"unique": "false"}},
+				},
+			},
+			"unsharded": {},
+		},
+	}
+	failures := checkVindexes(srvVSchema)
+	require.Len(t, failures, 3)
+	var names []string
+	for _, failure := range failures {
+		names = append(names, failure.Keyspace+"."+failure.Name)
+	}
+	assert.Equal(t, []string{"ks1.static", "ks2.bogus", "ks2.hash"}, names)
+	assert.Equal(t, VindexCheckFailure{
+		Keyspace: "ks2",
+		Name:     "hash",
+		Type:     "hash",
+		Error:    `vindex hash is declared non-unique, but vindexType "hash" is unique`,
+	}, failures[2])
+	assert.Equal(t, `vindexType "bogus" not found`, failures[1].Error)
+}
+
+func TestExecutorCheckVindexes(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	failures, err := executor.CheckVindexes()
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+
+	request, err := http.NewRequest("GET", pathVindexCheck, nil)
+	require.NoError(t, err)
+	response := httptest.NewRecorder()
+	executor.ServeHTTP(response, request)
+	require.Equal(t, http.StatusOK, response.Code)
+	var got []VindexCheckFailure
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &got))
+	assert.Empty(t, got)
+}
+
+func TestCompareVindexMap(t *testing.T) {
+	ksid := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+	golden := []VindexGolden{
+		{ID: sqltypes.NewInt64(1), KeyspaceID: ksid("166b40b44aba4bd6")},
+		{ID: sqltypes.NewInt64(2), KeyspaceID: ksid("06e7ea22ce92708f")},
+		{ID: sqltypes.NewInt64(3), KeyspaceID: ksid("4eb190c9a2fa169c")},
+		{ID: sqltypes.NewInt64(4), KeyspaceID: ksid("d2fd8867d50d2dfe")},
+	}
+	result, err := CompareVindexMap("hash", nil, golden)
+	require.NoError(t, err)
+	require.Len(t, result.Fields, 3)
+	assert.Empty(t, result.Rows)
+
+	golden = append(golden,
+		VindexGolden{ID: sqltypes.NewInt64(5), KeyspaceID: ksid("166b40b44aba4bd6")},
+		VindexGolden{ID: sqltypes.NULL, KeyspaceID: ksid("00")},
+	)
+	result, err = CompareVindexMap("hash", nil, golden)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARCHAR("5") VARCHAR("166b40b44aba4bd6") VARCHAR("70bb023c810ca87a")] [VARCHAR("") VARCHAR("00") VARCHAR("DestinationNone()")]]`, fmt.Sprintf("%v", result.Rows))
+
+	_, err = CompareVindexMap("lookup", map[string]string{"table": "t_lkp", "from": "c1", "to": "keyspace_id"}, golden)
+	assert.EqualError(t, err, "vindex type lookup needs a vcursor and cannot be compared")
+	_, err = CompareVindexMap("bogus", nil, golden)
+	assert.EqualError(t, err, `vindexType "bogus" not found`)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// maxChurnSample bounds the number of ids SimulateVindexChurn maps.
+const maxChurnSample = 1000000
+
+// SimulateVindexChurn estimates the resharding impact of redefining a
+// column vindex of a table. It maps the ids from first to last through
+// the current definition of the vindex and through the proposed one,
+// and counts the ids whose keyspace id changes and the ids that move to
+// another master shard of the keyspace. Nothing is changed, and no
+// query is sent to the tablets, so vindexes that need to query them,
+// like lookups, are not supported.
+func (e *Executor) SimulateVindexChurn(ctx context.Context, keyspace, tableName, vindexName string, proposed *vschemapb.Vindex, first, last int64) (vindexes.ChurnResult, error) {
+	if last < first || last-first >= maxChurnSample {
+		return vindexes.ChurnResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid sample range %d to %d: it must have between 1 and %d ids", first, last, maxChurnSample)
+	}
+	table, err := e.VSchema().FindTable(keyspace, tableName)
+	if err != nil {
+		return vindexes.ChurnResult{}, err
+	}
+	var current *vindexes.ColumnVindex
+	for _, colVindex := range table.ColumnVindexes {
+		if colVindex.Name == vindexName {
+			current = colVindex
+			break
+		}
+	}
+	if current == nil {
+		return vindexes.ChurnResult{}, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vindex %s is not bound to table %s.%s", vindexName, keyspace, tableName)
+	}
+	if len(current.Columns) != 1 {
+		return vindexes.ChurnResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: churn of multi-column vindex %s", vindexName)
+	}
+	proposedVindex, err := vindexes.CreateVindex(proposed.Type, vindexName, proposed.Params)
+	if err != nil {
+		return vindexes.ChurnResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid proposed definition for vindex %s: %v", vindexName, err)
+	}
+	for _, v := range []vindexes.Vindex{current.Vindex, proposedVindex} {
+		if v.NeedsVCursor() {
+			return vindexes.ChurnResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: churn of vindex %s that needs to query tablets", v.String())
+		}
+	}
+
+	_, _, shardRefs, err := e.resolver.resolver.GetKeyspaceShards(ctx, keyspace, topodatapb.TabletType_MASTER)
+	if err != nil {
+		return vindexes.ChurnResult{}, err
+	}
+	shards := make([]*topodatapb.KeyRange, 0, len(shardRefs))
+	for _, shard := range shardRefs {
+		shards = append(shards, shard.KeyRange)
+	}
+
+	rows := make([][]sqltypes.Value, 0, last-first+1)
+	for id := first; ; id++ {
+		rows = append(rows, []sqltypes.Value{sqltypes.NewInt64(id)})
+		if id == last {
+			break
+		}
+	}
+	return vindexes.Churn(current.Vindex, proposedVindex, nil, shards, rows)
+}
@@ -83,7 +83,7 @@ func (cached *ColumnVindex) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(104)
 	}
 	// field Columns []vitess.io/vitess/go/vt/sqlparser.ColIdent
 	{
@@ -100,6 +100,10 @@ func (cached *ColumnVindex) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.Vindex.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field Expression vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expression.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	return size
 }
 func (cached *ConsistentLookup) CachedSize(alloc bool) int64 {
@@ -156,7 +160,7 @@ func (cached *LookupHash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(152)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -170,7 +174,7 @@ func (cached *LookupHashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(152)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -184,7 +188,7 @@ func (cached *LookupNonUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(152)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -198,7 +202,7 @@ func (cached *LookupUnicodeLooseMD5Hash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(152)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -212,7 +216,7 @@ func (cached *LookupUnicodeLooseMD5HashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(152)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -226,7 +230,7 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(152)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -341,7 +345,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(169)
+		size += int64(170)
 	}
 	// field Type string
 	size += int64(len(cached.Type))
@@ -425,7 +429,7 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(256)
+		size += int64(272)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -458,7 +462,7 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Table string
 	size += int64(len(cached.Table))
@@ -471,6 +475,8 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	}
 	// field To string
 	size += int64(len(cached.To))
+	// field FromKeyMode string
+	size += int64(len(cached.FromKeyMode))
 	// field sel string
 	size += int64(len(cached.sel))
 	// field ver string
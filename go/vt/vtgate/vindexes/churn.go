@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ChurnResult counts the rows of a sample that a change of vindex
+// would move.
+type ChurnResult struct {
+	// Rows is the number of rows in the sample.
+	Rows int
+	// KeyspaceIDs is the number of rows whose keyspace id changes.
+	KeyspaceIDs int
+	// Shards is the number of rows that move to another shard. It is
+	// at most KeyspaceIDs, because a keyspace id can change within the
+	// range of a shard.
+	Shards int
+}
+
+// KeyspaceIDFraction returns the fraction of the rows whose keyspace id
+// changes, or 0 for an empty sample.
+func (c ChurnResult) KeyspaceIDFraction() float64 {
+	if c.Rows == 0 {
+		return 0
+	}
+	return float64(c.KeyspaceIDs) / float64(c.Rows)
+}
+
+// ShardFraction returns the fraction of the rows that move to another
+// shard, or 0 for an empty sample.
+func (c ChurnResult) ShardFraction() float64 {
+	if c.Rows == 0 {
+		return 0
+	}
+	return float64(c.Shards) / float64(c.Rows)
+}
+
+// Churn maps a sample of rows through the current and the proposed
+// vindex, and counts the rows whose keyspace id changes and the rows
+// that move to another of the given shard ranges. It estimates how much
+// data has to be moved before the proposed vindex can replace the
+// current one. As for Distribution, both vindexes must map every row to
+// a single keyspace id covered by exactly one of the shards.
+func Churn(current, proposed Vindex, vcursor VCursor, shards []*topodatapb.KeyRange, rowsColValues [][]sqltypes.Value) (ChurnResult, error) {
+	result := ChurnResult{Rows: len(rowsColValues)}
+	before, err := Map(current, vcursor, rowsColValues)
+	if err != nil {
+		return ChurnResult{}, err
+	}
+	after, err := Map(proposed, vcursor, rowsColValues)
+	if err != nil {
+		return ChurnResult{}, err
+	}
+	for i, row := range rowsColValues {
+		oldKsid, err := singleKeyspaceID(row, before[i])
+		if err != nil {
+			return ChurnResult{}, err
+		}
+		newKsid, err := singleKeyspaceID(row, after[i])
+		if err != nil {
+			return ChurnResult{}, err
+		}
+		if bytes.Equal(oldKsid, newKsid) {
+			continue
+		}
+		result.KeyspaceIDs++
+		oldShard, err := shardIndex(shards, oldKsid)
+		if err != nil {
+			return ChurnResult{}, err
+		}
+		newShard, err := shardIndex(shards, newKsid)
+		if err != nil {
+			return ChurnResult{}, err
+		}
+		if oldShard != newShard {
+			result.Shards++
+		}
+	}
+	return result, nil
+}
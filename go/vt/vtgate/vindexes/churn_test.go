@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/key"
+)
+
+func TestChurn(t *testing.T) {
+	numeric, err := CreateVindex("numeric", "num", nil)
+	require.NoError(t, err)
+	reverseBits, err := CreateVindex("reverse_bits", "rev", nil)
+	require.NoError(t, err)
+	rows := sampleRows(10)
+
+	// numeric keeps ids 1 to 10 in -40. reverse_bits moves the odd ids
+	// to 80- and above, and the even ids to the range of their lowest
+	// bits: 2 (0x40...), 6 (0x60...) and 10 (0x50...) move to 40-80,
+	// while 4 (0x20...) and 8 (0x10...) stay in -40.
+	shards, err := key.ParseShardingSpec("-40-80-c0-")
+	require.NoError(t, err)
+	churn, err := Churn(numeric, reverseBits, nil, shards, rows)
+	require.NoError(t, err)
+	assert.Equal(t, ChurnResult{Rows: 10, KeyspaceIDs: 10, Shards: 8}, churn)
+	assert.Equal(t, 1.0, churn.KeyspaceIDFraction())
+	assert.Equal(t, 0.8, churn.ShardFraction())
+
+	// With two shards, only the odd ids move.
+	shards, err = key.ParseShardingSpec("-80-")
+	require.NoError(t, err)
+	churn, err = Churn(numeric, reverseBits, nil, shards, rows)
+	require.NoError(t, err)
+	assert.Equal(t, ChurnResult{Rows: 10, KeyspaceIDs: 10, Shards: 5}, churn)
+
+	churn, err = Churn(numeric, numeric, nil, shards, rows)
+	require.NoError(t, err)
+	assert.Equal(t, ChurnResult{Rows: 10}, churn)
+	assert.Equal(t, 0.0, churn.KeyspaceIDFraction())
+}
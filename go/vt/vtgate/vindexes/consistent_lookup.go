@@ -42,9 +42,21 @@ var (
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
 )
 
+// consistentLookupParams are the parameters accepted by the consistent
+// lookup vindexes, which never autocommit.
+var consistentLookupParams = ParamSchema{
+	{Name: "table", Type: ParamTypeString, Required: true},
+	{Name: "from", Type: ParamTypeString, Required: true},
+	{Name: "to", Type: ParamTypeString, Required: true},
+	{Name: "write_only", Type: ParamTypeBool, Default: "false"},
+	{Name: "ignore_nulls", Type: ParamTypeBool, Default: "false"},
+}
+
 func init() {
 	Register("consistent_lookup", NewConsistentLookup)
 	Register("consistent_lookup_unique", NewConsistentLookupUnique)
+	DeclareParams("consistent_lookup", consistentLookupParams)
+	DeclareParams("consistent_lookup_unique", consistentLookupParams)
 }
 
 // ConsistentLookup is a non-unique lookup vindex that can stay
@@ -212,6 +224,9 @@ func newCLCommon(name string, m map[string]string) (*clCommon, error) {
 	if err := lu.lkp.Init(m, false /* autocommit */, false /* upsert */); err != nil {
 		return nil, err
 	}
+	if lu.lkp.FromKeyMode != "" {
+		return nil, fmt.Errorf("consistent_lookup: from_key_mode %s is not supported", lu.lkp.FromKeyMode)
+	}
 	return lu, nil
 }
 
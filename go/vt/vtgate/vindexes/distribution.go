@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Distribution maps a sample of rows through the vindex and counts how
+// many of them land in each of the given shard ranges. The returned
+// counts are in the same order as shards. It can be used to detect hot
+// shards for a vindex and a shard layout before any data is written.
+// Every row must map to a single keyspace id that is covered by exactly
+// one of the shards.
+func Distribution(vindex Vindex, vcursor VCursor, shards []*topodatapb.KeyRange, rowsColValues [][]sqltypes.Value) ([]int, error) {
+	destinations, err := Map(vindex, vcursor, rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]int, len(shards))
+	for i, dest := range destinations {
+		ksid, err := singleKeyspaceID(rowsColValues[i], dest)
+		if err != nil {
+			return nil, err
+		}
+		shard, err := shardIndex(shards, ksid)
+		if err != nil {
+			return nil, err
+		}
+		counts[shard]++
+	}
+	return counts, nil
+}
+
+// singleKeyspaceID returns the keyspace id of the destination the row
+// mapped to, or an error if it is not a single keyspace id.
+func singleKeyspaceID(row []sqltypes.Value, dest key.Destination) (key.DestinationKeyspaceID, error) {
+	ksid, ok := dest.(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "value %v does not map to a single keyspace id: %v", row, dest)
+	}
+	return ksid, nil
+}
+
+// shardIndex returns the index of the only shard range that covers the
+// keyspace id.
+func shardIndex(shards []*topodatapb.KeyRange, ksid key.DestinationKeyspaceID) (int, error) {
+	found := -1
+	for j, kr := range shards {
+		if !key.KeyRangeContains(kr, ksid) {
+			continue
+		}
+		if found != -1 {
+			return -1, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace id %x is covered by both shard %s and shard %s", []byte(ksid), key.KeyRangeString(shards[found]), key.KeyRangeString(kr))
+		}
+		found = j
+	}
+	if found == -1 {
+		return -1, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace id %x is not covered by any shard", []byte(ksid))
+	}
+	return found, nil
+}
+
+// Skew returns the ratio between the busiest shard and the average load
+// of the counts returned by Distribution. A perfectly even distribution
+// has a skew of 1, and a distribution where every row lands in the same
+// shard has a skew equal to the number of shards.
+func Skew(counts []int) float64 {
+	total, busiest := 0, 0
+	for _, count := range counts {
+		total += count
+		if count > busiest {
+			busiest = count
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(busiest) * float64(len(counts)) / float64(total)
+}
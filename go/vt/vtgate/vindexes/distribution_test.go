@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+func sampleRows(n int) [][]sqltypes.Value {
+	rows := make([][]sqltypes.Value, 0, n)
+	for i := 1; i <= n; i++ {
+		rows = append(rows, []sqltypes.Value{sqltypes.NewInt64(int64(i))})
+	}
+	return rows
+}
+
+func TestDistribution(t *testing.T) {
+	shards, err := key.ParseShardingSpec("-40-80-c0-")
+	require.NoError(t, err)
+	rows := sampleRows(1000)
+
+	counts, err := Distribution(hash, nil, shards, rows)
+	require.NoError(t, err)
+	require.Len(t, counts, len(shards))
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	assert.Equal(t, len(rows), total)
+	assert.Less(t, Skew(counts), 1.5)
+
+	// numeric keeps small ids in the first shard.
+	numeric, err := CreateVindex("numeric", "num", nil)
+	require.NoError(t, err)
+	counts, err = Distribution(numeric, nil, shards, rows)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1000, 0, 0, 0}, counts)
+	assert.Equal(t, 4.0, Skew(counts))
+}
+
+func TestDistributionErrors(t *testing.T) {
+	rows := sampleRows(10)
+
+	// Missing the upper half of the keyspace.
+	shards, err := key.ParseShardingSpec("-80")
+	require.NoError(t, err)
+	_, err = Distribution(hash, nil, shards, rows)
+	assert.Regexp(t, "keyspace id [0-9a-f]+ is not covered by any shard", err)
+
+	shards, err = key.ParseShardingSpec("-80-")
+	require.NoError(t, err)
+	shards = append(shards, shards[0])
+	_, err = Distribution(hash, nil, shards, rows)
+	assert.Regexp(t, "keyspace id [0-9a-f]+ is covered by both shard -80 and shard -80", err)
+
+	assert.Equal(t, 0.0, Skew([]int{0, 0}))
+}
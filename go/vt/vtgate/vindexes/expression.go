@@ -0,0 +1,319 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// expressionFuncs are the functions a column vindex expression may call.
+// vtgate evaluates them itself to route inserts, so they are limited to
+// the ones it computes like MySQL does on a text column in utf8mb4: the
+// string functions count characters, not bytes, and lower and upper only
+// accept ASCII values, whose case doesn't depend on the collation. MySQL
+// doesn't change the case of a binary string, so binary columns are
+// rejected. Like for a vindex on a plain column, the value is mapped as
+// is, so selects only find the rows an insert routed if the column
+// compares case-sensitively.
+var expressionFuncs = map[string]bool{
+	"lower":     true,
+	"lcase":     true,
+	"upper":     true,
+	"ucase":     true,
+	"trim":      true,
+	"ltrim":     true,
+	"rtrim":     true,
+	"concat":    true,
+	"left":      true,
+	"right":     true,
+	"substr":    true,
+	"substring": true,
+	"reverse":   true,
+}
+
+// ParseVindexExpression parses the expression of a column vindex and
+// validates it with ValidateVindexExpression.
+func ParseVindexExpression(expression string) (sqlparser.Expr, error) {
+	stmt, err := sqlparser.Parse("select " + expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vindex expression %s", expression)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return nil, fmt.Errorf("invalid vindex expression %s", expression)
+	}
+	aliased, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok || !aliased.As.IsEmpty() || sel.From != nil && sqlparser.String(sel.From) != "dual" {
+		return nil, fmt.Errorf("invalid vindex expression %s", expression)
+	}
+	if err := ValidateVindexExpression(aliased.Expr); err != nil {
+		return nil, err
+	}
+	return aliased.Expr, nil
+}
+
+// ValidateVindexExpression checks that expr only uses unqualified columns,
+// literals and the functions that are allowed in a column vindex
+// expression.
+func ValidateVindexExpression(expr sqlparser.Expr) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if !node.Qualifier.IsEmpty() {
+				return false, fmt.Errorf("vindex expression %s: column %s must not be qualified", sqlparser.String(expr), sqlparser.String(node))
+			}
+		case *sqlparser.FuncExpr:
+			if !node.Qualifier.IsEmpty() || node.Distinct || !expressionFuncs[node.Name.Lowered()] {
+				return false, fmt.Errorf("vindex expression %s: function %s is not supported", sqlparser.String(expr), sqlparser.String(node.Name))
+			}
+		case *sqlparser.SubstrExpr, *sqlparser.Literal, *sqlparser.AliasedExpr,
+			sqlparser.SelectExprs, sqlparser.ColIdent, sqlparser.TableIdent, sqlparser.TableName:
+		default:
+			return false, fmt.Errorf("vindex expression %s: %s is not supported", sqlparser.String(expr), sqlparser.String(node))
+		}
+		return true, nil
+	}, expr)
+}
+
+// ValidateVindexExpressionColumns checks that the expression of a column
+// vindex doesn't read any of the binary columns of the table.
+func ValidateVindexExpressionColumns(expr sqlparser.Expr, columns []*vschemapb.Column) error {
+	for _, col := range VindexExpressionColumns(expr) {
+		for _, column := range columns {
+			if col.EqualString(column.Name) && sqltypes.IsBinary(column.Type) {
+				return fmt.Errorf("vindex expression %s: column %s is binary", sqlparser.String(expr), col.String())
+			}
+		}
+	}
+	return nil
+}
+
+// VindexExpressionColumns returns the columns the expression of a
+// column vindex reads, in the order they first appear.
+func VindexExpressionColumns(expr sqlparser.Expr) []sqlparser.ColIdent {
+	var cols []sqlparser.ColIdent
+	seen := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && !seen[col.Name.Lowered()] {
+			seen[col.Name.Lowered()] = true
+			cols = append(cols, col.Name)
+		}
+		return true, nil
+	}, expr)
+	return cols
+}
+
+// MatchVindexExpression returns true if expr computes the same value as
+// the expression of a column vindex. Column qualifiers and the case of
+// column and function names are ignored.
+func MatchVindexExpression(expr, vindexExpr sqlparser.Expr) bool {
+	return normalizeVindexExpression(expr) == normalizeVindexExpression(vindexExpr)
+}
+
+func normalizeVindexExpression(expr sqlparser.Expr) string {
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			buf.Myprintf("%v", sqlparser.NewColIdent(node.Name.Lowered()))
+		case *sqlparser.FuncExpr:
+			if node.Qualifier.IsEmpty() && !node.Distinct {
+				buf.Myprintf("%s(%v)", node.Name.Lowered(), node.Exprs)
+				return
+			}
+			node.Format(buf)
+		default:
+			node.Format(buf)
+		}
+	})
+	buf.Myprintf("%v", expr)
+	return buf.String()
+}
+
+// EvalVindexExpression computes the value of the expression of a column
+// vindex from the values of the columns it reads, keyed by their
+// lowered names. The result is NULL if any of the values it depends on
+// is NULL.
+func EvalVindexExpression(expr sqlparser.Expr, values map[string]sqltypes.Value) (sqltypes.Value, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		value, ok := values[expr.Name.Lowered()]
+		if !ok {
+			return sqltypes.NULL, fmt.Errorf("vindex expression: no value for column %s", sqlparser.String(expr))
+		}
+		return value, nil
+	case *sqlparser.Literal:
+		pv, err := sqlparser.NewPlanValue(expr)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return pv.ResolveValue(nil)
+	case *sqlparser.NullVal:
+		return sqltypes.NULL, nil
+	case *sqlparser.SubstrExpr:
+		var str sqlparser.Expr = expr.StrVal
+		if expr.Name != nil {
+			str = expr.Name
+		}
+		args := sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: str}, &sqlparser.AliasedExpr{Expr: expr.From}}
+		if expr.To != nil {
+			args = append(args, &sqlparser.AliasedExpr{Expr: expr.To})
+		}
+		return EvalVindexExpression(&sqlparser.FuncExpr{Name: sqlparser.NewColIdent("substr"), Exprs: args}, values)
+	case *sqlparser.FuncExpr:
+		args := make([]sqltypes.Value, 0, len(expr.Exprs))
+		for _, arg := range expr.Exprs {
+			aliased, ok := arg.(*sqlparser.AliasedExpr)
+			if !ok {
+				return sqltypes.NULL, fmt.Errorf("vindex expression: %s is not supported", sqlparser.String(arg))
+			}
+			value, err := EvalVindexExpression(aliased.Expr, values)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			if value.IsNull() {
+				return sqltypes.NULL, nil
+			}
+			args = append(args, value)
+		}
+		return evalVindexFunc(expr.Name.Lowered(), args)
+	}
+	return sqltypes.NULL, fmt.Errorf("vindex expression: %s is not supported", sqlparser.String(expr))
+}
+
+// evalVindexFunc computes the result of one of the expressionFuncs. None
+// of the args are NULL.
+func evalVindexFunc(name string, args []sqltypes.Value) (sqltypes.Value, error) {
+	argCount := func(min, max int) error {
+		if len(args) < min || len(args) > max {
+			return fmt.Errorf("vindex expression: wrong number of arguments for %s", name)
+		}
+		return nil
+	}
+	intArg := func(i int) (int, error) {
+		n, err := evalengine.ToInt64(args[i])
+		return int(n), err
+	}
+
+	var result string
+	switch name {
+	case "lower", "lcase", "upper", "ucase", "trim", "ltrim", "rtrim", "reverse":
+		if err := argCount(1, 1); err != nil {
+			return sqltypes.NULL, err
+		}
+		str := args[0].ToString()
+		switch name {
+		case "lower", "lcase", "upper", "ucase":
+			if !isASCII(str) {
+				return sqltypes.NULL, fmt.Errorf("vindex expression: %s of a non-ASCII value is not supported", name)
+			}
+		}
+		switch name {
+		case "lower", "lcase":
+			result = strings.ToLower(str)
+		case "upper", "ucase":
+			result = strings.ToUpper(str)
+		case "trim":
+			result = strings.Trim(str, " ")
+		case "ltrim":
+			result = strings.TrimLeft(str, " ")
+		case "rtrim":
+			result = strings.TrimRight(str, " ")
+		case "reverse":
+			runes := []rune(str)
+			for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+				runes[i], runes[j] = runes[j], runes[i]
+			}
+			result = string(runes)
+		}
+	case "concat":
+		if err := argCount(1, len(args)); err != nil {
+			return sqltypes.NULL, err
+		}
+		var b strings.Builder
+		for _, arg := range args {
+			b.WriteString(arg.ToString())
+		}
+		result = b.String()
+	case "left", "right":
+		if err := argCount(2, 2); err != nil {
+			return sqltypes.NULL, err
+		}
+		n, err := intArg(1)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		runes := []rune(args[0].ToString())
+		switch {
+		case n <= 0:
+		case n >= len(runes):
+			result = string(runes)
+		case name == "left":
+			result = string(runes[:n])
+		default:
+			result = string(runes[len(runes)-n:])
+		}
+	case "substr", "substring":
+		// The position is 1-based, and counts from the end of the
+		// string if it is negative, like in MySQL.
+		if err := argCount(2, 3); err != nil {
+			return sqltypes.NULL, err
+		}
+		runes := []rune(args[0].ToString())
+		pos, err := intArg(1)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		length := len(runes)
+		if len(args) == 3 {
+			if length, err = intArg(2); err != nil {
+				return sqltypes.NULL, err
+			}
+		}
+		start := pos - 1
+		if pos < 0 {
+			start = len(runes) + pos
+		}
+		if pos == 0 || start < 0 || start >= len(runes) || length <= 0 {
+			break
+		}
+		end := start + length
+		if end > len(runes) {
+			end = len(runes)
+		}
+		result = string(runes[start:end])
+	default:
+		return sqltypes.NULL, fmt.Errorf("vindex expression: function %s is not supported", name)
+	}
+	return sqltypes.NewVarChar(result), nil
+}
+
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestVindexExpressionColumns(t *testing.T) {
+	expr, err := ParseVindexExpression("concat(lower(email), '/', domain, upper(Email))")
+	require.NoError(t, err)
+	var got []string
+	for _, col := range VindexExpressionColumns(expr) {
+		got = append(got, col.String())
+	}
+	assert.Equal(t, []string{"email", "domain"}, got)
+}
+
+func TestMatchVindexExpression(t *testing.T) {
+	vindexExpr, err := ParseVindexExpression("lower(email)")
+	require.NoError(t, err)
+
+	tcases := []struct {
+		expr string
+		want bool
+	}{{
+		expr: "lower(email)",
+		want: true,
+	}, {
+		expr: "LOWER(t.Email)",
+		want: true,
+	}, {
+		expr: "upper(email)",
+		want: false,
+	}, {
+		expr: "lower(name)",
+		want: false,
+	}, {
+		expr: "email",
+		want: false,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.expr + " from t")
+			require.NoError(t, err)
+			expr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			assert.Equal(t, tcase.want, MatchVindexExpression(expr, vindexExpr))
+		})
+	}
+}
+
+func TestEvalVindexExpression(t *testing.T) {
+	values := map[string]sqltypes.Value{
+		"email":  sqltypes.NewVarChar("A@Example.com"),
+		"domain": sqltypes.NewVarChar(" example.com "),
+		"name":   sqltypes.NewVarChar("Émile"),
+		"nil":    sqltypes.NULL,
+	}
+
+	tcases := []struct {
+		expression string
+		want       sqltypes.Value
+		err        string
+	}{{
+		expression: "lower(email)",
+		want:       sqltypes.NewVarChar("a@example.com"),
+	}, {
+		expression: "ucase(email)",
+		want:       sqltypes.NewVarChar("A@EXAMPLE.COM"),
+	}, {
+		expression: "trim(domain)",
+		want:       sqltypes.NewVarChar("example.com"),
+	}, {
+		expression: "concat(lower(email), '/', rtrim(domain))",
+		want:       sqltypes.NewVarChar("a@example.com/ example.com"),
+	}, {
+		expression: "left(email, 1)",
+		want:       sqltypes.NewVarChar("A"),
+	}, {
+		expression: "right(email, 3)",
+		want:       sqltypes.NewVarChar("com"),
+	}, {
+		expression: "substr(email, 3)",
+		want:       sqltypes.NewVarChar("Example.com"),
+	}, {
+		expression: "substring(email, -3, 2)",
+		want:       sqltypes.NewVarChar("co"),
+	}, {
+		expression: "reverse(email)",
+		want:       sqltypes.NewVarChar("moc.elpmaxE@A"),
+	}, {
+		expression: "lower(nil)",
+		want:       sqltypes.NULL,
+	}, {
+		expression: "concat(email, nil)",
+		want:       sqltypes.NULL,
+	}, {
+		expression: "reverse(name)",
+		want:       sqltypes.NewVarChar("elimÉ"),
+	}, {
+		expression: "lower(name)",
+		err:        "vindex expression: lower of a non-ASCII value is not supported",
+	}, {
+		expression: "ucase(name)",
+		err:        "vindex expression: ucase of a non-ASCII value is not supported",
+	}, {
+		expression: "lower(city)",
+		err:        "vindex expression: no value for column city",
+	}, {
+		expression: "left(email)",
+		err:        "vindex expression: wrong number of arguments for left",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.expression, func(t *testing.T) {
+			expr, err := ParseVindexExpression(tcase.expression)
+			require.NoError(t, err)
+			got, err := EvalVindexExpression(expr, values)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want.ToString(), got.ToString())
+			assert.Equal(t, tcase.want.IsNull(), got.IsNull())
+		})
+	}
+}
+
+func TestValidateVindexExpressionColumns(t *testing.T) {
+	expr, err := ParseVindexExpression("concat(lower(email), token)")
+	require.NoError(t, err)
+
+	// Columns of an unknown type are assumed to be text.
+	require.NoError(t, ValidateVindexExpressionColumns(expr, nil))
+	require.NoError(t, ValidateVindexExpressionColumns(expr, []*vschemapb.Column{{Name: "email", Type: sqltypes.VarChar}}))
+
+	err = ValidateVindexExpressionColumns(expr, []*vschemapb.Column{{Name: "email", Type: sqltypes.VarChar}, {Name: "Token", Type: sqltypes.Blob}})
+	assert.EqualError(t, err, "vindex expression concat(lower(email), token): column token is binary")
+}
@@ -35,6 +35,7 @@ import (
 var (
 	_ SingleColumn = (*Hash)(nil)
 	_ Reversible   = (*Hash)(nil)
+	_ AllVerifier  = (*Hash)(nil)
 )
 
 // Hash defines vindex that hashes an int64 to a KeyspaceId
@@ -110,6 +111,21 @@ func (vind *Hash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]boo
 	return out, nil
 }
 
+// AllVerify returns true if every id maps to its keyspace id. It
+// stops at the first mismatch.
+func (vind *Hash) AllVerify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) (bool, error) {
+	for i := range ids {
+		num, err := evalengine.ToUint64(ids[i])
+		if err != nil {
+			return false, vterrors.Wrap(err, "hash.AllVerify")
+		}
+		if !bytes.Equal(vhash(num), ksids[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // ReverseMap returns the ids from ksids.
 func (vind *Hash) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
 	reverseIds := make([]sqltypes.Value, 0, len(ksids))
@@ -19,6 +19,7 @@ package vindexes
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
@@ -27,15 +28,21 @@ import (
 )
 
 var (
-	_ SingleColumn = (*LookupUnique)(nil)
-	_ Lookup       = (*LookupUnique)(nil)
-	_ SingleColumn = (*LookupNonUnique)(nil)
-	_ Lookup       = (*LookupNonUnique)(nil)
+	_ SingleColumn  = (*LookupUnique)(nil)
+	_ Lookup        = (*LookupUnique)(nil)
+	_ Comparable    = (*LookupUnique)(nil)
+	_ Fingerprinter = (*LookupUnique)(nil)
+	_ SingleColumn  = (*LookupNonUnique)(nil)
+	_ Lookup        = (*LookupNonUnique)(nil)
+	_ Comparable    = (*LookupNonUnique)(nil)
+	_ Fingerprinter = (*LookupNonUnique)(nil)
 )
 
 func init() {
 	Register("lookup", NewLookup)
 	Register("lookup_unique", NewLookupUnique)
+	DeclareParams("lookup", lookupParams)
+	DeclareParams("lookup_unique", lookupParams)
 }
 
 // LookupNonUnique defines a vindex that uses a lookup table and create a mapping between from ids and KeyspaceId.
@@ -162,6 +169,18 @@ func NewLookup(name string, m map[string]string) (Vindex, error) {
 	return lookup, nil
 }
 
+// Equivalent returns true if other is a LookupNonUnique with the same
+// lookup table configuration.
+func (ln *LookupNonUnique) Equivalent(other Vindex) bool {
+	o, ok := other.(*LookupNonUnique)
+	return ok && ln.writeOnly == o.writeOnly && reflect.DeepEqual(ln.lkp, o.lkp)
+}
+
+// Fingerprint returns the fingerprint of the lookup table configuration.
+func (ln *LookupNonUnique) Fingerprint() string {
+	return ln.lkp.fingerprint("lookup", ln.writeOnly)
+}
+
 func ksidsToValues(ksids [][]byte) []sqltypes.Value {
 	values := make([]sqltypes.Value, 0, len(ksids))
 	for _, ksid := range ksids {
@@ -214,6 +233,18 @@ func (lu *LookupUnique) String() string {
 	return lu.name
 }
 
+// Equivalent returns true if other is a LookupUnique with the same
+// lookup table configuration.
+func (lu *LookupUnique) Equivalent(other Vindex) bool {
+	o, ok := other.(*LookupUnique)
+	return ok && lu.writeOnly == o.writeOnly && reflect.DeepEqual(lu.lkp, o.lkp)
+}
+
+// Fingerprint returns the fingerprint of the lookup table configuration.
+func (lu *LookupUnique) Fingerprint() string {
+	return lu.lkp.fingerprint("lookup_unique", lu.writeOnly)
+}
+
 // Cost returns the cost of this vindex as 10.
 func (lu *LookupUnique) Cost() int {
 	return 10
@@ -38,6 +38,8 @@ var (
 func init() {
 	Register("lookup_hash", NewLookupHash)
 	Register("lookup_hash_unique", NewLookupHashUnique)
+	DeclareParams("lookup_hash", lookupParams)
+	DeclareParams("lookup_hash_unique", lookupParams)
 }
 
 //====================================================================
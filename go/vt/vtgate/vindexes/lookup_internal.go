@@ -18,6 +18,7 @@ package vindexes
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -29,14 +30,40 @@ import (
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
+// lookupParams are the parameters accepted by the lookup vindexes.
+var lookupParams = ParamSchema{
+	{Name: "table", Type: ParamTypeString, Required: true},
+	{Name: "from", Type: ParamTypeString, Required: true},
+	{Name: "to", Type: ParamTypeString, Required: true},
+	{Name: "autocommit", Type: ParamTypeBool, Default: "false"},
+	{Name: "write_only", Type: ParamTypeBool, Default: "false"},
+	{Name: "ignore_nulls", Type: ParamTypeBool, Default: "false"},
+	{Name: "from_key_mode", Type: ParamTypeString, Default: fromKeyColumns},
+}
+
+// These are the ways the values of the from columns of a lookup vindex
+// can be stored in the lookup table, as set by the from_key_mode param.
+const (
+	// fromKeyColumns stores every value in its own column.
+	fromKeyColumns = "columns"
+	// fromKeyConcat stores the values joined with colons in a single
+	// column.
+	fromKeyConcat = "concat"
+	// fromKeyHash stores the xxhash of the values in a single column.
+	fromKeyHash = "hash"
+)
+
 // lookupInternal implements the functions for the Lookup vindexes.
 type lookupInternal struct {
-	Table         string   `json:"table"`
-	FromColumns   []string `json:"from_columns"`
-	To            string   `json:"to"`
-	Autocommit    bool     `json:"autocommit,omitempty"`
-	Upsert        bool     `json:"upsert,omitempty"`
-	IgnoreNulls   bool     `json:"ignore_nulls,omitempty"`
+	Table       string   `json:"table"`
+	FromColumns []string `json:"from_columns"`
+	To          string   `json:"to"`
+	Autocommit  bool     `json:"autocommit,omitempty"`
+	Upsert      bool     `json:"upsert,omitempty"`
+	IgnoreNulls bool     `json:"ignore_nulls,omitempty"`
+	// FromKeyMode is empty if every from column is stored in its
+	// own column of the lookup table.
+	FromKeyMode   string `json:"from_key_mode,omitempty"`
 	sel, ver, del string
 }
 
@@ -55,6 +82,17 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 		return err
 	}
 
+	switch mode := lookupQueryParams["from_key_mode"]; mode {
+	case "", fromKeyColumns:
+	case fromKeyConcat, fromKeyHash:
+		if len(lkp.FromColumns) != 1 {
+			return fmt.Errorf("lookup: from_key_mode %s stores the key in a single column, got from columns %v", mode, lkp.FromColumns)
+		}
+		lkp.FromKeyMode = mode
+	default:
+		return fmt.Errorf("lookup: invalid from_key_mode %q, must be one of %s, %s, %s", mode, fromKeyColumns, fromKeyConcat, fromKeyHash)
+	}
+
 	lkp.Autocommit = autocommit
 	lkp.Upsert = upsert
 
@@ -76,6 +114,7 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 	if lkp.Autocommit {
 		co = vtgatepb.CommitOrder_AUTOCOMMIT
 	}
+	ids = lkp.fromKeys(ids)
 	sel := lkp.sel
 	if vcursor.InTransactionAndIsDML() {
 		sel = sel + " for update"
@@ -141,6 +180,7 @@ func (lkp *lookupInternal) Verify(vcursor VCursor, ids, values []sqltypes.Value)
 
 func (lkp *lookupInternal) VerifyCustom(vcursor VCursor, ids, values []sqltypes.Value, co vtgatepb.CommitOrder) ([]bool, error) {
 	out := make([]bool, len(ids))
+	ids = lkp.fromKeys(ids)
 	for i, id := range ids {
 		bindVars := map[string]*querypb.BindVariable{
 			lkp.FromColumns[0]: sqltypes.ValueBindVariable(id),
@@ -216,7 +256,7 @@ nextRow:
 				continue nextRow
 			}
 		}
-		trimmedRowsCols = append(trimmedRowsCols, row)
+		trimmedRowsCols = append(trimmedRowsCols, lkp.fromKey(row))
 		trimmedToValues = append(trimmedToValues, toValues[i])
 	}
 	if len(trimmedRowsCols) == 0 {
@@ -296,12 +336,12 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	}
 	// We only need to check the first row. Number of cols per row
 	// is guaranteed by the engine to be uniform.
-	if len(rowsColValues[0]) != len(lkp.FromColumns) {
+	if len(lkp.fromKey(rowsColValues[0])) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
 	for _, column := range rowsColValues {
 		bindVars := make(map[string]*querypb.BindVariable, len(rowsColValues))
-		for colIdx, columnValue := range column {
+		for colIdx, columnValue := range lkp.fromKey(column) {
 			bindVars[lkp.FromColumns[colIdx]] = sqltypes.ValueBindVariable(columnValue)
 		}
 		bindVars[lkp.To] = sqltypes.ValueBindVariable(value)
@@ -321,6 +361,54 @@ func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return lkp.Create(vcursor, [][]sqltypes.Value{newValues}, []sqltypes.Value{toValue}, false /* ignoreMode */)
 }
 
+// fromKey returns the values stored in the from columns of the lookup
+// table for the values of the columns of a row.
+func (lkp *lookupInternal) fromKey(values []sqltypes.Value) []sqltypes.Value {
+	switch lkp.FromKeyMode {
+	case fromKeyConcat:
+		parts := make([]string, len(values))
+		for i, v := range values {
+			parts[i] = v.ToString()
+		}
+		return []sqltypes.Value{sqltypes.NewVarBinary(strings.Join(parts, ":"))}
+	case fromKeyHash:
+		var buf bytes.Buffer
+		for _, v := range values {
+			// Prefix every value with its length, so that moving bytes
+			// from one value to the next changes the hash.
+			fmt.Fprintf(&buf, "%d:", len(v.Raw()))
+			buf.Write(v.Raw())
+		}
+		return []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.VarBinary, vXXHash(buf.Bytes()))}
+	}
+	return values
+}
+
+// fromKeys returns the keys of the ids given to Map and Verify. These only
+// get the value of the first column, so a lookup vindex that combines
+// several columns in a single key can only route single column bindings.
+func (lkp *lookupInternal) fromKeys(ids []sqltypes.Value) []sqltypes.Value {
+	if lkp.FromKeyMode == "" {
+		return ids
+	}
+	keys := make([]sqltypes.Value, len(ids))
+	for i, id := range ids {
+		keys[i] = lkp.fromKey([]sqltypes.Value{id})[0]
+	}
+	return keys
+}
+
+// fingerprint returns the fingerprint of a lookup vindex of the given
+// type that uses this lookup table configuration.
+func (lkp *lookupInternal) fingerprint(vindexType string, writeOnly bool) string {
+	config, err := json.Marshal(lkp)
+	if err != nil {
+		// The configuration only has strings and bools.
+		panic(err)
+	}
+	return fmt.Sprintf("%s:%x", vindexType, vXXHash([]byte(fmt.Sprintf("%t:%s", writeOnly, config))))
+}
+
 func (lkp *lookupInternal) initDelStmt() string {
 	var delBuffer bytes.Buffer
 	fmt.Fprintf(&delBuffer, "delete from %s where ", lkp.Table)
@@ -17,7 +17,9 @@ limitations under the License.
 package vindexes
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -555,6 +557,127 @@ func TestLookupNonUniqueUpdate(t *testing.T) {
 	}
 }
 
+func TestLookupEquivalent(t *testing.T) {
+	create := func(vindexType string, params map[string]string) Comparable {
+		t.Helper()
+		v, err := CreateVindex(vindexType, "lkp", params)
+		require.NoError(t, err)
+		return v.(Comparable)
+	}
+	base := map[string]string{"table": "t", "from": "a,b", "to": "toc"}
+
+	for _, vindexType := range []string{"lookup", "lookup_unique"} {
+		t.Run(vindexType, func(t *testing.T) {
+			v := create(vindexType, base)
+
+			// Spacing in the from columns and explicit defaults don't matter.
+			assert.True(t, v.Equivalent(create(vindexType, map[string]string{"table": "t", "from": "a, b", "to": "toc", "autocommit": "false", "write_only": "false", "ignore_nulls": "false"})))
+
+			assert.False(t, v.Equivalent(create(vindexType, map[string]string{"table": "t2", "from": "a,b", "to": "toc"})))
+			assert.False(t, v.Equivalent(create(vindexType, map[string]string{"table": "t", "from": "a,b", "to": "toc", "write_only": "true"})))
+			assert.False(t, v.Equivalent(create(vindexType, map[string]string{"table": "t", "from": "a,b", "to": "toc", "autocommit": "true"})))
+		})
+	}
+	assert.False(t, create("lookup", base).Equivalent(create("lookup_unique", base)))
+}
+
+func TestLookupFromKeyMode(t *testing.T) {
+	create := func(mode string) (Vindex, error) {
+		return CreateVindex("lookup", "lookup", map[string]string{
+			"table":         "t",
+			"from":          "fromc",
+			"to":            "toc",
+			"from_key_mode": mode,
+		})
+	}
+	hashKey := func(values ...string) *querypb.BindVariable {
+		var buf bytes.Buffer
+		for _, v := range values {
+			fmt.Fprintf(&buf, "%d:%s", len(v), v)
+		}
+		return sqltypes.BytesBindVariable(vXXHash(buf.Bytes()))
+	}
+
+	testcases := []struct {
+		mode      string
+		createKey *querypb.BindVariable
+		mapKey    *querypb.BindVariable
+	}{{
+		mode:      "concat",
+		createKey: sqltypes.BytesBindVariable([]byte("1:a")),
+		mapKey:    sqltypes.BytesBindVariable([]byte("1")),
+	}, {
+		mode:      "hash",
+		createKey: hashKey("1", "a"),
+		mapKey:    hashKey("1"),
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.mode, func(t *testing.T) {
+			v, err := create(tcase.mode)
+			require.NoError(t, err)
+
+			vc := &vcursor{}
+			err = v.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}}, [][]byte{[]byte("test1")}, false /* ignoreMode */)
+			require.NoError(t, err)
+			utils.MustMatch(t, []*querypb.BoundQuery{{
+				Sql: "insert into t(fromc, toc) values(:fromc_0, :toc_0)",
+				BindVariables: map[string]*querypb.BindVariable{
+					"fromc_0": tcase.createKey,
+					"toc_0":   sqltypes.BytesBindVariable([]byte("test1")),
+				},
+			}}, vc.queries, "lookup.Create")
+
+			vc = &vcursor{}
+			_, err = v.(SingleColumn).Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+			require.NoError(t, err)
+			require.Len(t, vc.queries, 1)
+			utils.MustMatch(t, &querypb.BindVariable{
+				Type:   querypb.Type_TUPLE,
+				Values: []*querypb.Value{{Type: tcase.mapKey.Type, Value: tcase.mapKey.Value}},
+			}, vc.queries[0].BindVariables["fromc"], "lookup.Map")
+		})
+	}
+
+	v, err := create("columns")
+	require.NoError(t, err)
+	assert.Empty(t, v.(*LookupNonUnique).lkp.FromKeyMode)
+
+	_, err = create("bogus")
+	require.EqualError(t, err, `lookup: invalid from_key_mode "bogus", must be one of columns, concat, hash`)
+
+	_, err = CreateVindex("lookup", "lookup", map[string]string{
+		"table":         "t",
+		"from":          "a,b",
+		"to":            "toc",
+		"from_key_mode": "hash",
+	})
+	require.EqualError(t, err, "lookup: from_key_mode hash stores the key in a single column, got from columns [a b]")
+}
+
+func TestLookupFingerprint(t *testing.T) {
+	fingerprint := func(vindexType, name string, params map[string]string) string {
+		v, err := CreateVindex(vindexType, name, params)
+		require.NoError(t, err)
+		return v.(Fingerprinter).Fingerprint()
+	}
+	base := map[string]string{"table": "t", "from": "a,b", "to": "toc"}
+
+	for _, vindexType := range []string{"lookup", "lookup_unique"} {
+		t.Run(vindexType, func(t *testing.T) {
+			want := fingerprint(vindexType, "v1", base)
+			// The name and the spelling of the params don't matter.
+			assert.Equal(t, want, fingerprint(vindexType, "v2", base))
+			assert.Equal(t, want, fingerprint(vindexType, "v1", map[string]string{"table": "t", "from": " a, b ", "to": "toc", "write_only": "false"}))
+
+			assert.NotEqual(t, want, fingerprint(vindexType, "v1", map[string]string{"table": "t2", "from": "a,b", "to": "toc"}))
+			assert.NotEqual(t, want, fingerprint(vindexType, "v1", map[string]string{"table": "t", "from": "b,a", "to": "toc"}))
+			assert.NotEqual(t, want, fingerprint(vindexType, "v1", map[string]string{"table": "t", "from": "a,b", "to": "toc", "write_only": "true"}))
+			assert.NotEqual(t, want, fingerprint(vindexType, "v1", map[string]string{"table": "t", "from": "a,b", "to": "toc", "autocommit": "true"}))
+		})
+	}
+	assert.NotEqual(t, fingerprint("lookup", "v", base), fingerprint("lookup_unique", "v", base))
+}
+
 func createLookup(t *testing.T, name string, writeOnly bool) SingleColumn {
 	t.Helper()
 	write := "false"
@@ -39,6 +39,8 @@ var (
 func init() {
 	Register("lookup_unicodeloosemd5_hash", NewLookupUnicodeLooseMD5Hash)
 	Register("lookup_unicodeloosemd5_hash_unique", NewLookupUnicodeLooseMD5HashUnique)
+	DeclareParams("lookup_unicodeloosemd5_hash", lookupParams)
+	DeclareParams("lookup_unicodeloosemd5_hash_unique", lookupParams)
 }
 
 //====================================================================
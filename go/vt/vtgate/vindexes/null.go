@@ -24,8 +24,9 @@ import (
 )
 
 var (
-	_        Vindex = (*Null)(nil)
-	nullksid        = []byte{0}
+	_        Vindex     = (*Null)(nil)
+	_        Enumerable = (*Null)(nil)
+	nullksid            = []byte{0}
 )
 
 // Null defines a vindex that always return 0. It's Unique and
@@ -81,6 +82,11 @@ func (vind *Null) Verify(cursor VCursor, ids []sqltypes.Value, ksids [][]byte) (
 	return out, nil
 }
 
+// Destinations returns the only destination of the vindex.
+func (vind *Null) Destinations() ([]key.Destination, error) {
+	return []key.Destination{key.DestinationKeyspaceID(nullksid)}, nil
+}
+
 func init() {
 	Register("null", NewNull)
 }
@@ -69,6 +69,15 @@ func TestNullMap(t *testing.T) {
 	}
 }
 
+func TestNullDestinations(t *testing.T) {
+	got, err := Destinations(null)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceID([]byte{0})}, got)
+
+	_, err = Destinations(hash)
+	assert.EqualError(t, err, "vindex nn cannot enumerate its destinations")
+}
+
 func TestNullVerify(t *testing.T) {
 	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
 	ksids := [][]byte{{0}, {1}}
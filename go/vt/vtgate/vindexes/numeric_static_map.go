@@ -46,7 +46,9 @@ type NumericStaticMap struct {
 }
 
 func init() {
-	Register("numeric_static_map", NewNumericStaticMap)
+	RegisterWithSchema("numeric_static_map", NewNumericStaticMap, ParamSchema{
+		{Name: "json_path", Type: ParamTypeString, Required: true},
+	})
 }
 
 // NewNumericStaticMap creates a NumericStaticMap vindex.
@@ -33,6 +33,9 @@ var (
 
 func init() {
 	Register("region_experimental", NewRegionExperimental)
+	DeclareParams("region_experimental", ParamSchema{
+		{Name: "region_bytes", Type: ParamTypeInt, Required: true},
+	})
 }
 
 // RegionExperimental is a multi-column unique vindex. The first column is prefixed
@@ -37,6 +37,10 @@ var (
 
 func init() {
 	Register("region_json", NewRegionJSON)
+	DeclareParams("region_json", ParamSchema{
+		{Name: "region_map", Type: ParamTypeString, Required: true},
+		{Name: "region_bytes", Type: ParamTypeInt, Required: true},
+	})
 }
 
 // RegionMap is used to store mapping of country to region
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+)
+
+var (
+	// EnableTimings turns on the latency histograms of vindex Map and Verify calls.
+	EnableTimings = flag.Bool("vindex_timings", false, "Record the latency of vindex Map and Verify calls in the VindexTimings histograms, keyed by vindex name.")
+
+	vindexTimings = stats.NewMultiTimings("VindexTimings", "Latency of vindex Map and Verify calls", []string{"Operation", "Vindex"})
+)
+
+// These are the operations recorded in the VindexTimings histograms.
+const (
+	MapOperation    = "Map"
+	VerifyOperation = "Verify"
+)
+
+// RecordTiming records the time elapsed since start for the given
+// operation on the vindex, if timings are enabled. Vindexes whose Map
+// or Verify is not reached through the package level Map and Verify
+// functions can call it themselves.
+func RecordTiming(operation string, vindex Vindex, start time.Time) {
+	if !*EnableTimings {
+		return
+	}
+	vindexTimings.Record([]string{operation, vindex.String()}, start)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestRecordTimings(t *testing.T) {
+	rows := [][]sqltypes.Value{{sqltypes.NewInt64(1)}}
+	mapKey := MapOperation + "." + hash.String()
+	verifyKey := VerifyOperation + "." + hash.String()
+
+	// Disabled by default.
+	_, err := Map(hash, nil, rows)
+	require.NoError(t, err)
+	assert.Zero(t, vindexTimings.Counts()[mapKey])
+
+	*EnableTimings = true
+	defer func() {
+		*EnableTimings = false
+	}()
+
+	_, err = Map(hash, nil, rows)
+	require.NoError(t, err)
+	_, err = Verify(hash, nil, rows, [][]byte{[]byte("\x16k@\xb4J\xbaK\xd6")})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, vindexTimings.Counts()[mapKey], int64(1))
+	assert.GreaterOrEqual(t, vindexTimings.Counts()[verifyKey], int64(1))
+	assert.GreaterOrEqual(t, vindexTimings.Histograms()[mapKey].Count(), int64(1))
+}
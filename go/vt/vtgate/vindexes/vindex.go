@@ -17,7 +17,12 @@ limitations under the License.
 package vindexes
 
 import (
+	"flag"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
@@ -25,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -91,6 +97,71 @@ type MultiColumn interface {
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
 }
 
+// A Selective vindex reports how many rows are expected to share a
+// single value. This is optional. If present, the planbuilder uses it
+// to choose between vindexes that have the same cost.
+type Selective interface {
+	Vindex
+	// Selectivity returns the estimated number of rows per value.
+	// A unique vindex returns 1.
+	Selectivity() float64
+}
+
+// A Comparable vindex can tell whether another vindex behaves the
+// same way, even if their params are spelled differently. This is
+// optional. If present, ALTER VSCHEMA uses it to accept the
+// redeclaration of an existing vindex with different params.
+type Comparable interface {
+	Vindex
+	Equivalent(other Vindex) bool
+}
+
+// A Fingerprinter vindex returns a stable fingerprint derived from its
+// type and effective params. Vindexes that behave the same way have the
+// same fingerprint, whatever their names. This is optional. If present,
+// caches and diagnostics can use it to key or compare vindexes.
+type Fingerprinter interface {
+	Vindex
+	Fingerprint() string
+}
+
+// An Enumerable vindex can list every destination its Map can
+// return. This is only possible for vindexes that map to a bounded set
+// of keyspace ids. It is meant for exhaustive routing checks in tests
+// and tools, not for query routing.
+type Enumerable interface {
+	Vindex
+	Destinations() ([]key.Destination, error)
+}
+
+// An AllVerifier vindex can tell whether all ids map to their
+// keyspace ids without verifying each of them. This is optional. If
+// present, AllVerify uses it to stop at the first mismatch.
+type AllVerifier interface {
+	SingleColumn
+	AllVerify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) (bool, error)
+}
+
+// A ShardAware vindex can change where it routes ids when some shards
+// of the keyspace are unavailable, for example because they are
+// read-only or draining during a migration. This is optional. If
+// present, AvoidShards is given the destinations returned by Map and
+// the unavailable shards, and returns the destinations to use instead.
+// It can return the destinations unchanged.
+type ShardAware interface {
+	SingleColumn
+	AvoidShards(vcursor VCursor, ids []sqltypes.Value, destinations []key.Destination, unavailable []*topodatapb.ShardReference) ([]key.Destination, error)
+}
+
+// An Initializable vindex needs to do expensive setup, like
+// opening resources or warming caches, before it's used. This is
+// optional. If present, Init is called once when the vschema is
+// built, and an error fails the build of the keyspace.
+type Initializable interface {
+	Vindex
+	Init() error
+}
+
 // A Reversible vindex is one that can perform a
 // reverse lookup from a keyspace id to an id. This
 // is optional. If present, VTGate can use it to
@@ -132,7 +203,47 @@ type WantOwnerInfo interface {
 // register a NewVindexFunc under a unique vindexType.
 type NewVindexFunc func(string, map[string]string) (Vindex, error)
 
-var registry = make(map[string]NewVindexFunc)
+var (
+	// StrictParams makes CREATE VINDEX and ADD VINDEX reject the params
+	// that the vindex type doesn't declare. See CheckParams.
+	StrictParams = flag.Bool("vindex_strict_params", false, "If set, CREATE VINDEX and ADD VINDEX reject the vindex params that are not declared by the vindex type.")
+
+	registry = make(map[string]NewVindexFunc)
+	schemas  = make(map[string]ParamSchema)
+	// declared holds the schemas that are only checked in strict mode.
+	declared = make(map[string]ParamSchema)
+)
+
+// These are the types a vindex parameter can be declared with.
+const (
+	ParamTypeString = "string"
+	ParamTypeInt    = "int"
+	ParamTypeBool   = "bool"
+)
+
+// ParamSpec describes a single vindex parameter.
+type ParamSpec struct {
+	Name     string
+	Type     string
+	Required bool
+	// Default is the value used when the parameter is not set,
+	// if it is not required.
+	Default string
+}
+
+// ParamSchema declares the parameters accepted by a vindex type.
+type ParamSchema []ParamSpec
+
+// Find returns the spec of the named parameter, or nil if the schema
+// doesn't declare it.
+func (ps ParamSchema) Find(name string) *ParamSpec {
+	for i := range ps {
+		if ps[i].Name == name {
+			return &ps[i]
+		}
+	}
+	return nil
+}
 
 // Register registers a vindex under the specified vindexType.
 // A duplicate vindexType will generate a panic.
@@ -145,6 +256,56 @@ func Register(vindexType string, newVindexFunc NewVindexFunc) {
 	registry[vindexType] = newVindexFunc
 }
 
+// RegisterWithSchema registers a vindex like Register, along with the
+// schema of the parameters it accepts. CreateVindex rejects parameters
+// that are not in the schema. The "tags" parameter is accepted by every
+// vindex and doesn't need to be declared.
+func RegisterWithSchema(vindexType string, newVindexFunc NewVindexFunc, schema ParamSchema) {
+	Register(vindexType, newVindexFunc)
+	schemas[vindexType] = schema
+}
+
+// DeclareParams records the schema of the parameters accepted by a
+// vindex type that was registered with Register. Unlike the schemas
+// given to RegisterWithSchema, it is not enforced by CreateVindex, so
+// that existing vschemas with stray parameters keep loading. It is only
+// enforced by CheckParams.
+func DeclareParams(vindexType string, schema ParamSchema) {
+	if _, ok := registry[vindexType]; !ok {
+		panic(fmt.Sprintf("%s is not registered", vindexType))
+	}
+	declared[vindexType] = schema
+}
+
+// VindexParamSchema returns the parameter schema of a vindex type, and
+// false if the type was registered without one.
+func VindexParamSchema(vindexType string) (ParamSchema, bool) {
+	if schema, ok := schemas[vindexType]; ok {
+		return schema, true
+	}
+	schema, ok := declared[vindexType]
+	return schema, ok
+}
+
+// CheckParams returns an error if params contains parameters that are not
+// in the schema of the vindex type. A type without a schema accepts no
+// parameters but "tags". It is used to validate the vindexes created
+// through DDL in strict mode.
+func CheckParams(vindexType string, params map[string]string) error {
+	schema, _ := VindexParamSchema(vindexType)
+	return checkParams(vindexType, schema, params)
+}
+
+// RegisteredVindexTypes returns the sorted list of registered vindex types.
+func RegisteredVindexTypes() []string {
+	types := make([]string, 0, len(registry))
+	for vindexType := range registry {
+		types = append(types, vindexType)
+	}
+	sort.Strings(types)
+	return types
+}
+
 // CreateVindex creates a vindex of the specified type using the
 // supplied params. The type must have been previously registered.
 func CreateVindex(vindexType, name string, params map[string]string) (Vindex, error) {
@@ -152,11 +313,90 @@ func CreateVindex(vindexType, name string, params map[string]string) (Vindex, er
 	if !ok {
 		return nil, fmt.Errorf("vindexType %q not found", vindexType)
 	}
-	return f(name, params)
+	if schema, ok := schemas[vindexType]; ok {
+		if err := checkParams(vindexType, schema, params); err != nil {
+			return nil, err
+		}
+	}
+	vindex, err := f(name, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnique(vindexType, vindex, params); err != nil {
+		return nil, err
+	}
+	return vindex, nil
+}
+
+// checkUnique validates the "unique" param, which every vindex accepts.
+// It declares whether the vindex maps every value to at most one
+// keyspace id, and must agree with the vindex.
+func checkUnique(vindexType string, vindex Vindex, params map[string]string) error {
+	val, ok := params[sqlparser.VindexUniqueStr]
+	if !ok {
+		return nil
+	}
+	unique, err := strconv.ParseBool(val)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %s", sqlparser.VindexUniqueStr, val)
+	}
+	switch {
+	case unique && !vindex.IsUnique():
+		return fmt.Errorf("vindex %s is declared unique, but vindexType %q is not unique", vindex.String(), vindexType)
+	case !unique && vindex.IsUnique():
+		return fmt.Errorf("vindex %s is declared non-unique, but vindexType %q is unique", vindex.String(), vindexType)
+	}
+	return nil
+}
+
+func checkParams(vindexType string, schema ParamSchema, params map[string]string) error {
+	var unknown []string
+	for name := range params {
+		if name == sqlparser.VindexTagsStr || name == sqlparser.VindexUniqueStr || schema.Find(name) != nil {
+			continue
+		}
+		unknown = append(unknown, name)
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf("unknown params for vindexType %q: %s", vindexType, strings.Join(unknown, ", "))
+}
+
+// Cheaper returns true if vindex a should be preferred over vindex b.
+// The vindex with the lower cost wins. If both have the same cost and
+// are Selective, the one with the lower selectivity wins.
+func Cheaper(a, b Vindex) bool {
+	if a.Cost() != b.Cost() {
+		return a.Cost() < b.Cost()
+	}
+	sa, ok := a.(Selective)
+	if !ok {
+		return false
+	}
+	sb, ok := b.(Selective)
+	if !ok {
+		return false
+	}
+	return sa.Selectivity() < sb.Selectivity()
+}
+
+// Destinations returns every destination the vindex can map to. It
+// returns an UNIMPLEMENTED error if the vindex is not Enumerable.
+func Destinations(vindex Vindex) ([]key.Destination, error) {
+	enumerable, ok := vindex.(Enumerable)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vindex %s cannot enumerate its destinations", vindex.String())
+	}
+	return enumerable.Destinations()
 }
 
 // Map invokes the Map implementation supplied by the vindex.
 func Map(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	if *EnableTimings {
+		defer RecordTiming(MapOperation, vindex, time.Now())
+	}
 	switch vindex := vindex.(type) {
 	case MultiColumn:
 		return vindex.Map(vcursor, rowsColValues)
@@ -168,6 +408,9 @@ func Map(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]ke
 
 // Verify invokes the Verify implementation supplied by the vindex.
 func Verify(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	if *EnableTimings {
+		defer RecordTiming(VerifyOperation, vindex, time.Now())
+	}
 	switch vindex := vindex.(type) {
 	case MultiColumn:
 		return vindex.Verify(vcursor, rowsColValues, ksids)
@@ -177,6 +420,70 @@ func Verify(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 	return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "vindex does not have Map functions")
 }
 
+// A VerifyMismatch is an id that doesn't map to the keyspace id it was
+// given, as reported by VerifyDetail.
+type VerifyMismatch struct {
+	// Index is the position of the id in the input.
+	Index int
+	// Provided is the keyspace id the id was given.
+	Provided []byte
+	// Expected is the destination the vindex maps the id to. It is a
+	// key.DestinationKeyspaceID for unique vindexes.
+	Expected key.Destination
+}
+
+// VerifyDetail is like Verify, but it reports the ids that don't map
+// to their keyspace ids along with the destination they map to. It
+// returns nil if all the ids match.
+func VerifyDetail(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]VerifyMismatch, error) {
+	verified, err := Verify(vindex, vcursor, rowsColValues, ksids)
+	if err != nil {
+		return nil, err
+	}
+	var mismatches []VerifyMismatch
+	var mismatchRows [][]sqltypes.Value
+	for i, ok := range verified {
+		if ok {
+			continue
+		}
+		mismatches = append(mismatches, VerifyMismatch{Index: i, Provided: ksids[i]})
+		mismatchRows = append(mismatchRows, rowsColValues[i])
+	}
+	if len(mismatches) == 0 {
+		return nil, nil
+	}
+	destinations, err := Map(vindex, vcursor, mismatchRows)
+	if err != nil {
+		return nil, err
+	}
+	for i := range mismatches {
+		mismatches[i].Expected = destinations[i]
+	}
+	return mismatches, nil
+}
+
+// AllVerify returns true if every id maps to its keyspace id. It uses
+// the AllVerify implementation of the vindex if there is one, and
+// falls back to Verify otherwise.
+func AllVerify(vindex SingleColumn, vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) (bool, error) {
+	if *EnableTimings {
+		defer RecordTiming(VerifyOperation, vindex, time.Now())
+	}
+	if verifier, ok := vindex.(AllVerifier); ok {
+		return verifier.AllVerify(vcursor, ids, ksids)
+	}
+	out, err := vindex.Verify(vcursor, ids, ksids)
+	if err != nil {
+		return false, err
+	}
+	for _, ok := range out {
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func firstColsOnly(rowsColValues [][]sqltypes.Value) []sqltypes.Value {
 	firstCols := make([]sqltypes.Value, 0, len(rowsColValues))
 	for _, val := range rowsColValues {
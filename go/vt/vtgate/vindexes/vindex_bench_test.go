@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+// An idDistribution generates the n ids a vindex benchmark maps.
+type idDistribution func(n int) []sqltypes.Value
+
+// sequentialIDs are the integers from 1 to n.
+func sequentialIDs(n int) []sqltypes.Value {
+	ids := make([]sqltypes.Value, n)
+	for i := range ids {
+		ids[i] = sqltypes.NewInt64(int64(i + 1))
+	}
+	return ids
+}
+
+// randomIDs are uniformly distributed unsigned integers. The seed is
+// fixed so that runs can be compared.
+func randomIDs(n int) []sqltypes.Value {
+	r := rand.New(rand.NewSource(1))
+	ids := make([]sqltypes.Value, n)
+	for i := range ids {
+		ids[i] = sqltypes.NewUint64(r.Uint64())
+	}
+	return ids
+}
+
+// stringIDs are short strings with a common prefix.
+func stringIDs(n int) []sqltypes.Value {
+	ids := make([]sqltypes.Value, n)
+	for i := range ids {
+		ids[i] = sqltypes.NewVarChar(fmt.Sprintf("user-%d", i))
+	}
+	return ids
+}
+
+// vindexBenchmark describes a vindex and the ids it is benchmarked with.
+// Every operation maps, verifies or reverse maps batch ids at a time.
+type vindexBenchmark struct {
+	vindexType string
+	params     map[string]string
+	ids        idDistribution
+	idsName    string
+	batch      int
+}
+
+func (vb vindexBenchmark) name() string {
+	return fmt.Sprintf("%s,ids=%s,batch=%d", vb.vindexType, vb.idsName, vb.batch)
+}
+
+// defaultVindexBenchmarks are the vindexes run by BenchmarkVindexes. Lookup
+// vindexes are left out because they need a VCursor that can run queries.
+var defaultVindexBenchmarks = []vindexBenchmark{
+	{vindexType: "hash", ids: sequentialIDs, idsName: "sequential", batch: 64},
+	{vindexType: "hash", ids: randomIDs, idsName: "random", batch: 64},
+	{vindexType: "xxhash", ids: sequentialIDs, idsName: "sequential", batch: 64},
+	{vindexType: "numeric", ids: sequentialIDs, idsName: "sequential", batch: 64},
+	{vindexType: "reverse_bits", ids: sequentialIDs, idsName: "sequential", batch: 64},
+	{vindexType: "binary", ids: stringIDs, idsName: "string", batch: 64},
+	{vindexType: "binary_md5", ids: stringIDs, idsName: "string", batch: 64},
+	{vindexType: "unicode_loose_md5", ids: stringIDs, idsName: "string", batch: 64},
+	{vindexType: "unicode_loose_xxhash", ids: stringIDs, idsName: "string", batch: 64},
+}
+
+// vindexBenchmarkOps creates the vindex of vb and returns its operations
+// to benchmark, keyed by name. ReverseMap is only benchmarked if the
+// vindex is Reversible.
+func vindexBenchmarkOps(tb testing.TB, vb vindexBenchmark) map[string]func(b *testing.B) {
+	vindex, err := CreateVindex(vb.vindexType, vb.vindexType, vb.params)
+	require.NoError(tb, err)
+	single, ok := vindex.(SingleColumn)
+	if !ok {
+		tb.Skipf("%s is not a single column vindex", vb.vindexType)
+	}
+
+	ids := vb.ids(vb.batch)
+	destinations, err := single.Map(nil, ids)
+	require.NoError(tb, err)
+	ksids := make([][]byte, len(destinations))
+	for i, dest := range destinations {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		require.True(tb, ok, "%v does not map to a keyspace id: %v", ids[i], dest)
+		ksids[i] = ksid
+	}
+
+	ops := map[string]func(b *testing.B){
+		"Map": func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := single.Map(nil, ids); err != nil {
+					b.Fatal(err)
+				}
+			}
+		},
+		"Verify": func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := single.Verify(nil, ids, ksids); err != nil {
+					b.Fatal(err)
+				}
+			}
+		},
+	}
+	if reversible, ok := vindex.(Reversible); ok {
+		ops["ReverseMap"] = func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := reversible.ReverseMap(nil, ksids); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+	return ops
+}
+
+func runVindexBenchmark(b *testing.B, vb vindexBenchmark) {
+	ops := vindexBenchmarkOps(b, vb)
+	names := make([]string, 0, len(ops))
+	for name := range ops {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.Run(name, ops[name])
+	}
+}
+
+func BenchmarkVindexes(b *testing.B) {
+	for _, vb := range defaultVindexBenchmarks {
+		vb := vb
+		b.Run(vb.name(), func(b *testing.B) {
+			runVindexBenchmark(b, vb)
+		})
+	}
+}
+
+func TestVindexBenchmarkOps(t *testing.T) {
+	testcases := []struct {
+		vindexType string
+		ops        []string
+	}{{
+		vindexType: "hash",
+		ops:        []string{"Map", "ReverseMap", "Verify"},
+	}, {
+		// xxhash is not Reversible.
+		vindexType: "xxhash",
+		ops:        []string{"Map", "Verify"},
+	}}
+
+	// Run every operation a few times only, rather than for the default
+	// benchmark time.
+	benchtime := flag.Lookup("test.benchtime")
+	require.NotNil(t, benchtime)
+	saved := benchtime.Value.String()
+	require.NoError(t, benchtime.Value.Set("10x"))
+	defer benchtime.Value.Set(saved)
+
+	for _, tcase := range testcases {
+		t.Run(tcase.vindexType, func(t *testing.T) {
+			ops := vindexBenchmarkOps(t, vindexBenchmark{vindexType: tcase.vindexType, ids: randomIDs, batch: 8})
+			var names []string
+			for name, op := range ops {
+				names = append(names, name)
+				result := testing.Benchmark(op)
+				assert.NotZero(t, result.N, name)
+			}
+			sort.Strings(names)
+			assert.Equal(t, tcase.ops, names)
+		})
+	}
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package vindexes
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
@@ -79,3 +81,192 @@ func TestVindexVerify(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, got)
 }
+
+func TestVerifyDetail(t *testing.T) {
+	hash, err := CreateVindex("hash", "hash", nil)
+	require.NoError(t, err)
+
+	ksid1 := []byte("\x16k@\xb4J\xbaK\xd6")
+	ksid2 := []byte("\x06\xe7\xea\"Βp\x8f")
+	rows := [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(2)}, {sqltypes.NewInt64(1)}}
+
+	got, err := VerifyDetail(hash, nil, rows, [][]byte{ksid1, ksid2, ksid1})
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// The ksid of 1 is given to 2.
+	got, err = VerifyDetail(hash, nil, rows, [][]byte{ksid1, ksid1, ksid1})
+	require.NoError(t, err)
+	want := []VerifyMismatch{{
+		Index:    1,
+		Provided: ksid1,
+		Expected: key.DestinationKeyspaceID(ksid2),
+	}}
+	assert.Equal(t, want, got)
+
+	// Verify keeps its boolean contract.
+	verified, err := Verify(hash, nil, rows, [][]byte{ksid1, ksid1, ksid1})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, verified)
+}
+
+func TestAllVerify(t *testing.T) {
+	hash, err := CreateVindex("hash", "hash", nil)
+	require.NoError(t, err)
+	binary, err := CreateVindex("binary", "binary", nil)
+	require.NoError(t, err)
+
+	hashKsid := []byte("\x16k@\xb4J\xbaK\xd6")
+	testcases := []struct {
+		vindex SingleColumn
+		ids    []sqltypes.Value
+		ksids  [][]byte
+	}{{
+		// Hash implements AllVerifier.
+		vindex: hash.(SingleColumn),
+		ids:    []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(1)},
+		ksids:  [][]byte{hashKsid, hashKsid},
+	}, {
+		// Binary falls back to Verify.
+		vindex: binary.(SingleColumn),
+		ids:    []sqltypes.Value{sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("b")},
+		ksids:  [][]byte{[]byte("a"), []byte("b")},
+	}}
+	for _, tcase := range testcases {
+		got, err := AllVerify(tcase.vindex, nil, tcase.ids, tcase.ksids)
+		require.NoError(t, err)
+		assert.True(t, got, tcase.vindex.String())
+
+		tcase.ksids[1] = []byte("\x00")
+		got, err = AllVerify(tcase.vindex, nil, tcase.ids, tcase.ksids)
+		require.NoError(t, err)
+		assert.False(t, got, tcase.vindex.String())
+	}
+
+	// Hash stops at the first mismatch, before it gets to the id it
+	// cannot parse.
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("aa")}
+	_, err = hash.(SingleColumn).Verify(nil, ids, [][]byte{nil, nil})
+	require.EqualError(t, err, "hash.Verify: could not parse value: 'aa'")
+	got, err := AllVerify(hash.(SingleColumn), nil, ids, [][]byte{nil, nil})
+	require.NoError(t, err)
+	assert.False(t, got)
+}
+
+func TestRegisteredVindexTypes(t *testing.T) {
+	types := RegisteredVindexTypes()
+	assert.Contains(t, types, "hash")
+	assert.Contains(t, types, "lookup")
+	assert.True(t, sort.StringsAreSorted(types), "types are not sorted: %v", types)
+	assert.Equal(t, len(registry), len(types))
+}
+
+func TestVindexParamSchema(t *testing.T) {
+	schema, ok := VindexParamSchema("numeric_static_map")
+	require.True(t, ok)
+	assert.Equal(t, &ParamSpec{Name: "json_path", Type: ParamTypeString, Required: true}, schema.Find("json_path"))
+	assert.Nil(t, schema.Find("unknown"))
+
+	_, ok = VindexParamSchema("hash")
+	assert.False(t, ok)
+
+	// Declared schemas are not enforced by CreateVindex.
+	schema, ok = VindexParamSchema("lookup")
+	require.True(t, ok)
+	assert.Equal(t, &ParamSpec{Name: "write_only", Type: ParamTypeBool, Default: "false"}, schema.Find("write_only"))
+	lookupParams := map[string]string{"table": "t", "from": "fromc", "to": "toc", "write_onyl": "true"}
+	_, err := CreateVindex("lookup", "lkp", lookupParams)
+	assert.NoError(t, err)
+	assert.EqualError(t, CheckParams("lookup", lookupParams), `unknown params for vindexType "lookup": write_onyl`)
+
+	_, err = CreateVindex("numeric_static_map", "nsm", map[string]string{
+		"json_path": "testdata/numeric_static_map_test.json",
+		"jsonpath":  "testdata/numeric_static_map_test.json",
+		"extra":     "1",
+	})
+	assert.EqualError(t, err, `unknown params for vindexType "numeric_static_map": extra, jsonpath`)
+
+	// Tags are accepted by every vindex.
+	_, err = CreateVindex("numeric_static_map", "nsm", map[string]string{
+		"json_path": "testdata/numeric_static_map_test.json",
+		"tags":      "pii",
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateVindexUnique(t *testing.T) {
+	testcases := []struct {
+		vindexType string
+		unique     string
+		err        string
+	}{{
+		vindexType: "hash",
+		unique:     "true",
+	}, {
+		vindexType: "lookup",
+		unique:     "false",
+	}, {
+		vindexType: "lookup_unique",
+		unique:     "TRUE",
+	}, {
+		vindexType: "hash",
+		unique:     "false",
+		err:        `vindex v is declared non-unique, but vindexType "hash" is unique`,
+	}, {
+		vindexType: "lookup",
+		unique:     "true",
+		err:        `vindex v is declared unique, but vindexType "lookup" is not unique`,
+	}, {
+		vindexType: "numeric_static_map",
+		unique:     "maybe",
+		err:        "invalid value for unique: maybe",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.vindexType+","+tcase.unique, func(t *testing.T) {
+			params := map[string]string{"unique": tcase.unique}
+			switch tcase.vindexType {
+			case "lookup", "lookup_unique":
+				params["table"], params["from"], params["to"] = "t", "fromc", "toc"
+			case "numeric_static_map":
+				params["json_path"] = "testdata/numeric_static_map_test.json"
+			}
+			vindex, err := CreateVindex(tcase.vindexType, "v", params)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, params["unique"] != "false", vindex.IsUnique())
+		})
+	}
+}
+
+// selectiveVindex is a Selective vindex with a configurable cost.
+type selectiveVindex struct {
+	cost        int
+	selectivity float64
+}
+
+func (v *selectiveVindex) String() string       { return "selective" }
+func (v *selectiveVindex) Cost() int            { return v.cost }
+func (*selectiveVindex) IsUnique() bool         { return false }
+func (*selectiveVindex) NeedsVCursor() bool     { return false }
+func (v *selectiveVindex) Selectivity() float64 { return v.selectivity }
+
+func TestCheaper(t *testing.T) {
+	hash, err := CreateVindex("hash", "hash", nil)
+	assert.NoError(t, err)
+	low := &selectiveVindex{cost: 1, selectivity: 2}
+	high := &selectiveVindex{cost: 1, selectivity: 10}
+	costly := &selectiveVindex{cost: 2, selectivity: 1}
+
+	// Cost comes first.
+	assert.True(t, Cheaper(high, costly))
+	assert.False(t, Cheaper(costly, high))
+	// Selectivity breaks ties.
+	assert.True(t, Cheaper(low, high))
+	assert.False(t, Cheaper(high, low))
+	// Without selectivity on both sides, a tie keeps the current choice.
+	assert.False(t, Cheaper(low, hash))
+	assert.False(t, Cheaper(hash, low))
+}
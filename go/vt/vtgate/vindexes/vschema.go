@@ -90,6 +90,9 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	// Scatter is set if the table is intentionally scatter-routed.
+	// It doesn't need any vindex in a sharded keyspace.
+	Scatter bool `json:"scatter,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -105,6 +108,12 @@ type ColumnVindex struct {
 	Name    string               `json:"name"`
 	Owned   bool                 `json:"owned,omitempty"`
 	Vindex  Vindex               `json:"vindex"`
+	// Disabled is set if the planner must not use the vindex for
+	// routing. It is still maintained on writes.
+	Disabled bool `json:"disabled,omitempty"`
+	// Expression is set if the vindex is on a generated column. It
+	// computes the value of the column.
+	Expression sqlparser.Expr `json:"-"`
 }
 
 // Column describes a column.
@@ -224,6 +233,11 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		if err != nil {
 			return err
 		}
+		if v, ok := vindex.(Initializable); ok {
+			if err := v.Init(); err != nil {
+				return fmt.Errorf("vindex %s failed to initialize: %v", vname, err)
+			}
+		}
 
 		// If the keyspace requires explicit routing, don't include it in global routing
 		if !ks.RequireExplicitRouting {
@@ -240,6 +254,7 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Name:                    sqlparser.NewTableIdent(tname),
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
+			Scatter:                 table.Scatter,
 		}
 		switch table.Type {
 		case "", TypeReference:
@@ -260,8 +275,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Pinned = decoded
 		}
 
-		// If keyspace is sharded, then any table that's not a reference or pinned must have vindexes.
-		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
+		// If keyspace is sharded, then any table that's not a reference, pinned
+		// or scatter-routed must have vindexes.
+		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && !table.Scatter && len(table.ColumnVindexes) == 0 {
 			return fmt.Errorf("missing primary col vindex for table: %s", tname)
 		}
 
@@ -302,11 +318,25 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 				}
 			}
 			columnVindex := &ColumnVindex{
-				Columns: columns,
-				Type:    vindexInfo.Type,
-				Name:    ind.Name,
-				Owned:   owned,
-				Vindex:  vindex,
+				Columns:  columns,
+				Type:     vindexInfo.Type,
+				Name:     ind.Name,
+				Owned:    owned,
+				Vindex:   vindex,
+				Disabled: ind.Disabled,
+			}
+			if ind.Expression != "" {
+				if len(columns) != 1 {
+					return fmt.Errorf("vindex expression is only supported on a single column in vindex (%s) and table (%s)", ind.Name, tname)
+				}
+				expr, err := ParseVindexExpression(ind.Expression)
+				if err != nil {
+					return err
+				}
+				if err := ValidateVindexExpressionColumns(expr, table.Columns); err != nil {
+					return err
+				}
+				columnVindex.Expression = expr
 			}
 			if i == 0 {
 				// Perform Primary vindex check.
@@ -316,6 +346,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 				if owned {
 					return fmt.Errorf("primary vindex %s cannot be owned for table %s", ind.Name, tname)
 				}
+				if ind.Disabled {
+					return fmt.Errorf("primary vindex %s cannot be disabled for table %s", ind.Name, tname)
+				}
 			}
 			t.ColumnVindexes = append(t.ColumnVindexes, columnVindex)
 			if owned {
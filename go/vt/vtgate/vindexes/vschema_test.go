@@ -175,8 +175,30 @@ func NewSTLO(name string, _ map[string]string) (Vindex, error) {
 var _ SingleColumn = (*stLO)(nil)
 var _ Lookup = (*stLO)(nil)
 
+var _ Initializable = (*stInit)(nil)
+
+// stInit is a Functional, Unique Vindex that needs to be initialized.
+// Init fails if the fail param is set.
+type stInit struct {
+	stFU
+	initialized bool
+}
+
+func (v *stInit) Init() error {
+	if v.Params["fail"] != "" {
+		return errors.New(v.Params["fail"])
+	}
+	v.initialized = true
+	return nil
+}
+
+func NewSTInit(name string, params map[string]string) (Vindex, error) {
+	return &stInit{stFU: stFU{name: name, Params: params}}, nil
+}
+
 func init() {
 	Register("cheap", NewCheapVindex)
+	Register("stinit", NewSTInit)
 	Register("stfu", NewSTFU)
 	Register("stfn", NewSTFN)
 	Register("stln", NewSTLN)
@@ -1354,6 +1376,55 @@ func TestBuildVSchemaVindexNotFoundFail(t *testing.T) {
 	}
 }
 
+func TestBuildVSchemaVindexInit(t *testing.T) {
+	newKeyspace := func(params map[string]string) *vschemapb.Keyspace {
+		return &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"stinit": {
+					Type:   "stinit",
+					Params: params,
+				},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"t1": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{
+							Column: "c1",
+							Name:   "stinit",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	ks, err := BuildKeyspaceSchema(newKeyspace(nil), "sharded")
+	require.NoError(t, err)
+	assert.True(t, ks.Vindexes["stinit"].(*stInit).initialized)
+
+	err = ValidateKeyspace(newKeyspace(map[string]string{"fail": "cannot open resource"}))
+	assert.EqualError(t, err, "vindex stinit failed to initialize: cannot open resource")
+}
+
+func TestBuildVSchemaScatterTable(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"t1": {Scatter: true},
+				},
+			},
+		},
+	}
+	got, _ := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	t1 := got.Keyspaces["sharded"].Tables["t1"]
+	assert.True(t, t1.Scatter)
+	assert.Empty(t, t1.ColumnVindexes)
+}
+
 func TestBuildVSchemaNoColumnVindexFail(t *testing.T) {
 	bad := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -1733,6 +1804,77 @@ func TestBuildVSchemaColumnAndColumnsFail(t *testing.T) {
 	}
 }
 
+func TestBuildVSchemaVindexExpression(t *testing.T) {
+	testcases := []struct {
+		expression string
+		columns    []string
+		want       string
+		err        string
+	}{{
+		expression: "lower(email)",
+		columns:    []string{"email_lower"},
+		want:       "lower(email)",
+	}, {
+		expression: "concat(LEFT(a, 3), substr(b, 2))",
+		columns:    []string{"c"},
+		want:       "concat(left(a, 3), substr(b, 2))",
+	}, {
+		expression: "sleep(email)",
+		columns:    []string{"c"},
+		err:        "vindex expression sleep(email): function sleep is not supported",
+	}, {
+		expression: "lower(t.email)",
+		columns:    []string{"c"},
+		err:        "vindex expression lower(t.email): column t.email must not be qualified",
+	}, {
+		expression: "email + 1",
+		columns:    []string{"c"},
+		err:        "vindex expression email + 1: email + 1 is not supported",
+	}, {
+		expression: "lower(email",
+		columns:    []string{"c"},
+		err:        "invalid vindex expression lower(email",
+	}, {
+		expression: "lower(email)",
+		columns:    []string{"c1", "c2"},
+		err:        "vindex expression is only supported on a single column in vindex (stfu) and table (t1)",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.expression, func(t *testing.T) {
+			srvVSchema := vschemapb.SrvVSchema{
+				Keyspaces: map[string]*vschemapb.Keyspace{
+					"sharded": {
+						Sharded: true,
+						Vindexes: map[string]*vschemapb.Vindex{
+							"stfu": {
+								Type: "stfu",
+							},
+						},
+						Tables: map[string]*vschemapb.Table{
+							"t1": {
+								ColumnVindexes: []*vschemapb.ColumnVindex{{
+									Columns:    tcase.columns,
+									Name:       "stfu",
+									Expression: tcase.expression,
+								}},
+							},
+						},
+					},
+				},
+			}
+			got, _ := BuildVSchema(&srvVSchema)
+			err := got.Keyspaces["sharded"].Error
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			colVindex := got.Keyspaces["sharded"].Tables["t1"].ColumnVindexes[0]
+			assert.Equal(t, tcase.want, sqlparser.String(colVindex.Expression))
+		})
+	}
+}
+
 func TestBuildVSchemaNoColumnsFail(t *testing.T) {
 	bad := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -1794,6 +1936,38 @@ func TestBuildVSchemaNotUniqueFail(t *testing.T) {
 	}
 }
 
+func TestBuildVSchemaPrimaryDisabledFail(t *testing.T) {
+	bad := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"stfu": {
+						Type: "stfu",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{
+							{
+								Column:   "c1",
+								Name:     "stfu",
+								Disabled: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	got, _ := BuildVSchema(&bad)
+	err := got.Keyspaces["sharded"].Error
+	want := "primary vindex stfu cannot be disabled for table t1"
+	if err == nil || err.Error() != want {
+		t.Errorf("BuildVSchema: %v, want %v", err, want)
+	}
+}
+
 func TestBuildVSchemaPrimaryCannotBeOwned(t *testing.T) {
 	bad := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
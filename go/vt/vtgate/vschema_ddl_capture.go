@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// showCapturedVSchemaDDL returns the ALTER VSCHEMA statements captured
+// by the session, in the order they were applied.
+func showCapturedVSchemaDDL(safeSession *SafeSession) *sqltypes.Result {
+	var rows [][]sqltypes.Value
+	for _, captured := range safeSession.GetCapturedVSchemaDDL() {
+		rows = append(rows, buildVarCharRow(captured.Keyspace, captured.Statement))
+	}
+	return &sqltypes.Result{
+		Fields: buildVarCharFields("Keyspace", "Statement"),
+		Rows:   rows,
+	}
+}
+
+// ReplayVSchemaDDL applies captured ALTER VSCHEMA statements in order
+// to a copy of the vschema, which can be nil to start from an empty
+// one, and returns the copy. The statements are applied as a whole: if
+// one fails, the error says which, and no vschema is returned. Neither
+// the given vschema nor the topo are changed.
+func (e *Executor) ReplayVSchemaDDL(vschema *vschemapb.SrvVSchema, captured []*vtgatepb.CapturedVSchemaDDL) (*vschemapb.SrvVSchema, error) {
+	result := &vschemapb.SrvVSchema{}
+	if vschema != nil {
+		result = proto.Clone(vschema).(*vschemapb.SrvVSchema)
+	}
+	if result.Keyspaces == nil {
+		result.Keyspaces = map[string]*vschemapb.Keyspace{}
+	}
+	for i, c := range captured {
+		stmt, err := sqlparser.Parse(c.Statement)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "statement %d", i+1)
+		}
+		vschemaDDL, ok := stmt.(*sqlparser.AlterVschema)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "statement %d is not an ALTER VSCHEMA: %s", i+1, c.Statement)
+		}
+		if err := applyVSchemaDDL(result, c.Keyspace, vschemaDDL); err != nil {
+			return nil, vterrors.Wrapf(err, "statement %d", i+1)
+		}
+	}
+	return result, nil
+}
+
+// applyVSchemaDDL applies a vschema DDL to the SrvVSchema, like
+// ExecuteVSchema does without saving it.
+func applyVSchemaDDL(srvVschema *vschemapb.SrvVSchema, keyspace string, vschemaDDL *sqlparser.AlterVschema) error {
+	switch vschemaDDL.Action {
+	case sqlparser.CopyKeyspaceDDLAction:
+		dst, ks, err := copyKeyspaceVSchema(srvVschema, vschemaDDL)
+		if err != nil {
+			return err
+		}
+		srvVschema.Keyspaces[dst] = ks
+	case sqlparser.AddRoutingRuleDDLAction, sqlparser.DropRoutingRuleDDLAction:
+		rules, err := applyRoutingRuleVSchemaDDL(srvVschema, vschemaDDL)
+		if err != nil {
+			return err
+		}
+		srvVschema.RoutingRules = rules
+	case sqlparser.SetVSchemaLabelDDLAction:
+		srvVschema.Label = vschemaDDL.Label
+	case sqlparser.ApplyVSchemaScriptDDLAction:
+		// Scripts are captured as the statements they applied.
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot replay a script, replay the statements it applied instead")
+	default:
+		ksName, _, ks, err := applyKeyspaceVSchemaDDL(srvVschema, keyspace, vschemaDDL)
+		if err != nil {
+			return err
+		}
+		srvVschema.Keyspaces[ksName] = ks
+	}
+	return nil
+}
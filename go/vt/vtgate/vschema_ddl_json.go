@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// vschemaChange describes the vschema objects changed by an ALTER
+// VSCHEMA. It is returned as JSON when the session sets
+// vschema_ddl_json. Created objects are described as they are after the
+// statement, and dropped objects as they were before it.
+type vschemaChange struct {
+	Action      string                 `json:"action"`
+	Keyspace    string                 `json:"keyspace,omitempty"`
+	Vindex      *vindexChange          `json:"vindex,omitempty"`
+	Table       *tableChange           `json:"table,omitempty"`
+	Bindings    []bindingChange        `json:"bindings,omitempty"`
+	RoutingRule *vschemapb.RoutingRule `json:"routing_rule,omitempty"`
+	Label       string                 `json:"label,omitempty"`
+}
+
+type vindexChange struct {
+	Name   string            `json:"name"`
+	Type   string            `json:"type"`
+	Params map[string]string `json:"params,omitempty"`
+	Owner  string            `json:"owner,omitempty"`
+}
+
+type tableChange struct {
+	Name          string                   `json:"name"`
+	Type          string                   `json:"type,omitempty"`
+	Source        string                   `json:"source,omitempty"`
+	AutoIncrement *vschemapb.AutoIncrement `json:"auto_increment,omitempty"`
+	Parent        *vschemapb.ParentTable   `json:"parent,omitempty"`
+	Scatter       bool                     `json:"scatter,omitempty"`
+}
+
+type bindingChange struct {
+	Table      string   `json:"table"`
+	Vindex     string   `json:"vindex"`
+	Columns    []string `json:"columns"`
+	Expression string   `json:"expression,omitempty"`
+}
+
+// vschemaDDLResult returns the result of an ALTER VSCHEMA. It is empty
+// unless the session sets vschema_ddl_json, in which case it has a
+// single row with the JSON description of the change.
+func (vc *vcursorImpl) vschemaDDLResult(change *vschemaChange) (*sqltypes.Result, error) {
+	if !vc.safeSession.GetVSchemaDDLJSON() {
+		return &sqltypes.Result{}, nil
+	}
+	data, err := json.Marshal(change)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{
+		Fields:       []*querypb.Field{{Name: "vschema_change", Type: sqltypes.VarChar}},
+		Rows:         [][]sqltypes.Value{{sqltypes.NewVarChar(string(data))}},
+		RowsAffected: 1,
+	}, nil
+}
+
+// describeVSchemaChange describes the change made by an ALTER VSCHEMA
+// to the keyspace, which went from orig to ks.
+func describeVSchemaChange(ksName string, orig, ks *vschemapb.Keyspace, vschemaDDL *sqlparser.AlterVschema) *vschemaChange {
+	change := &vschemaChange{
+		Action:   vschemaDDL.Action.ToString(),
+		Keyspace: ksName,
+	}
+	tableName := vschemaDDL.Table.Name.String()
+
+	switch vschemaDDL.Action {
+	case sqlparser.CreateVindexDDLAction:
+		change.Vindex = describeVindex(ks, vschemaDDL.VindexSpec.Name.String())
+	case sqlparser.DropVindexDDLAction:
+		change.Vindex = describeVindex(orig, vschemaDDL.VindexSpec.Name.String())
+	case sqlparser.CloneVindexDDLAction:
+		change.Vindex = describeVindex(ks, vschemaDDL.VindexSpec.Name.String())
+	case sqlparser.AddVschemaTableDDLAction, sqlparser.AddSequenceDDLAction, sqlparser.AddReferenceTableDDLAction, sqlparser.AddAutoIncDDLAction, sqlparser.SetParentTableDDLAction, sqlparser.SetScatterTableDDLAction:
+		change.Table = describeTable(ks, tableName)
+	case sqlparser.DropVschemaTableDDLAction:
+		change.Table = describeTable(orig, tableName)
+	case sqlparser.RenameVschemaTableDDLAction:
+		change.Table = describeTable(ks, vschemaDDL.NewName.Name.String())
+	case sqlparser.AddColVindexDDLAction, sqlparser.ReorderColVindexDDLAction:
+		// ADD VINDEX creates the vindex if it is given a type.
+		if vschemaDDL.Action == sqlparser.AddColVindexDDLAction && !vschemaDDL.VindexSpec.Type.IsEmpty() {
+			change.Vindex = describeVindex(ks, vschemaDDL.VindexSpec.Name.String())
+		}
+		change.Bindings = describeBindings(ks, tableName, vschemaDDL.VindexSpec.Name.String())
+	case sqlparser.AddColVindexesDDLAction:
+		for _, binding := range vschemaDDL.VindexBindings {
+			change.Bindings = append(change.Bindings, describeBindings(ks, tableName, binding.Spec.Name.String())...)
+		}
+	case sqlparser.DropColVindexDDLAction:
+		change.Bindings = describeBindings(orig, tableName, vschemaDDL.VindexSpec.Name.String())
+	case sqlparser.DropAllColVindexesDDLAction:
+		change.Bindings = describeBindings(orig, tableName, "")
+	}
+	return change
+}
+
+// describeVindex returns the description of the named vindex of the
+// keyspace, or nil if there is no such vindex.
+func describeVindex(ks *vschemapb.Keyspace, name string) *vindexChange {
+	vindex := ks.GetVindexes()[name]
+	if vindex == nil {
+		return nil
+	}
+	return &vindexChange{
+		Name:   name,
+		Type:   vindex.Type,
+		Params: vindex.Params,
+		Owner:  vindex.Owner,
+	}
+}
+
+// describeTable returns the description of the named table of the
+// keyspace, or nil if there is no such table.
+func describeTable(ks *vschemapb.Keyspace, name string) *tableChange {
+	table := ks.GetTables()[name]
+	if table == nil {
+		return nil
+	}
+	return &tableChange{
+		Name:          name,
+		Type:          table.Type,
+		Source:        table.Source,
+		AutoIncrement: table.AutoIncrement,
+		Parent:        table.Parent,
+		Scatter:       table.Scatter,
+	}
+}
+
+// describeBindings returns the description of the bindings of the named
+// vindex to the table, or of all its bindings if vindex is empty.
+func describeBindings(ks *vschemapb.Keyspace, table, vindex string) []bindingChange {
+	var bindings []bindingChange
+	for _, colVindex := range ks.GetTables()[table].GetColumnVindexes() {
+		if vindex != "" && colVindex.Name != vindex {
+			continue
+		}
+		columns := colVindex.Columns
+		if colVindex.Column != "" {
+			columns = []string{colVindex.Column}
+		}
+		bindings = append(bindings, bindingChange{
+			Table:      table,
+			Vindex:     colVindex.Name,
+			Columns:    columns,
+			Expression: colVindex.Expression,
+		})
+	}
+	return bindings
+}
+
+// findRoutingRule returns the routing rule for the table, or nil if
+// there is none.
+func findRoutingRule(rules *vschemapb.RoutingRules, from string) *vschemapb.RoutingRule {
+	for _, rule := range rules.GetRules() {
+		if rule.FromTable == from {
+			return rule
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ package vtgate
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"sync"
 
@@ -38,8 +39,79 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+
+	// pendingOrigins are the origins of the updates made by this vtgate
+	// that have not come back from the topo watch yet.
+	pendingOrigins []pendingOrigin
+
+	// subscribersMu protects subscribers. It is held while the watch
+	// sets the current SrvVSchema and while a subscriber replays it, so
+	// a subscriber either replays an update or receives it, never both
+	// and never neither. Callbacks are called without it.
+	subscribersMu sync.Mutex
+	subscribers   []*vschemaSubscriber
+
+	// startupCheck logs the vindexes of the first SrvVSchema received
+	// that cannot be created.
+	startupCheck sync.Once
+}
+
+// VSchemaOrigin describes the cause of a SrvVSchema update made by this
+// vtgate, to help attribute updates when several users and controllers
+// alter the vschema.
+type VSchemaOrigin struct {
+	// Username is the immediate caller that issued the statement.
+	Username string
+	// Statement summarizes the statement that caused the update.
+	Statement string
+}
+
+// VSchemaUpdate is a SrvVSchema delivered to subscribers along with its
+// origin. Origin is nil if the update was not made by this vtgate, or if
+// it is the current SrvVSchema replayed on subscription.
+type VSchemaUpdate struct {
+	SrvVSchema *vschemapb.SrvVSchema
+	Origin     *VSchemaOrigin
+}
+
+type vschemaSubscriber struct {
+	// mu is held while the callback runs, so the updates of one
+	// subscriber are delivered one at a time and in order.
+	mu       sync.Mutex
+	callback func(*VSchemaUpdate)
+	// removed is set under mu once the subscriber unsubscribed.
+	removed bool
 }
 
+func (sub *vschemaSubscriber) deliver(update *VSchemaUpdate) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if !sub.removed {
+		sub.callback(update)
+	}
+}
+
+type pendingOrigin struct {
+	hash   srvVSchemaHash
+	origin *VSchemaOrigin
+}
+
+// srvVSchemaHash identifies the content of a SrvVSchema, to recognize the
+// updates made by this vtgate when they come back from the topo watch
+// without keeping a copy of each of them.
+type srvVSchemaHash [sha256.Size]byte
+
+// hashSrvVSchema returns the hash of the SrvVSchema. It hashes the text
+// format, because the binary marshaling of the generated code doesn't
+// encode map fields in a stable order.
+func hashSrvVSchema(v *vschemapb.SrvVSchema) srvVSchemaHash {
+	return sha256.Sum256([]byte(proto.CompactTextString(v)))
+}
+
+// maxPendingOrigins bounds the origins kept for updates that never come
+// back from the topo watch, for example because they were overwritten.
+const maxPendingOrigins = 16
+
 //GetCurrentVschema return the denormalized VSchema from SrvVSchema
 func (vm *VSchemaManager) GetCurrentVschema() (*vindexes.VSchema, error) {
 	srvVschema := vm.GetCurrentSrvVschema()
@@ -57,6 +129,100 @@ func (vm *VSchemaManager) GetCurrentSrvVschema() *vschemapb.SrvVSchema {
 	return proto.Clone(vm.currentSrvVschema).(*vschemapb.SrvVSchema)
 }
 
+// Subscribe registers a callback that is called with a copy of every
+// SrvVSchema received from the topo watch, until the returned function
+// is called. If replay is true, the current SrvVSchema is delivered
+// synchronously before Subscribe returns and before any later update, so
+// a late subscriber does not have to wait for the next change to learn
+// about the current state.
+//
+// This lives here rather than in srvtopo.Server.WatchSrvVSchema because
+// every srvtopo watch reads its first value from the topo in its own
+// goroutine, while the VSchemaManager already holds the SrvVSchema this
+// vtgate runs with and sees every update in order.
+// The callback must not call its own unsubscribe function.
+func (vm *VSchemaManager) Subscribe(callback func(*vschemapb.SrvVSchema), replay bool) (unsubscribe func()) {
+	return vm.SubscribeUpdates(func(update *VSchemaUpdate) {
+		callback(update.SrvVSchema)
+	}, replay)
+}
+
+// SubscribeUpdates is like Subscribe, but the callback also receives the
+// origin of every update.
+func (vm *VSchemaManager) SubscribeUpdates(callback func(*VSchemaUpdate), replay bool) (unsubscribe func()) {
+	// The subscriber is locked before it is registered, so the watch
+	// can't deliver a later update to it before the replay.
+	sub := &vschemaSubscriber{callback: callback}
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	vm.subscribersMu.Lock()
+	var current *vschemapb.SrvVSchema
+	if replay {
+		current = vm.GetCurrentSrvVschema()
+	}
+	vm.subscribers = append(vm.subscribers, sub)
+	vm.subscribersMu.Unlock()
+
+	if replay {
+		callback(&VSchemaUpdate{SrvVSchema: current})
+	}
+	return func() {
+		vm.subscribersMu.Lock()
+		for i, s := range vm.subscribers {
+			if s == sub {
+				vm.subscribers = append(vm.subscribers[:i], vm.subscribers[i+1:]...)
+				break
+			}
+		}
+		vm.subscribersMu.Unlock()
+
+		sub.mu.Lock()
+		sub.removed = true
+		sub.mu.Unlock()
+	}
+}
+
+func notifySubscribers(subscribers []*vschemaSubscriber, v *vschemapb.SrvVSchema, origin *VSchemaOrigin) {
+	for _, sub := range subscribers {
+		update := &VSchemaUpdate{SrvVSchema: proto.Clone(v).(*vschemapb.SrvVSchema)}
+		if origin != nil {
+			o := *origin
+			update.Origin = &o
+		}
+		sub.deliver(update)
+	}
+}
+
+// addPendingOrigin records the origin of an update about to be saved.
+func (vm *VSchemaManager) addPendingOrigin(vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) {
+	hash := hashSrvVSchema(vschema)
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	vm.pendingOrigins = append(vm.pendingOrigins, pendingOrigin{hash: hash, origin: origin})
+	if len(vm.pendingOrigins) > maxPendingOrigins {
+		vm.pendingOrigins = vm.pendingOrigins[1:]
+	}
+}
+
+// takePendingOrigin returns the origin of the update that saved v, if it
+// was made by this vtgate, and forgets it.
+func (vm *VSchemaManager) takePendingOrigin(v *vschemapb.SrvVSchema) *VSchemaOrigin {
+	if v == nil {
+		return nil
+	}
+	hash := hashSrvVSchema(v)
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	for i, pending := range vm.pendingOrigins {
+		if pending.hash == hash {
+			vm.pendingOrigins = append(vm.pendingOrigins[:i], vm.pendingOrigins[i+1:]...)
+			return pending.origin
+		}
+	}
+	return nil
+}
+
 // watchSrvVSchema watches the SrvVSchema from the topo. The function does
 // not return an error. It instead logs warnings on failure.
 // The SrvVSchema object is roll-up of all the Keyspace information,
@@ -88,13 +254,19 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		}
 
 		// keep a copy of the latest SrvVschema
+		origin := vm.takePendingOrigin(v)
+		vm.subscribersMu.Lock()
 		vm.mu.Lock()
 		vm.currentSrvVschema = v
 		vm.mu.Unlock()
+		subscribers := append([]*vschemaSubscriber(nil), vm.subscribers...)
+		vm.subscribersMu.Unlock()
+		defer notifySubscribers(subscribers, v, origin)
 
 		// Transform the provided SrvVSchema into a VSchema.
 		var vschema *vindexes.VSchema
 		if v != nil {
+			vm.startupCheck.Do(func() { logVindexCheck(v) })
 			vschema, err = vindexes.BuildVSchema(v)
 			if err != nil {
 				log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", cell, err)
@@ -133,12 +305,16 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
 // the given keyspace is updated in the global topo, and the full SrvVSchema
-// is updated in all known cells.
-func (vm *VSchemaManager) UpdateVSchema(ctx context.Context, ksName string, vschema *vschemapb.SrvVSchema) error {
+// is updated in all known cells. If origin is set, it is delivered to the
+// subscribers along with the update once it comes back from the topo watch.
+func (vm *VSchemaManager) UpdateVSchema(ctx context.Context, ksName string, vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) error {
 	topoServer, err := vm.e.serv.GetTopoServer()
 	if err != nil {
 		return err
 	}
+	if origin != nil {
+		vm.addPendingOrigin(vschema, origin)
+	}
 
 	ks := vschema.Keyspaces[ksName]
 	err = topoServer.SaveVSchema(ctx, ksName, ks)
@@ -146,6 +322,49 @@ func (vm *VSchemaManager) UpdateVSchema(ctx context.Context, ksName string, vsch
 		return err
 	}
 
+	return updateSrvVSchema(ctx, topoServer, vschema)
+}
+
+// UpdateRoutingRules propagates the updated routing rules to the topo.
+// The rules are saved in the global topo, and the full SrvVSchema is
+// updated in all known cells, so the rules take effect in one update.
+// The origin is handled as in UpdateVSchema.
+func (vm *VSchemaManager) UpdateRoutingRules(ctx context.Context, vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) error {
+	topoServer, err := vm.e.serv.GetTopoServer()
+	if err != nil {
+		return err
+	}
+	if origin != nil {
+		vm.addPendingOrigin(vschema, origin)
+	}
+
+	if err := topoServer.SaveRoutingRules(ctx, vschema.RoutingRules); err != nil {
+		return err
+	}
+
+	return updateSrvVSchema(ctx, topoServer, vschema)
+}
+
+// UpdateVSchemaLabel propagates the updated version label of the vschema
+// to the topo, as UpdateRoutingRules does for the routing rules.
+func (vm *VSchemaManager) UpdateVSchemaLabel(ctx context.Context, vschema *vschemapb.SrvVSchema, origin *VSchemaOrigin) error {
+	topoServer, err := vm.e.serv.GetTopoServer()
+	if err != nil {
+		return err
+	}
+	if origin != nil {
+		vm.addPendingOrigin(vschema, origin)
+	}
+
+	if err := topoServer.SaveVSchemaLabel(ctx, vschema.Label); err != nil {
+		return err
+	}
+
+	return updateSrvVSchema(ctx, topoServer, vschema)
+}
+
+// updateSrvVSchema saves the SrvVSchema in all known cells.
+func updateSrvVSchema(ctx context.Context, topoServer *topo.Server, vschema *vschemapb.SrvVSchema) error {
 	cells, err := topoServer.GetKnownCells(ctx)
 	if err != nil {
 		return err
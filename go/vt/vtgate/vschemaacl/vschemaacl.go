@@ -18,8 +18,11 @@ package vschemaacl
 
 import (
 	"flag"
+	"sort"
 	"strings"
 
+	"vitess.io/vitess/go/vt/log"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
@@ -27,22 +30,44 @@ var (
 	// AuthorizedDDLUsers specifies the users that can perform ddl operations
 	AuthorizedDDLUsers = flag.String("vschema_ddl_authorized_users", "", "List of users authorized to execute vschema ddl operations, or '%' to allow all users.")
 
+	// DefaultACL specifies whether an empty AuthorizedDDLUsers denies or allows all users
+	DefaultACL = flag.String("vschema_ddl_default_acl", denyMode, "Behavior when vschema_ddl_authorized_users is empty: 'deny' rejects all users, 'allow' authorizes all users.")
+
 	// ddlAllowAll is true if the special value of "*" was specified
 	allowAll bool
 
 	// ddlACL contains a set of allowed usernames
 	acl map[string]struct{}
+
+	// defaultMode is the effective DefaultACL of the last Init
+	defaultMode = denyMode
+)
+
+const (
+	denyMode  = "deny"
+	allowMode = "allow"
 )
 
 // Init parses the users option and sets allowAll / acl accordingly
 func Init() {
 	acl = make(map[string]struct{})
 	allowAll = false
+	defaultMode = denyMode
+	if *DefaultACL == allowMode {
+		defaultMode = allowMode
+	}
 
 	if *AuthorizedDDLUsers == "%" {
 		allowAll = true
 		return
 	} else if *AuthorizedDDLUsers == "" {
+		switch *DefaultACL {
+		case allowMode:
+			allowAll = true
+		case denyMode:
+		default:
+			log.Warningf("unknown value %q for -vschema_ddl_default_acl, denying all users", *DefaultACL)
+		}
 		return
 	}
 
@@ -62,3 +87,29 @@ func Authorized(caller *querypb.VTGateCallerID) bool {
 	_, ok := acl[user]
 	return ok
 }
+
+// Rules describes the ACL set up by the last Init.
+type Rules struct {
+	// AllowAll is true if all users are authorized.
+	AllowAll bool
+	// Users are the authorized users, sorted.
+	Users []string
+	// DefaultMode is the behavior when no users are listed, allow
+	// or deny.
+	DefaultMode string
+}
+
+// CurrentRules returns the ACL set up by the last Init, as opposed to
+// the values of the flags, which may have changed since.
+func CurrentRules() Rules {
+	users := make([]string, 0, len(acl))
+	for user := range acl {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+	return Rules{
+		AllowAll:    allowAll,
+		Users:       users,
+		DefaultMode: defaultMode,
+	}
+}
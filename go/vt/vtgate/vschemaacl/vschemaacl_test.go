@@ -17,6 +17,7 @@ limitations under the License.
 package vschemaacl
 
 import (
+	"reflect"
 	"testing"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -68,3 +69,102 @@ func TestVschemaAcl(t *testing.T) {
 		t.Errorf("user should not be authorized")
 	}
 }
+
+func TestVschemaAclDefaultMode(t *testing.T) {
+	redUser := querypb.VTGateCallerID{Username: "redUser"}
+	yellowUser := querypb.VTGateCallerID{Username: "yellowUser"}
+	defer func() {
+		*AuthorizedDDLUsers = ""
+		*DefaultACL = "deny"
+		Init()
+	}()
+
+	// Deny mode with an empty list rejects everyone
+	*DefaultACL = "deny"
+	*AuthorizedDDLUsers = ""
+	Init()
+
+	if Authorized(&redUser) {
+		t.Errorf("user should not be authorized")
+	}
+	if Authorized(&yellowUser) {
+		t.Errorf("user should not be authorized")
+	}
+
+	// Deny mode with a populated list only allows the listed users
+	*AuthorizedDDLUsers = "redUser"
+	Init()
+
+	if !Authorized(&redUser) {
+		t.Errorf("user should be authorized")
+	}
+	if Authorized(&yellowUser) {
+		t.Errorf("user should not be authorized")
+	}
+
+	// Allow mode with an empty list allows everyone
+	*DefaultACL = "allow"
+	*AuthorizedDDLUsers = ""
+	Init()
+
+	if !Authorized(&redUser) {
+		t.Errorf("user should be authorized")
+	}
+	if !Authorized(&yellowUser) {
+		t.Errorf("user should be authorized")
+	}
+
+	// Allow mode with a populated list still only allows the listed users
+	*AuthorizedDDLUsers = "redUser"
+	Init()
+
+	if !Authorized(&redUser) {
+		t.Errorf("user should be authorized")
+	}
+	if Authorized(&yellowUser) {
+		t.Errorf("user should not be authorized")
+	}
+
+	// Unknown modes fall back to deny
+	*DefaultACL = "bogus"
+	*AuthorizedDDLUsers = ""
+	Init()
+
+	if Authorized(&redUser) {
+		t.Errorf("user should not be authorized")
+	}
+}
+
+func TestCurrentRules(t *testing.T) {
+	defer func() {
+		*AuthorizedDDLUsers = ""
+		*DefaultACL = "deny"
+		Init()
+	}()
+
+	*AuthorizedDDLUsers = "twoUser, oneUser"
+	*DefaultACL = "allow"
+	Init()
+	// Flag changes are only picked up by Init.
+	*AuthorizedDDLUsers = "%"
+
+	want := Rules{Users: []string{"oneUser", "twoUser"}, DefaultMode: "allow"}
+	if got := CurrentRules(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CurrentRules() = %+v, want %+v", got, want)
+	}
+
+	Init()
+	want = Rules{AllowAll: true, Users: []string{}, DefaultMode: "allow"}
+	if got := CurrentRules(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CurrentRules() = %+v, want %+v", got, want)
+	}
+
+	// Unknown modes fall back to deny
+	*AuthorizedDDLUsers = ""
+	*DefaultACL = "bogus"
+	Init()
+	want = Rules{Users: []string{}, DefaultMode: "deny"}
+	if got := CurrentRules(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CurrentRules() = %+v, want %+v", got, want)
+	}
+}
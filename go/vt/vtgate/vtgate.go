@@ -53,17 +53,27 @@ import (
 )
 
 var (
-	transactionMode      = flag.String("transaction_mode", "MULTI", "SINGLE: disallow multi-db transactions, MULTI: allow multi-db transactions with best effort commit, TWOPC: allow multi-db transactions with 2pc commit")
-	normalizeQueries     = flag.Bool("normalize_queries", true, "Rewrite queries with bind vars. Turn this off if the app itself sends normalized queries with bind vars.")
-	terseErrors          = flag.Bool("vtgate-config-terse-errors", false, "prevent bind vars from escaping in returned errors")
-	streamBufferSize     = flag.Int("stream_buffer_size", 32*1024, "the number of bytes sent from vtgate for each stream call. It's recommended to keep this value in sync with vttablet's query-server-config-stream-buffer-size.")
-	queryPlanCacheSize   = flag.Int64("gate_query_cache_size", cache.DefaultConfig.MaxEntries, "gate server query cache size, maximum number of queries to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a cache. This config controls the expected amount of unique entries in the cache.")
-	queryPlanCacheMemory = flag.Int64("gate_query_cache_memory", cache.DefaultConfig.MaxMemoryUsage, "gate server query cache size in bytes, maximum amount of memory to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
-	queryPlanCacheLFU    = flag.Bool("gate_query_cache_lfu", cache.DefaultConfig.LFU, "gate server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
-	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
-	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
-	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
-	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
+	transactionMode       = flag.String("transaction_mode", "MULTI", "SINGLE: disallow multi-db transactions, MULTI: allow multi-db transactions with best effort commit, TWOPC: allow multi-db transactions with 2pc commit")
+	normalizeQueries      = flag.Bool("normalize_queries", true, "Rewrite queries with bind vars. Turn this off if the app itself sends normalized queries with bind vars.")
+	terseErrors           = flag.Bool("vtgate-config-terse-errors", false, "prevent bind vars from escaping in returned errors")
+	streamBufferSize      = flag.Int("stream_buffer_size", 32*1024, "the number of bytes sent from vtgate for each stream call. It's recommended to keep this value in sync with vttablet's query-server-config-stream-buffer-size.")
+	queryPlanCacheSize    = flag.Int64("gate_query_cache_size", cache.DefaultConfig.MaxEntries, "gate server query cache size, maximum number of queries to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a cache. This config controls the expected amount of unique entries in the cache.")
+	queryPlanCacheMemory  = flag.Int64("gate_query_cache_memory", cache.DefaultConfig.MaxMemoryUsage, "gate server query cache size in bytes, maximum amount of memory to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
+	queryPlanCacheLFU     = flag.Bool("gate_query_cache_lfu", cache.DefaultConfig.LFU, "gate server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
+	_                     = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
+	maxMemoryRows         = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
+	warnMemoryRows        = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	defaultDDLStrategy    = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
+	ddlMaxConcurrency     = flag.Int("ddl_max_concurrency", 0, "Maximum number of shards a DDL statement is sent to concurrently. The shards are dispatched in batches of this size, and with @@ddl_fail_fast the batches after a failed one are not dispatched. 0 means no limit.")
+	recentQueriesSize     = flag.Int("recent_queries_size", 0, "Number of recently executed statements kept in memory for information_schema.vitess_recent_queries, with their literals redacted. Only the users allowed to alter the vschema can read them. 0 disables it.")
+	ddlTimingInfo         = flag.Bool("ddl_timing_info", false, "If set, the result of a DDL statement carries a warning with its total elapsed time and the elapsed time on every shard it is sent to.")
+	maxCapturedVSchemaDDL = flag.Int("max_captured_vschema_ddl", 100, "Maximum number of ALTER VSCHEMA statements a session that captures them keeps. The captured statements are part of the session, so further statements are not captured, with a warning.")
+	vschemaMaxTables      = flag.Int("vschema_max_tables", 100000, "Maximum number of tables in the vschema of a keyspace. ALTER VSCHEMA statements that would go beyond it are rejected. 0 means no limit.")
+	vschemaMaxVindexes    = flag.Int("vschema_max_vindexes", 100000, "Maximum number of vindexes in the vschema of a keyspace. ALTER VSCHEMA statements that would go beyond it are rejected. 0 means no limit.")
+	ddlKindInfo           = flag.Bool("ddl_kind_info", false, "If set, the result of a DDL statement carries a warning telling whether it changed the vschema or was sent to the shards.")
+	ddlShardRowsInfo      = flag.Bool("ddl_shard_rows_info", false, "If set, the result of a DDL statement sent to the shards carries a warning with the rows affected reported by each shard.")
+	ddlQueryInfo          = flag.Bool("ddl_query_info", false, "If set, the result of a DDL statement sent to the shards carries a warning with the statement text, after normalization, as it was sent to the shards.")
+	showVindexFingerprint = flag.Bool("show_vindex_fingerprint", false, "If set, SHOW VSCHEMA VINDEXES has an extra Fingerprint column with the fingerprint of each vindex that has one.")
 
 	// TODO(deepthi): change these two vars to unexported and move to healthcheck.go when LegacyHealthcheck is removed
 
@@ -522,6 +522,9 @@ func (sm *streamMigrater) templatizeKeyRange(ctx context.Context, rule *binlogda
 	}
 	// There was no in_keyrange expression. Create a new one.
 	vtable := sm.ts.sourceKSSchema.Tables[rule.Match]
+	if len(vtable.ColumnVindexes) == 0 {
+		return fmt.Errorf("table %s has no vindex to filter the stream by keyrange: streams on scatter tables cannot be migrated", rule.Match)
+	}
 	inkr := &sqlparser.FuncExpr{
 		Name: sqlparser.NewColIdent("in_keyrange"),
 		Exprs: sqlparser.SelectExprs{
@@ -1683,6 +1683,19 @@ func TestTemplatize(t *testing.T) {
 			},
 		}},
 		err: "cannot migrate queries that contain '{{' in their string: select '{{' from t1 where in_keyrange('-80')",
+	}, {
+		// scatter table without a vindex
+		in: []*vrStream{{
+			bls: &binlogdatapb.BinlogSource{
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "scatter",
+						Filter: "select * from scatter",
+					}},
+				},
+			},
+		}},
+		err: "table scatter has no vindex to filter the stream by keyrange: streams on scatter tables cannot be migrated",
 	}}
 	vs := &vschemapb.Keyspace{
 		Sharded: true,
@@ -1707,6 +1720,9 @@ func TestTemplatize(t *testing.T) {
 			"ref": {
 				Type: vindexes.TypeReference,
 			},
+			"scatter": {
+				Scatter: true,
+			},
 		},
 	}
 	ksschema, err := vindexes.BuildKeyspaceSchema(vs, "ks")
@@ -1247,6 +1247,9 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 					}
 					// TODO(sougou): handle degenerate cases like sequence, etc.
 					// We currently assume the primary vindex is the best way to filter, which may not be true.
+					if len(vtable.ColumnVindexes) == 0 {
+						return fmt.Errorf("table %s has no vindex to filter the reverse stream by keyrange: scatter tables cannot be migrated back to a sharded keyspace", rule.Match)
+					}
 					inKeyrange = fmt.Sprintf(" where in_keyrange(%s, '%s', '%s')", sqlparser.String(vtable.ColumnVindexes[0].Columns[0]), vtable.ColumnVindexes[0].Type, key.KeyRangeString(source.si.KeyRange))
 				}
 				filter = fmt.Sprintf("select * from %s%s", rule.Match, inKeyrange)